@@ -8,26 +8,143 @@ import (
 type AppSpec struct {
 	// Chart is the Helm chart which defines the App.
 	Chart string `json:"chart"`
-	// Repo is the URL for the chart repository.
+	// Repo is the URL for the chart repository. Repositories without a scheme are served over HTTPS:
+	// e.g. apps.home-cloud.io. Charts in OCI registries are referenced with the oci:// scheme: e.g.
+	// oci://ghcr.io/home-cloud-io/charts (the chart is pulled from <repo>/<chart>).
 	Repo string `json:"repo"`
+	// PullSecret optionally names a Secret in the namespace of the App with the credentials of the
+	// chart repository or registry. Either a kubernetes.io/dockerconfigjson Secret or one with
+	// username and password keys (e.g. kubernetes.io/basic-auth).
+	PullSecret string `json:"pullSecret,omitempty"`
+	// PlainHTTP pulls charts from an OCI registry over plain HTTP: e.g. a registry on the LAN.
+	PlainHTTP bool `json:"plainHTTP,omitempty"`
+	// Keyring optionally names a Secret in the namespace of the App holding a GnuPG public keyring
+	// under the "keyring" key. Charts are then only installed if their provenance file is signed by
+	// one of its keys.
+	Keyring string `json:"keyring,omitempty"`
 	// Release is the name of the Helm release of the App.
 	Release string `json:"release"`
 	// Values optionally defines the values that will be applied to the Chart.
 	Values string `json:"values,omitempty"`
 	// Version is the version of the chart.
 	Version string `json:"version"`
+	// PurgePolicy optionally defines what happens to the components created for the App (namespace,
+	// secrets, volumes, databases) when the App is deleted. (default: Retain)
+	PurgePolicy PurgePolicy `json:"purgePolicy,omitempty"`
+	// UpgradeTimeout optionally defines how long the workloads (Deployments, StatefulSets and
+	// DaemonSets) of the App have to roll out after an upgrade before the release is rolled back to
+	// the previous revision. (default: 5m)
+	UpgradeTimeout *metav1.Duration `json:"upgradeTimeout,omitempty"`
+	// DatabasePasswordRotationInterval optionally defines how often the passwords of the databases
+	// created for the App are rotated. Rotation can also be requested at any time by setting the
+	// RotateDatabasePasswordsAnnotation on the App.
+	DatabasePasswordRotationInterval *metav1.Duration `json:"databasePasswordRotationInterval,omitempty"`
+	// Persistence optionally overrides how the volumes of the App are provisioned. (default: the
+	// Persistence of the Install)
+	Persistence *PersistenceSpec `json:"persistence,omitempty"`
+	// Resources optionally overrides the CPU and memory budget of the App. Values which aren't set
+	// fall back to the Resources of the chart and then those of the Install.
+	Resources *ResourcesSpec `json:"resources,omitempty"`
 }
 
+// RotateDatabasePasswordsAnnotation requests a rotation of the App database passwords whenever its
+// value changes (e.g. set it to the current timestamp).
+const RotateDatabasePasswordsAnnotation = "apps.home-cloud.io/rotate-database-passwords"
+
+// RotateSecretKeysAnnotation requests new values for keys of the App secrets whenever its value
+// changes. The value is a comma separated list of <secret>/<key> entries naming the keys declared by
+// the chart: e.g. "immich/jwt-secret,immich/admin". Anything after an @ in an entry is ignored so that
+// the same keys can be rotated again: e.g. "immich/jwt-secret@2025-01-01T00:00:00Z".
+const RotateSecretKeysAnnotation = "apps.home-cloud.io/rotate-secret-keys"
+
+const (
+	// AppLabel is set on the resources created for an App (namespace, secrets, volumes, routes) with
+	// the name of the App so that changes to them are reconciled by the App.
+	AppLabel = "apps.home-cloud.io/app"
+	// AppNamespaceLabel is set alongside AppLabel with the namespace of the App.
+	AppNamespaceLabel = "apps.home-cloud.io/app-namespace"
+)
+
+// PurgePolicy describes how the components created for an App are handled when it is deleted.
+// +kubebuilder:validation:Enum=Retain;Delete
+type PurgePolicy string
+
+const (
+	// PurgePolicyRetain leaves the namespace, secrets, volumes and databases of the App in place so
+	// that a reinstall picks up the existing data.
+	PurgePolicyRetain PurgePolicy = "Retain"
+	// PurgePolicyDelete removes the namespace, secrets, volumes and databases of the App so that a
+	// reinstall starts clean.
+	PurgePolicyDelete PurgePolicy = "Delete"
+)
+
 // AppStatus defines the observed state of an App
 type AppStatus struct {
 	// Version is the version of the Chart that is currently installed.
 	Version string `json:"version"`
 	// Values that were used for the current Chart install.
 	Values string `json:"values,omitempty"`
+	// Phase is a high-level summary of where the App is in its lifecycle.
+	Phase AppPhase `json:"phase,omitempty"`
+	// Error is the last error encountered while reconciling the App. It is cleared once the App is
+	// successfully installed or upgraded.
+	Error string `json:"error,omitempty"`
+	// ObservedGeneration is the most recent generation of the App observed by the operator.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// FailedVersion is the last version of the Chart that was rolled back because the App did not
+	// become healthy after upgrading to it. It isn't set when only the values were upgraded.
+	FailedVersion string `json:"failedVersion,omitempty"`
+	// RolledBackGeneration is the generation of the App spec whose upgrade was last rolled back. The
+	// operator won't retry the upgrade until the App spec is changed.
+	RolledBackGeneration int64 `json:"rolledBackGeneration,omitempty"`
+	// UpgradeRevision is the revision of the Helm release whose rollout is awaited after an upgrade.
+	// It is cleared once the workloads of the revision have rolled out or the upgrade is rolled back.
+	UpgradeRevision int `json:"upgradeRevision,omitempty"`
+	// UpgradeStarted is when the upgrade whose rollout is awaited was applied.
+	UpgradeStarted *metav1.Time `json:"upgradeStarted,omitempty"`
+	// DatabasePasswordsRotated is the last time the passwords of the App databases were rotated.
+	DatabasePasswordsRotated *metav1.Time `json:"databasePasswordsRotated,omitempty"`
+	// DatabasePasswordRotationRequest is the value of the RotateDatabasePasswordsAnnotation that was
+	// last handled.
+	DatabasePasswordRotationRequest string `json:"databasePasswordRotationRequest,omitempty"`
+	// SecretKeyRotationRequest is the value of the RotateSecretKeysAnnotation that was last handled.
+	SecretKeyRotationRequest string `json:"secretKeyRotationRequest,omitempty"`
+	// Conditions represent the latest observations of each step of the App install.
+	//+listType=map
+	//+listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// AppPhase is a high-level summary of where an App is in its lifecycle.
+type AppPhase string
+
+const (
+	AppPhaseInstalling   AppPhase = "Installing"
+	AppPhaseUpgrading    AppPhase = "Upgrading"
+	AppPhaseInstalled    AppPhase = "Installed"
+	AppPhaseFailed       AppPhase = "Failed"
+	AppPhaseUninstalling AppPhase = "Uninstalling"
+)
+
+// Condition types reported on the AppStatus.
+const (
+	// AppConditionReady is true when the requested version of the App is fully installed.
+	AppConditionReady = "Ready"
+	// AppConditionDependenciesReady is true when the namespace, secrets, volumes and databases of
+	// the App have been created.
+	AppConditionDependenciesReady = "DependenciesReady"
+	// AppConditionChartInstalled is true when the Helm chart of the App has been installed or upgraded.
+	AppConditionChartInstalled = "ChartInstalled"
+	// AppConditionRoutesReady is true when the routes of the App have been created.
+	AppConditionRoutesReady = "RoutesReady"
+)
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.version`
+//+kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // App is the Schema for the apps API
 type App struct {
@@ -0,0 +1,152 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupSpec defines the desired state of a Backup
+type BackupSpec struct {
+	// App is the name of the App to back up. The App must be in the same namespace as the Backup.
+	App string `json:"app"`
+	// Target is where the backup is stored.
+	Target BackupTarget `json:"target"`
+	// Keep optionally defines how many backups of the same schedule are kept in the target once this
+	// backup completes. Older backups are removed. (default: all backups are kept)
+	Keep int `json:"keep,omitempty"`
+}
+
+// BackupTarget defines where backups are stored. Exactly one target must be set.
+type BackupTarget struct {
+	// Local stores backups in a directory on the host.
+	Local *LocalBackupTarget `json:"local,omitempty"`
+	// S3 stores backups in an S3-compatible bucket.
+	S3 *S3BackupTarget `json:"s3,omitempty"`
+}
+
+// LocalBackupTarget stores backups in a directory on the host.
+type LocalBackupTarget struct {
+	// Path is the directory on the host that backups are written to.
+	Path string `json:"path"`
+}
+
+// S3BackupTarget stores backups in an S3-compatible bucket.
+type S3BackupTarget struct {
+	// Endpoint is the URL of the S3-compatible service (e.g. https://s3.us-east-1.amazonaws.com).
+	Endpoint string `json:"endpoint"`
+	// Bucket is the name of the bucket that backups are written to.
+	Bucket string `json:"bucket"`
+	// Prefix optionally defines the path within the bucket that backups are written to.
+	Prefix string `json:"prefix,omitempty"`
+	// AccessKeyID references a Secret which contains the access key id.
+	AccessKeyID SecretReference `json:"accessKeyID"`
+	// SecretAccessKey references a Secret which contains the secret access key.
+	SecretAccessKey SecretReference `json:"secretAccessKey"`
+}
+
+// BackupStatus defines the observed state of a Backup
+type BackupStatus struct {
+	// Phase is a high-level summary of where the Backup is in its lifecycle.
+	Phase BackupPhase `json:"phase,omitempty"`
+	// Location is where the backup is stored within the target.
+	Location string `json:"location,omitempty"`
+	// StartTime is when the backup was started.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is when the backup completed (or failed).
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Error is the reason the backup failed.
+	Error string `json:"error,omitempty"`
+}
+
+// BackupPhase is a high-level summary of where a Backup is in its lifecycle.
+type BackupPhase string
+
+const (
+	BackupPhaseRunning   BackupPhase = "Running"
+	BackupPhaseCompleted BackupPhase = "Completed"
+	BackupPhaseFailed    BackupPhase = "Failed"
+)
+
+const (
+	// BackupLabel is set on the resources created for a Backup with the name of the Backup.
+	BackupLabel = "backups.home-cloud.io/backup"
+	// BackupScheduleLabel is set on Backups created by a BackupSchedule with the name of the BackupSchedule.
+	BackupScheduleLabel = "backups.home-cloud.io/schedule"
+)
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="App",type=string,JSONPath=`.spec.app`
+//+kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+//+kubebuilder:printcolumn:name="Location",type=string,JSONPath=`.status.location`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// Backup is the Schema for the backups API
+type Backup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackupSpec   `json:"spec,omitempty"`
+	Status BackupStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// BackupList contains a list of Backups
+type BackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Backup `json:"items"`
+}
+
+// BackupScheduleSpec defines the desired state of a BackupSchedule
+type BackupScheduleSpec struct {
+	// App is the name of the App to back up. The App must be in the same namespace as the BackupSchedule.
+	App string `json:"app"`
+	// Schedule is the cron expression on which backups are created (e.g. "0 2 * * *").
+	Schedule string `json:"schedule"`
+	// Target is where the backups are stored.
+	Target BackupTarget `json:"target"`
+	// Keep optionally defines how many backups are kept. Older backups are removed. (default: 7)
+	Keep int `json:"keep,omitempty"`
+	// Suspend optionally stops new backups from being created.
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// BackupScheduleStatus defines the observed state of a BackupSchedule
+type BackupScheduleStatus struct {
+	// LastScheduleTime is the last time a Backup was created.
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// LastBackup is the name of the last Backup that was created.
+	LastBackup string `json:"lastBackup,omitempty"`
+	// Error is the reason the schedule could not be registered (e.g. an invalid cron expression).
+	Error string `json:"error,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="App",type=string,JSONPath=`.spec.app`
+//+kubebuilder:printcolumn:name="Schedule",type=string,JSONPath=`.spec.schedule`
+//+kubebuilder:printcolumn:name="Last Backup",type=date,JSONPath=`.status.lastScheduleTime`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// BackupSchedule is the Schema for the backupschedules API
+type BackupSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackupScheduleSpec   `json:"spec,omitempty"`
+	Status BackupScheduleStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// BackupScheduleList contains a list of BackupSchedules
+type BackupScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackupSchedule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Backup{}, &BackupList{}, &BackupSchedule{}, &BackupScheduleList{})
+}
@@ -15,6 +15,20 @@ type InstallSpec struct {
 	// TODO: document API
 	Daemon   *DaemonSpec   `json:"daemon,omitempty"`
 	Settings *SettingsSpec `json:"settings,omitempty"`
+	// Persistence defines how volumes are provisioned for Apps. Apps can override this with their
+	// own Persistence.
+	Persistence *PersistenceSpec `json:"persistence,omitempty"`
+	// TLS defines how certificates are issued for the hostnames of App routes. Certificates are
+	// only issued (and routes served over HTTPS) when TLS is set.
+	TLS *TLSSpec `json:"tls,omitempty"`
+	// Resources defines the default CPU and memory budget of Apps. Charts and Apps can override each
+	// value with their own Resources.
+	Resources *ResourcesSpec `json:"resources,omitempty"`
+	// Isolation defines which traffic is allowed into the namespaces of Apps.
+	Isolation *IsolationSpec `json:"isolation,omitempty"`
+	// Bundle installs and upgrades Home Cloud from an offline bundle made by tools/releaser instead
+	// of downloading release files and charts from the internet.
+	Bundle *BundleSpec `json:"bundle,omitempty"`
 }
 
 type GatewayAPISpec struct {
@@ -23,6 +37,15 @@ type GatewayAPISpec struct {
 	Disable bool   `json:"disable,omitempty"`
 	Source  string `json:"source,omitempty"`
 	Version string `json:"version,omitempty"`
+	// Gateway references the Gateway that App routes are attached to. Set this to use a Gateway from
+	// another Gateway API implementation or one not managed by Home Cloud (default: the Istio
+	// ingress gateway)
+	Gateway *GatewayReference `json:"gateway,omitempty"`
+}
+
+type GatewayReference struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
 }
 
 type IstioSpec struct {
@@ -104,6 +127,12 @@ type SettingsSpec struct {
 	AutoUpdateSystem bool `json:"autoUpdateSystem,omitempty"`
 	// Hostname defines the base hostname for the install (default: home-cloud.local)
 	Hostname string `json:"hostname,omitempty"`
+	// RouteHostnames defines the templates of the hostnames that App routes are served on. The
+	// placeholder {route} is replaced with the name of the route and {hostname} with Hostname: e.g.
+	// "{route}.{hostname}" or "{route}.example.com" (default: ["{route}.local"])
+	//
+	// Hostnames ending in .local are advertised on the LAN over mDNS.
+	RouteHostnames []string `json:"routeHostnames,omitempty"`
 	// AppStores defines the app stores to install apps from
 	AppStores []AppStore `json:"appStores,omitempty"`
 	// AutoUpdateAppsSchedule is a cron string that defines the freqency with which the server
@@ -128,6 +157,169 @@ type AppStore struct {
 	RawChartURL string `json:"rawChartURL"`
 }
 
+type PersistenceSpec struct {
+	// Backend selects how volumes are provisioned (default: hostPath)
+	Backend PersistenceBackend `json:"backend,omitempty"`
+	// HostPath configures the hostPath backend.
+	HostPath *HostPathPersistenceSpec `json:"hostPath,omitempty"`
+	// StorageClass configures the storageClass backend.
+	StorageClass *StorageClassPersistenceSpec `json:"storageClass,omitempty"`
+	// NFS configures the nfs backend.
+	NFS *NFSPersistenceSpec `json:"nfs,omitempty"`
+}
+
+// PersistenceBackend is a way of provisioning volumes for Apps.
+// +kubebuilder:validation:Enum=hostPath;storageClass;nfs
+type PersistenceBackend string
+
+const (
+	// PersistenceBackendHostPath creates volumes on the host. The volumes are created through the
+	// daemon unless it is disabled, in which case they are created under HostPath.Path.
+	PersistenceBackendHostPath PersistenceBackend = "hostPath"
+	// PersistenceBackendStorageClass creates claims against an existing StorageClass (e.g. Longhorn)
+	// and leaves provisioning to it.
+	PersistenceBackendStorageClass PersistenceBackend = "storageClass"
+	// PersistenceBackendNFS creates volumes in a directory of an NFS export.
+	PersistenceBackendNFS PersistenceBackend = "nfs"
+)
+
+type HostPathPersistenceSpec struct {
+	// Path is the directory volumes are created in when the daemon is disabled. The user is
+	// responsible for creating it. (default: /mnt/home-cloud)
+	Path string `json:"path,omitempty"`
+}
+
+type StorageClassPersistenceSpec struct {
+	// Name of the StorageClass. The default StorageClass of the cluster is used when empty.
+	Name string `json:"name,omitempty"`
+	// AccessMode of the claims. (default: ReadWriteOnce)
+	AccessMode string `json:"accessMode,omitempty"`
+}
+
+type NFSPersistenceSpec struct {
+	// Server is the hostname or IP address of the NFS server.
+	Server string `json:"server"`
+	// Path is the exported directory that volumes are created in.
+	Path string `json:"path"`
+}
+
+// ResourcesSpec defines the CPU and memory budget of an App. It is enforced with a ResourceQuota and a
+// LimitRange in the namespace of the App. Amounts are Kubernetes quantities: e.g. "500m" CPU or "2Gi"
+// memory.
+type ResourcesSpec struct {
+	// Requests is the total CPU and memory that the containers of the App can request.
+	Requests ResourceAmounts `json:"requests,omitempty"`
+	// Limits is the total CPU and memory that the containers of the App can be limited to.
+	Limits ResourceAmounts `json:"limits,omitempty"`
+	// DefaultRequests are set on containers which don't declare their own requests. (default: none
+	// unless Requests is set in which case containers request nothing so that they fit the quota)
+	DefaultRequests ResourceAmounts `json:"defaultRequests,omitempty" yaml:"defaultRequests"`
+	// DefaultLimits are set on containers which don't declare their own limits. (default: none so when
+	// Limits is set, containers which don't declare limits are rejected by the quota unless this is set)
+	DefaultLimits ResourceAmounts `json:"defaultLimits,omitempty" yaml:"defaultLimits"`
+}
+
+type ResourceAmounts struct {
+	CPU    string `json:"cpu,omitempty"`
+	Memory string `json:"memory,omitempty"`
+}
+
+type IsolationSpec struct {
+	// Enable denies traffic into the namespace of each App except from the App itself, the Apps which
+	// depend on it (through chart dependencies or databases), the gateway of its routes and the
+	// Install namespace. This is enforced with Istio AuthorizationPolicies or, when Istio is disabled,
+	// NetworkPolicies (which need a CNI that supports them). (default: false)
+	Enable bool `json:"enable,omitempty"`
+	// AllowNamespaces are namespaces which are allowed to reach every App: e.g. for monitoring.
+	AllowNamespaces []string `json:"allowNamespaces,omitempty"`
+}
+
+type BundleSpec struct {
+	// Path is the directory the bundle is extracted to on the host. It is mounted into the operator
+	// which reads the release manifest, CRDs, Gateway API CRDs and the Istio charts from it. Apps
+	// are installed from the charts of the bundle when it has the chart version. The container
+	// images listed in the bundle must be made available to the cluster separately: e.g. through a
+	// registry mirror. To upgrade, extract the bundle of the new release and set Version.
+	// (default: /var/lib/home-cloud/bundle)
+	Path string `json:"path,omitempty"`
+}
+
+type TLSSpec struct {
+	// Disable turns off issuing certificates and serving App routes over HTTPS. Only the Istio
+	// ingress gateway is configured, so TLS is also off when Istio is disabled or a custom
+	// Gateway is set.
+	Disable bool `json:"disable,omitempty"`
+	// RedirectHTTP redirects plain HTTP requests for App routes to HTTPS.
+	RedirectHTTP bool `json:"redirectHTTP,omitempty"`
+	// ACME issues certificates for hostnames not ending in .local from an ACME CA (e.g. Let's
+	// Encrypt) using DNS-01 challenges. All other hostnames are issued by the local CA whose root
+	// certificate can be installed on devices to trust it.
+	ACME *ACMESpec `json:"acme,omitempty"`
+}
+
+type ACMESpec struct {
+	// Email is the contact address of the ACME account.
+	Email string `json:"email,omitempty"`
+	// Server is the directory URL of the ACME CA. (default: https://acme-v02.api.letsencrypt.org/directory)
+	Server string `json:"server,omitempty"`
+	// DNS01 configures how DNS-01 challenges are answered.
+	DNS01 DNS01Spec `json:"dns01"`
+}
+
+type DNS01Spec struct {
+	// Provider is the DNS provider the challenge records are created with.
+	Provider DNSProvider `json:"provider"`
+	// CredentialsSecret is the name of a Secret in the namespace of the Install holding the API
+	// token of the DNS provider under the "token" key.
+	CredentialsSecret string `json:"credentialsSecret"`
+}
+
+// DNSProvider is a DNS service that DNS-01 challenge records can be created with.
+// +kubebuilder:validation:Enum=cloudflare
+type DNSProvider string
+
+const (
+	DNSProviderCloudflare DNSProvider = "cloudflare"
+)
+
+const (
+	// IsolationLabel is set on the policies isolating App namespaces and allowing Apps into the
+	// namespaces of their dependencies.
+	IsolationLabel = "apps.home-cloud.io/isolation"
+)
+
+const (
+	// CASecretName is the Secret in the namespace of the Install holding the local CA.
+	CASecretName = "home-cloud-ca"
+	// CertificateLabel is set on the Secrets holding the certificates of route hostnames.
+	CertificateLabel = "tls.home-cloud.io/certificate"
+	// CertificateHostnameAnnotation holds the hostname of a certificate Secret.
+	CertificateHostnameAnnotation = "tls.home-cloud.io/hostname"
+)
+
+const (
+	// AccountsSecretName is the Secret in the namespace of the Install holding the Home Cloud accounts
+	// which users sign in to Apps with.
+	AccountsSecretName = "home-cloud-accounts"
+	// IdentitySecretName is the Secret in the namespace of the Install holding the signing keys of the
+	// identity provider.
+	IdentitySecretName = "home-cloud-identity"
+	// AuthProviderName is the Istio extension provider which authenticates requests to App routes
+	// against the identity provider.
+	AuthProviderName = "home-cloud-auth"
+	// OIDCClientLabel is set on the Secrets which register OIDC clients with the identity provider.
+	OIDCClientLabel = "identity.home-cloud.io/client"
+	// OIDCRedirectURIsAnnotation holds the comma separated redirect URIs of an OIDC client Secret.
+	OIDCRedirectURIsAnnotation = "identity.home-cloud.io/redirect-uris"
+	// OIDCClientIDKey, OIDCClientSecretKey and OIDCIssuerURLKey are the keys of an OIDC client Secret.
+	OIDCClientIDKey     = "clientID"
+	OIDCClientSecretKey = "clientSecret"
+	OIDCIssuerURLKey    = "issuerURL"
+	// OIDCInternalURLKey holds the URL Apps can reach the identity provider at from inside the cluster
+	// (e.g. for the token and userinfo endpoints) as the issuer URL usually only resolves on the LAN.
+	OIDCInternalURLKey = "internalURL"
+)
+
 type ImageVersion struct {
 	Image string
 	Tag   string
@@ -0,0 +1,75 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RestoreSpec defines the desired state of a Restore
+type RestoreSpec struct {
+	// Backup is the name of the completed Backup to restore the App from. The Backup must be in the
+	// same namespace as the Restore.
+	Backup string `json:"backup"`
+}
+
+// RestoreStatus defines the observed state of a Restore
+type RestoreStatus struct {
+	// Phase is a high-level summary of where the Restore is in its lifecycle.
+	Phase RestorePhase `json:"phase,omitempty"`
+	// App is the name of the App being restored.
+	App string `json:"app,omitempty"`
+	// Replicas records the replicas of each workload of the App (keyed by <kind>/<name>) before it
+	// was stopped so that they can be started again once the Restore is done.
+	Replicas map[string]int32 `json:"replicas,omitempty"`
+	// StartTime is when the restore was started.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is when the restore completed (or failed).
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Error is the reason the restore failed.
+	Error string `json:"error,omitempty"`
+}
+
+// RestorePhase is a high-level summary of where a Restore is in its lifecycle.
+type RestorePhase string
+
+const (
+	// RestorePhaseStopping is while the workloads of the App are scaled down.
+	RestorePhaseStopping RestorePhase = "Stopping"
+	// RestorePhaseRestoring is while the databases and volumes of the App are restored.
+	RestorePhaseRestoring RestorePhase = "Restoring"
+	// RestorePhaseCompleted is once the App has been restored and its workloads started again.
+	RestorePhaseCompleted RestorePhase = "Completed"
+	// RestorePhaseFailed is once the restore has failed and the workloads of the App started again.
+	RestorePhaseFailed RestorePhase = "Failed"
+)
+
+// RestoreLabel is set on the resources created for a Restore with the name of the Restore.
+const RestoreLabel = "backups.home-cloud.io/restore"
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Backup",type=string,JSONPath=`.spec.backup`
+//+kubebuilder:printcolumn:name="App",type=string,JSONPath=`.status.app`
+//+kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// Restore is the Schema for the restores API
+type Restore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RestoreSpec   `json:"spec,omitempty"`
+	Status RestoreStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// RestoreList contains a list of Restores
+type RestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Restore `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Restore{}, &RestoreList{})
+}
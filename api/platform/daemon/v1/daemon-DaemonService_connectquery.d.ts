@@ -36,3 +36,7 @@ export const createVolume: typeof DaemonService["method"]["createVolume"];
  * @generated from rpc platform.daemon.v1.DaemonService.DeleteVolume
  */
 export const deleteVolume: typeof DaemonService["method"]["deleteVolume"];
+/**
+ * @generated from rpc platform.daemon.v1.DaemonService.ResizeVolume
+ */
+export const resizeVolume: typeof DaemonService["method"]["resizeVolume"];
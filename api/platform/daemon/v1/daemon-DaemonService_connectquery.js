@@ -43,3 +43,8 @@ export const createVolume = DaemonService.method.createVolume;
  * @generated from rpc platform.daemon.v1.DaemonService.DeleteVolume
  */
 export const deleteVolume = DaemonService.method.deleteVolume;
+
+/**
+ * @generated from rpc platform.daemon.v1.DaemonService.ResizeVolume
+ */
+export const resizeVolume = DaemonService.method.resizeVolume;
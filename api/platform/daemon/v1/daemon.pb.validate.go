@@ -1709,3 +1709,213 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = DeleteVolumeResponseValidationError{}
+
+// Validate checks the field values on ResizeVolumeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResizeVolumeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResizeVolumeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResizeVolumeRequestMultiError, or nil if none found.
+func (m *ResizeVolumeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResizeVolumeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for MinSize
+
+	// no validation rules for MaxSize
+
+	if len(errors) > 0 {
+		return ResizeVolumeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResizeVolumeRequestMultiError is an error wrapping multiple validation
+// errors returned by ResizeVolumeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResizeVolumeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResizeVolumeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResizeVolumeRequestMultiError) AllErrors() []error { return m }
+
+// ResizeVolumeRequestValidationError is the validation error returned by
+// ResizeVolumeRequest.Validate if the designated constraints aren't met.
+type ResizeVolumeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResizeVolumeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResizeVolumeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResizeVolumeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResizeVolumeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResizeVolumeRequestValidationError) ErrorName() string {
+	return "ResizeVolumeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResizeVolumeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResizeVolumeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResizeVolumeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResizeVolumeRequestValidationError{}
+
+// Validate checks the field values on ResizeVolumeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResizeVolumeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResizeVolumeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResizeVolumeResponseMultiError, or nil if none found.
+func (m *ResizeVolumeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResizeVolumeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ResizeVolumeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResizeVolumeResponseMultiError is an error wrapping multiple validation
+// errors returned by ResizeVolumeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResizeVolumeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResizeVolumeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResizeVolumeResponseMultiError) AllErrors() []error { return m }
+
+// ResizeVolumeResponseValidationError is the validation error returned by
+// ResizeVolumeResponse.Validate if the designated constraints aren't met.
+type ResizeVolumeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResizeVolumeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResizeVolumeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResizeVolumeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResizeVolumeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResizeVolumeResponseValidationError) ErrorName() string {
+	return "ResizeVolumeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResizeVolumeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResizeVolumeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResizeVolumeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResizeVolumeResponseValidationError{}
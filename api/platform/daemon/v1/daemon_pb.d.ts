@@ -262,6 +262,50 @@ export declare type DeleteVolumeResponse = Message<"platform.daemon.v1.DeleteVol
  */
 export declare const DeleteVolumeResponseSchema: GenMessage<DeleteVolumeResponse>;
 
+/**
+ * @generated from message platform.daemon.v1.ResizeVolumeRequest
+ */
+export declare type ResizeVolumeRequest = Message<"platform.daemon.v1.ResizeVolumeRequest"> & {
+  /**
+   * identifier of the volume as returned by CreateVolume()
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * minimum size of the volume: parsed with https://github.com/dustin/go-humanize
+   *
+   * @generated from field: string min_size = 2;
+   */
+  minSize: string;
+
+  /**
+   * maximum size of the volume: parsed with https://github.com/dustin/go-humanize
+   *
+   * @generated from field: string max_size = 3;
+   */
+  maxSize: string;
+};
+
+/**
+ * Describes the message platform.daemon.v1.ResizeVolumeRequest.
+ * Use `create(ResizeVolumeRequestSchema)` to create a new message.
+ */
+export declare const ResizeVolumeRequestSchema: GenMessage<ResizeVolumeRequest>;
+
+/**
+ * @generated from message platform.daemon.v1.ResizeVolumeResponse
+ */
+export declare type ResizeVolumeResponse = Message<"platform.daemon.v1.ResizeVolumeResponse"> & {
+};
+
+/**
+ * Describes the message platform.daemon.v1.ResizeVolumeResponse.
+ * Use `create(ResizeVolumeResponseSchema)` to create a new message.
+ */
+export declare const ResizeVolumeResponseSchema: GenMessage<ResizeVolumeResponse>;
+
 /**
  * @generated from service platform.daemon.v1.DaemonService
  */
@@ -330,5 +374,13 @@ export declare const DaemonService: GenService<{
     input: typeof DeleteVolumeRequestSchema;
     output: typeof DeleteVolumeResponseSchema;
   },
+  /**
+   * @generated from rpc platform.daemon.v1.DaemonService.ResizeVolume
+   */
+  resizeVolume: {
+    methodKind: "unary";
+    input: typeof ResizeVolumeRequestSchema;
+    output: typeof ResizeVolumeResponseSchema;
+  },
 }>;
 
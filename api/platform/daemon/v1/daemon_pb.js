@@ -9,7 +9,7 @@ import { file_platform_daemon_v1_system } from "./system_pb";
  * Describes the file platform/daemon/v1/daemon.proto.
  */
 export const file_platform_daemon_v1_daemon = /*@__PURE__*/
-  fileDesc("Ch9wbGF0Zm9ybS9kYWVtb24vdjEvZGFlbW9uLnByb3RvEhJwbGF0Zm9ybS5kYWVtb24udjEiFQoTU2h1dGRvd25Ib3N0UmVxdWVzdCIWChRTaHV0ZG93bkhvc3RSZXNwb25zZSITChFSZWJvb3RIb3N0UmVxdWVzdCIUChJSZWJvb3RIb3N0UmVzcG9uc2UiFAoSU3lzdGVtU3RhdHNSZXF1ZXN0IkUKE1N5c3RlbVN0YXRzUmVzcG9uc2USLgoFc3RhdHMYASABKAsyHy5wbGF0Zm9ybS5kYWVtb24udjEuU3lzdGVtU3RhdHMiEAoOVmVyc2lvblJlcXVlc3QiMAoPVmVyc2lvblJlc3BvbnNlEgwKBG5hbWUYASABKAkSDwoHdmVyc2lvbhgCIAEoCSIxCg5VcGdyYWRlUmVxdWVzdBIOCgZzb3VyY2UYASABKAkSDwoHdmVyc2lvbhgCIAEoCSIRCg9VcGdyYWRlUmVzcG9uc2UiKwoYVXBncmFkZUt1YmVybmV0ZXNSZXF1ZXN0Eg8KB3ZlcnNpb24YASABKAkiGwoZVXBncmFkZUt1YmVybmV0ZXNSZXNwb25zZSJHChNDcmVhdGVWb2x1bWVSZXF1ZXN0EgwKBG5hbWUYASABKAkSEAoIbWluX3NpemUYAiABKAkSEAoIbWF4X3NpemUYAyABKAkiMAoUQ3JlYXRlVm9sdW1lUmVzcG9uc2USCgoCaWQYASABKAkSDAoEcGF0aBgCIAEoCSIhChNEZWxldGVWb2x1bWVSZXF1ZXN0EgoKAmlkGAEgASgJIhYKFERlbGV0ZVZvbHVtZVJlc3BvbnNlMp8GCg1EYWVtb25TZXJ2aWNlEmMKDFNodXRkb3duSG9zdBInLnBsYXRmb3JtLmRhZW1vbi52MS5TaHV0ZG93bkhvc3RSZXF1ZXN0GigucGxhdGZvcm0uZGFlbW9uLnYxLlNodXRkb3duSG9zdFJlc3BvbnNlIgASXQoKUmVib290SG9zdBIlLnBsYXRmb3JtLmRhZW1vbi52MS5SZWJvb3RIb3N0UmVxdWVzdBomLnBsYXRmb3JtLmRhZW1vbi52MS5SZWJvb3RIb3N0UmVzcG9uc2UiABJgCgtTeXN0ZW1TdGF0cxImLnBsYXRmb3JtLmRhZW1vbi52MS5TeXN0ZW1TdGF0c1JlcXVlc3QaJy5wbGF0Zm9ybS5kYWVtb24udjEuU3lzdGVtU3RhdHNSZXNwb25zZSIAElQKB1ZlcnNpb24SIi5wbGF0Zm9ybS5kYWVtb24udjEuVmVyc2lvblJlcXVlc3QaIy5wbGF0Zm9ybS5kYWVtb24udjEuVmVyc2lvblJlc3BvbnNlIgASVAoHVXBncmFkZRIiLnBsYXRmb3JtLmRhZW1vbi52MS5VcGdyYWRlUmVxdWVzdBojLnBsYXRmb3JtLmRhZW1vbi52MS5VcGdyYWRlUmVzcG9uc2UiABJyChFVcGdyYWRlS3ViZXJuZXRlcxIsLnBsYXRmb3JtLmRhZW1vbi52MS5VcGdyYWRlS3ViZXJuZXRlc1JlcXVlc3QaLS5wbGF0Zm9ybS5kYWVtb24udjEuVXBncmFkZUt1YmVybmV0ZXNSZXNwb25zZSIAEmMKDENyZWF0ZVZvbHVtZRInLnBsYXRmb3JtLmRhZW1vbi52MS5DcmVhdGVWb2x1bWVSZXF1ZXN0GigucGxhdGZvcm0uZGFlbW9uLnYxLkNyZWF0ZVZvbHVtZVJlc3BvbnNlIgASYwoMRGVsZXRlVm9sdW1lEicucGxhdGZvcm0uZGFlbW9uLnYxLkRlbGV0ZVZvbHVtZVJlcXVlc3QaKC5wbGF0Zm9ybS5kYWVtb24udjEuRGVsZXRlVm9sdW1lUmVzcG9uc2UiAEI2WjRnaXRodWIuY29tL2hvbWUtY2xvdWQtaW8vY29yZS9hcGkvcGxhdGZvcm0vZGFlbW9uL3YxYgZwcm90bzM", [file_platform_daemon_v1_system]);
+  fileDesc("Ch9wbGF0Zm9ybS9kYWVtb24vdjEvZGFlbW9uLnByb3RvEhJwbGF0Zm9ybS5kYWVtb24udjEiFQoTU2h1dGRvd25Ib3N0UmVxdWVzdCIWChRTaHV0ZG93bkhvc3RSZXNwb25zZSITChFSZWJvb3RIb3N0UmVxdWVzdCIUChJSZWJvb3RIb3N0UmVzcG9uc2UiFAoSU3lzdGVtU3RhdHNSZXF1ZXN0IkUKE1N5c3RlbVN0YXRzUmVzcG9uc2USLgoFc3RhdHMYASABKAsyHy5wbGF0Zm9ybS5kYWVtb24udjEuU3lzdGVtU3RhdHMiEAoOVmVyc2lvblJlcXVlc3QiMAoPVmVyc2lvblJlc3BvbnNlEgwKBG5hbWUYASABKAkSDwoHdmVyc2lvbhgCIAEoCSIxCg5VcGdyYWRlUmVxdWVzdBIOCgZzb3VyY2UYASABKAkSDwoHdmVyc2lvbhgCIAEoCSIRCg9VcGdyYWRlUmVzcG9uc2UiKwoYVXBncmFkZUt1YmVybmV0ZXNSZXF1ZXN0Eg8KB3ZlcnNpb24YASABKAkiGwoZVXBncmFkZUt1YmVybmV0ZXNSZXNwb25zZSJHChNDcmVhdGVWb2x1bWVSZXF1ZXN0EgwKBG5hbWUYASABKAkSEAoIbWluX3NpemUYAiABKAkSEAoIbWF4X3NpemUYAyABKAkiMAoUQ3JlYXRlVm9sdW1lUmVzcG9uc2USCgoCaWQYASABKAkSDAoEcGF0aBgCIAEoCSIhChNEZWxldGVWb2x1bWVSZXF1ZXN0EgoKAmlkGAEgASgJIhYKFERlbGV0ZVZvbHVtZVJlc3BvbnNlIkUKE1Jlc2l6ZVZvbHVtZVJlcXVlc3QSCgoCaWQYASABKAkSEAoIbWluX3NpemUYAiABKAkSEAoIbWF4X3NpemUYAyABKAkiFgoUUmVzaXplVm9sdW1lUmVzcG9uc2UyhAcKDURhZW1vblNlcnZpY2USYwoMU2h1dGRvd25Ib3N0EicucGxhdGZvcm0uZGFlbW9uLnYxLlNodXRkb3duSG9zdFJlcXVlc3QaKC5wbGF0Zm9ybS5kYWVtb24udjEuU2h1dGRvd25Ib3N0UmVzcG9uc2UiABJdCgpSZWJvb3RIb3N0EiUucGxhdGZvcm0uZGFlbW9uLnYxLlJlYm9vdEhvc3RSZXF1ZXN0GiYucGxhdGZvcm0uZGFlbW9uLnYxLlJlYm9vdEhvc3RSZXNwb25zZSIAEmAKC1N5c3RlbVN0YXRzEiYucGxhdGZvcm0uZGFlbW9uLnYxLlN5c3RlbVN0YXRzUmVxdWVzdBonLnBsYXRmb3JtLmRhZW1vbi52MS5TeXN0ZW1TdGF0c1Jlc3BvbnNlIgASVAoHVmVyc2lvbhIiLnBsYXRmb3JtLmRhZW1vbi52MS5WZXJzaW9uUmVxdWVzdBojLnBsYXRmb3JtLmRhZW1vbi52MS5WZXJzaW9uUmVzcG9uc2UiABJUCgdVcGdyYWRlEiIucGxhdGZvcm0uZGFlbW9uLnYxLlVwZ3JhZGVSZXF1ZXN0GiMucGxhdGZvcm0uZGFlbW9uLnYxLlVwZ3JhZGVSZXNwb25zZSIAEnIKEVVwZ3JhZGVLdWJlcm5ldGVzEiwucGxhdGZvcm0uZGFlbW9uLnYxLlVwZ3JhZGVLdWJlcm5ldGVzUmVxdWVzdBotLnBsYXRmb3JtLmRhZW1vbi52MS5VcGdyYWRlS3ViZXJuZXRlc1Jlc3BvbnNlIgASYwoMQ3JlYXRlVm9sdW1lEicucGxhdGZvcm0uZGFlbW9uLnYxLkNyZWF0ZVZvbHVtZVJlcXVlc3QaKC5wbGF0Zm9ybS5kYWVtb24udjEuQ3JlYXRlVm9sdW1lUmVzcG9uc2UiABJjCgxEZWxldGVWb2x1bWUSJy5wbGF0Zm9ybS5kYWVtb24udjEuRGVsZXRlVm9sdW1lUmVxdWVzdBooLnBsYXRmb3JtLmRhZW1vbi52MS5EZWxldGVWb2x1bWVSZXNwb25zZSIAEmMKDFJlc2l6ZVZvbHVtZRInLnBsYXRmb3JtLmRhZW1vbi52MS5SZXNpemVWb2x1bWVSZXF1ZXN0GigucGxhdGZvcm0uZGFlbW9uLnYxLlJlc2l6ZVZvbHVtZVJlc3BvbnNlIgBCNlo0Z2l0aHViLmNvbS9ob21lLWNsb3VkLWlvL2NvcmUvYXBpL3BsYXRmb3JtL2RhZW1vbi92MWIGcHJvdG8z", [file_platform_daemon_v1_system]);
 
 /**
  * Describes the message platform.daemon.v1.ShutdownHostRequest.
@@ -123,6 +123,20 @@ export const DeleteVolumeRequestSchema = /*@__PURE__*/
 export const DeleteVolumeResponseSchema = /*@__PURE__*/
   messageDesc(file_platform_daemon_v1_daemon, 15);
 
+/**
+ * Describes the message platform.daemon.v1.ResizeVolumeRequest.
+ * Use `create(ResizeVolumeRequestSchema)` to create a new message.
+ */
+export const ResizeVolumeRequestSchema = /*@__PURE__*/
+  messageDesc(file_platform_daemon_v1_daemon, 16);
+
+/**
+ * Describes the message platform.daemon.v1.ResizeVolumeResponse.
+ * Use `create(ResizeVolumeResponseSchema)` to create a new message.
+ */
+export const ResizeVolumeResponseSchema = /*@__PURE__*/
+  messageDesc(file_platform_daemon_v1_daemon, 17);
+
 /**
  * @generated from service platform.daemon.v1.DaemonService
  */
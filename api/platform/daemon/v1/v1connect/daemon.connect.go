@@ -55,6 +55,9 @@ const (
 	// DaemonServiceDeleteVolumeProcedure is the fully-qualified name of the DaemonService's
 	// DeleteVolume RPC.
 	DaemonServiceDeleteVolumeProcedure = "/platform.daemon.v1.DaemonService/DeleteVolume"
+	// DaemonServiceResizeVolumeProcedure is the fully-qualified name of the DaemonService's
+	// ResizeVolume RPC.
+	DaemonServiceResizeVolumeProcedure = "/platform.daemon.v1.DaemonService/ResizeVolume"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -68,6 +71,7 @@ var (
 	daemonServiceUpgradeKubernetesMethodDescriptor = daemonServiceServiceDescriptor.Methods().ByName("UpgradeKubernetes")
 	daemonServiceCreateVolumeMethodDescriptor      = daemonServiceServiceDescriptor.Methods().ByName("CreateVolume")
 	daemonServiceDeleteVolumeMethodDescriptor      = daemonServiceServiceDescriptor.Methods().ByName("DeleteVolume")
+	daemonServiceResizeVolumeMethodDescriptor      = daemonServiceServiceDescriptor.Methods().ByName("ResizeVolume")
 )
 
 // DaemonServiceClient is a client for the platform.daemon.v1.DaemonService service.
@@ -80,6 +84,7 @@ type DaemonServiceClient interface {
 	UpgradeKubernetes(context.Context, *connect.Request[v1.UpgradeKubernetesRequest]) (*connect.Response[v1.UpgradeKubernetesResponse], error)
 	CreateVolume(context.Context, *connect.Request[v1.CreateVolumeRequest]) (*connect.Response[v1.CreateVolumeResponse], error)
 	DeleteVolume(context.Context, *connect.Request[v1.DeleteVolumeRequest]) (*connect.Response[v1.DeleteVolumeResponse], error)
+	ResizeVolume(context.Context, *connect.Request[v1.ResizeVolumeRequest]) (*connect.Response[v1.ResizeVolumeResponse], error)
 }
 
 // NewDaemonServiceClient constructs a client for the platform.daemon.v1.DaemonService service. By
@@ -140,6 +145,12 @@ func NewDaemonServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(daemonServiceDeleteVolumeMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		resizeVolume: connect.NewClient[v1.ResizeVolumeRequest, v1.ResizeVolumeResponse](
+			httpClient,
+			baseURL+DaemonServiceResizeVolumeProcedure,
+			connect.WithSchema(daemonServiceResizeVolumeMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -153,6 +164,7 @@ type daemonServiceClient struct {
 	upgradeKubernetes *connect.Client[v1.UpgradeKubernetesRequest, v1.UpgradeKubernetesResponse]
 	createVolume      *connect.Client[v1.CreateVolumeRequest, v1.CreateVolumeResponse]
 	deleteVolume      *connect.Client[v1.DeleteVolumeRequest, v1.DeleteVolumeResponse]
+	resizeVolume      *connect.Client[v1.ResizeVolumeRequest, v1.ResizeVolumeResponse]
 }
 
 // ShutdownHost calls platform.daemon.v1.DaemonService.ShutdownHost.
@@ -195,6 +207,11 @@ func (c *daemonServiceClient) DeleteVolume(ctx context.Context, req *connect.Req
 	return c.deleteVolume.CallUnary(ctx, req)
 }
 
+// ResizeVolume calls platform.daemon.v1.DaemonService.ResizeVolume.
+func (c *daemonServiceClient) ResizeVolume(ctx context.Context, req *connect.Request[v1.ResizeVolumeRequest]) (*connect.Response[v1.ResizeVolumeResponse], error) {
+	return c.resizeVolume.CallUnary(ctx, req)
+}
+
 // DaemonServiceHandler is an implementation of the platform.daemon.v1.DaemonService service.
 type DaemonServiceHandler interface {
 	ShutdownHost(context.Context, *connect.Request[v1.ShutdownHostRequest]) (*connect.Response[v1.ShutdownHostResponse], error)
@@ -205,6 +222,7 @@ type DaemonServiceHandler interface {
 	UpgradeKubernetes(context.Context, *connect.Request[v1.UpgradeKubernetesRequest]) (*connect.Response[v1.UpgradeKubernetesResponse], error)
 	CreateVolume(context.Context, *connect.Request[v1.CreateVolumeRequest]) (*connect.Response[v1.CreateVolumeResponse], error)
 	DeleteVolume(context.Context, *connect.Request[v1.DeleteVolumeRequest]) (*connect.Response[v1.DeleteVolumeResponse], error)
+	ResizeVolume(context.Context, *connect.Request[v1.ResizeVolumeRequest]) (*connect.Response[v1.ResizeVolumeResponse], error)
 }
 
 // NewDaemonServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -261,6 +279,12 @@ func NewDaemonServiceHandler(svc DaemonServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(daemonServiceDeleteVolumeMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	daemonServiceResizeVolumeHandler := connect.NewUnaryHandler(
+		DaemonServiceResizeVolumeProcedure,
+		svc.ResizeVolume,
+		connect.WithSchema(daemonServiceResizeVolumeMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/platform.daemon.v1.DaemonService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case DaemonServiceShutdownHostProcedure:
@@ -279,6 +303,8 @@ func NewDaemonServiceHandler(svc DaemonServiceHandler, opts ...connect.HandlerOp
 			daemonServiceCreateVolumeHandler.ServeHTTP(w, r)
 		case DaemonServiceDeleteVolumeProcedure:
 			daemonServiceDeleteVolumeHandler.ServeHTTP(w, r)
+		case DaemonServiceResizeVolumeProcedure:
+			daemonServiceResizeVolumeHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -319,3 +345,7 @@ func (UnimplementedDaemonServiceHandler) CreateVolume(context.Context, *connect.
 func (UnimplementedDaemonServiceHandler) DeleteVolume(context.Context, *connect.Request[v1.DeleteVolumeRequest]) (*connect.Response[v1.DeleteVolumeResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("platform.daemon.v1.DaemonService.DeleteVolume is not implemented"))
 }
+
+func (UnimplementedDaemonServiceHandler) ResizeVolume(context.Context, *connect.Request[v1.ResizeVolumeRequest]) (*connect.Response[v1.ResizeVolumeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("platform.daemon.v1.DaemonService.ResizeVolume is not implemented"))
+}
@@ -50,6 +50,14 @@ const (
 	// WebServiceGetAppStorageProcedure is the fully-qualified name of the WebService's GetAppStorage
 	// RPC.
 	WebServiceGetAppStorageProcedure = "/platform.server.v1.WebService/GetAppStorage"
+	// WebServiceListAppBackupsProcedure is the fully-qualified name of the WebService's ListAppBackups
+	// RPC.
+	WebServiceListAppBackupsProcedure = "/platform.server.v1.WebService/ListAppBackups"
+	// WebServiceRestoreAppProcedure is the fully-qualified name of the WebService's RestoreApp RPC.
+	WebServiceRestoreAppProcedure = "/platform.server.v1.WebService/RestoreApp"
+	// WebServicePreviewAppChangeProcedure is the fully-qualified name of the WebService's
+	// PreviewAppChange RPC.
+	WebServicePreviewAppChangeProcedure = "/platform.server.v1.WebService/PreviewAppChange"
 	// WebServiceShutdownHostProcedure is the fully-qualified name of the WebService's ShutdownHost RPC.
 	WebServiceShutdownHostProcedure = "/platform.server.v1.WebService/ShutdownHost"
 	// WebServiceRestartHostProcedure is the fully-qualified name of the WebService's RestartHost RPC.
@@ -69,6 +77,16 @@ const (
 	// WebServiceSetDeviceSettingsProcedure is the fully-qualified name of the WebService's
 	// SetDeviceSettings RPC.
 	WebServiceSetDeviceSettingsProcedure = "/platform.server.v1.WebService/SetDeviceSettings"
+	// WebServiceGetCACertificateProcedure is the fully-qualified name of the WebService's
+	// GetCACertificate RPC.
+	WebServiceGetCACertificateProcedure = "/platform.server.v1.WebService/GetCACertificate"
+	// WebServiceListAccountsProcedure is the fully-qualified name of the WebService's ListAccounts RPC.
+	WebServiceListAccountsProcedure = "/platform.server.v1.WebService/ListAccounts"
+	// WebServiceSaveAccountProcedure is the fully-qualified name of the WebService's SaveAccount RPC.
+	WebServiceSaveAccountProcedure = "/platform.server.v1.WebService/SaveAccount"
+	// WebServiceDeleteAccountProcedure is the fully-qualified name of the WebService's DeleteAccount
+	// RPC.
+	WebServiceDeleteAccountProcedure = "/platform.server.v1.WebService/DeleteAccount"
 	// WebServiceEnableSecureTunnellingProcedure is the fully-qualified name of the WebService's
 	// EnableSecureTunnelling RPC.
 	WebServiceEnableSecureTunnellingProcedure = "/platform.server.v1.WebService/EnableSecureTunnelling"
@@ -98,6 +116,9 @@ var (
 	webServiceAppsHealthCheckMethodDescriptor         = webServiceServiceDescriptor.Methods().ByName("AppsHealthCheck")
 	webServiceGetAppsInStoreMethodDescriptor          = webServiceServiceDescriptor.Methods().ByName("GetAppsInStore")
 	webServiceGetAppStorageMethodDescriptor           = webServiceServiceDescriptor.Methods().ByName("GetAppStorage")
+	webServiceListAppBackupsMethodDescriptor          = webServiceServiceDescriptor.Methods().ByName("ListAppBackups")
+	webServiceRestoreAppMethodDescriptor              = webServiceServiceDescriptor.Methods().ByName("RestoreApp")
+	webServicePreviewAppChangeMethodDescriptor        = webServiceServiceDescriptor.Methods().ByName("PreviewAppChange")
 	webServiceShutdownHostMethodDescriptor            = webServiceServiceDescriptor.Methods().ByName("ShutdownHost")
 	webServiceRestartHostMethodDescriptor             = webServiceServiceDescriptor.Methods().ByName("RestartHost")
 	webServiceGetSystemStatsMethodDescriptor          = webServiceServiceDescriptor.Methods().ByName("GetSystemStats")
@@ -105,6 +126,10 @@ var (
 	webServiceGetSystemLogsMethodDescriptor           = webServiceServiceDescriptor.Methods().ByName("GetSystemLogs")
 	webServiceGetDeviceSettingsMethodDescriptor       = webServiceServiceDescriptor.Methods().ByName("GetDeviceSettings")
 	webServiceSetDeviceSettingsMethodDescriptor       = webServiceServiceDescriptor.Methods().ByName("SetDeviceSettings")
+	webServiceGetCACertificateMethodDescriptor        = webServiceServiceDescriptor.Methods().ByName("GetCACertificate")
+	webServiceListAccountsMethodDescriptor            = webServiceServiceDescriptor.Methods().ByName("ListAccounts")
+	webServiceSaveAccountMethodDescriptor             = webServiceServiceDescriptor.Methods().ByName("SaveAccount")
+	webServiceDeleteAccountMethodDescriptor           = webServiceServiceDescriptor.Methods().ByName("DeleteAccount")
 	webServiceEnableSecureTunnellingMethodDescriptor  = webServiceServiceDescriptor.Methods().ByName("EnableSecureTunnelling")
 	webServiceDisableSecureTunnellingMethodDescriptor = webServiceServiceDescriptor.Methods().ByName("DisableSecureTunnelling")
 	webServiceRegisterToLocatorMethodDescriptor       = webServiceServiceDescriptor.Methods().ByName("RegisterToLocator")
@@ -129,6 +154,12 @@ type WebServiceClient interface {
 	GetAppsInStore(context.Context, *connect.Request[v1.GetAppsInStoreRequest]) (*connect.Response[v1.GetAppsInStoreResponse], error)
 	// Get all installed app storage volumes
 	GetAppStorage(context.Context, *connect.Request[v1.GetAppStorageRequest]) (*connect.Response[v1.GetAppStorageResponse], error)
+	// Get all backups of an installed app
+	ListAppBackups(context.Context, *connect.Request[v1.ListAppBackupsRequest]) (*connect.Response[v1.ListAppBackupsResponse], error)
+	// Restore an installed app from one of its backups
+	RestoreApp(context.Context, *connect.Request[v1.RestoreAppRequest]) (*connect.Response[v1.RestoreAppResponse], error)
+	// Preview the changes of installing or updating a Home Cloud application without applying them
+	PreviewAppChange(context.Context, *connect.Request[v1.PreviewAppChangeRequest]) (*connect.Response[v1.PreviewAppChangeResponse], error)
 	// Shutdown the host machine running Home Cloud
 	ShutdownHost(context.Context, *connect.Request[v1.ShutdownHostRequest]) (*connect.Response[v1.ShutdownHostResponse], error)
 	// Restart the host machine running Home Cloud
@@ -143,6 +174,14 @@ type WebServiceClient interface {
 	GetDeviceSettings(context.Context, *connect.Request[v1.GetDeviceSettingsRequest]) (*connect.Response[v1.GetDeviceSettingsResponse], error)
 	// Set the device settings
 	SetDeviceSettings(context.Context, *connect.Request[v1.SetDeviceSettingsRequest]) (*connect.Response[v1.SetDeviceSettingsResponse], error)
+	// Get the root certificate of the local CA which issues certificates for app routes
+	GetCACertificate(context.Context, *connect.Request[v1.GetCACertificateRequest]) (*connect.Response[v1.GetCACertificateResponse], error)
+	// List the Home Cloud accounts which users sign in to apps with
+	ListAccounts(context.Context, *connect.Request[v1.ListAccountsRequest]) (*connect.Response[v1.ListAccountsResponse], error)
+	// Create a Home Cloud account or update its email and password
+	SaveAccount(context.Context, *connect.Request[v1.SaveAccountRequest]) (*connect.Response[v1.SaveAccountResponse], error)
+	// Delete a Home Cloud account
+	DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error)
 	// Enables the remote access feature
 	EnableSecureTunnelling(context.Context, *connect.Request[v1.EnableSecureTunnellingRequest]) (*connect.Response[v1.EnableSecureTunnellingResponse], error)
 	// Disables the remote access feature
@@ -209,6 +248,24 @@ func NewWebServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(webServiceGetAppStorageMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		listAppBackups: connect.NewClient[v1.ListAppBackupsRequest, v1.ListAppBackupsResponse](
+			httpClient,
+			baseURL+WebServiceListAppBackupsProcedure,
+			connect.WithSchema(webServiceListAppBackupsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		restoreApp: connect.NewClient[v1.RestoreAppRequest, v1.RestoreAppResponse](
+			httpClient,
+			baseURL+WebServiceRestoreAppProcedure,
+			connect.WithSchema(webServiceRestoreAppMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		previewAppChange: connect.NewClient[v1.PreviewAppChangeRequest, v1.PreviewAppChangeResponse](
+			httpClient,
+			baseURL+WebServicePreviewAppChangeProcedure,
+			connect.WithSchema(webServicePreviewAppChangeMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		shutdownHost: connect.NewClient[v1.ShutdownHostRequest, v1.ShutdownHostResponse](
 			httpClient,
 			baseURL+WebServiceShutdownHostProcedure,
@@ -251,6 +308,30 @@ func NewWebServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(webServiceSetDeviceSettingsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getCACertificate: connect.NewClient[v1.GetCACertificateRequest, v1.GetCACertificateResponse](
+			httpClient,
+			baseURL+WebServiceGetCACertificateProcedure,
+			connect.WithSchema(webServiceGetCACertificateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listAccounts: connect.NewClient[v1.ListAccountsRequest, v1.ListAccountsResponse](
+			httpClient,
+			baseURL+WebServiceListAccountsProcedure,
+			connect.WithSchema(webServiceListAccountsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		saveAccount: connect.NewClient[v1.SaveAccountRequest, v1.SaveAccountResponse](
+			httpClient,
+			baseURL+WebServiceSaveAccountProcedure,
+			connect.WithSchema(webServiceSaveAccountMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAccount: connect.NewClient[v1.DeleteAccountRequest, v1.DeleteAccountResponse](
+			httpClient,
+			baseURL+WebServiceDeleteAccountProcedure,
+			connect.WithSchema(webServiceDeleteAccountMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		enableSecureTunnelling: connect.NewClient[v1.EnableSecureTunnellingRequest, v1.EnableSecureTunnellingResponse](
 			httpClient,
 			baseURL+WebServiceEnableSecureTunnellingProcedure,
@@ -299,6 +380,9 @@ type webServiceClient struct {
 	appsHealthCheck         *connect.Client[v1.AppsHealthCheckRequest, v1.AppsHealthCheckResponse]
 	getAppsInStore          *connect.Client[v1.GetAppsInStoreRequest, v1.GetAppsInStoreResponse]
 	getAppStorage           *connect.Client[v1.GetAppStorageRequest, v1.GetAppStorageResponse]
+	listAppBackups          *connect.Client[v1.ListAppBackupsRequest, v1.ListAppBackupsResponse]
+	restoreApp              *connect.Client[v1.RestoreAppRequest, v1.RestoreAppResponse]
+	previewAppChange        *connect.Client[v1.PreviewAppChangeRequest, v1.PreviewAppChangeResponse]
 	shutdownHost            *connect.Client[v1.ShutdownHostRequest, v1.ShutdownHostResponse]
 	restartHost             *connect.Client[v1.RestartHostRequest, v1.RestartHostResponse]
 	getSystemStats          *connect.Client[v1.GetSystemStatsRequest, v1.GetSystemStatsResponse]
@@ -306,6 +390,10 @@ type webServiceClient struct {
 	getSystemLogs           *connect.Client[v1.GetSystemLogsRequest, v1.GetSystemLogsResponse]
 	getDeviceSettings       *connect.Client[v1.GetDeviceSettingsRequest, v1.GetDeviceSettingsResponse]
 	setDeviceSettings       *connect.Client[v1.SetDeviceSettingsRequest, v1.SetDeviceSettingsResponse]
+	getCACertificate        *connect.Client[v1.GetCACertificateRequest, v1.GetCACertificateResponse]
+	listAccounts            *connect.Client[v1.ListAccountsRequest, v1.ListAccountsResponse]
+	saveAccount             *connect.Client[v1.SaveAccountRequest, v1.SaveAccountResponse]
+	deleteAccount           *connect.Client[v1.DeleteAccountRequest, v1.DeleteAccountResponse]
 	enableSecureTunnelling  *connect.Client[v1.EnableSecureTunnellingRequest, v1.EnableSecureTunnellingResponse]
 	disableSecureTunnelling *connect.Client[v1.DisableSecureTunnellingRequest, v1.DisableSecureTunnellingResponse]
 	registerToLocator       *connect.Client[v1.RegisterToLocatorRequest, v1.RegisterToLocatorResponse]
@@ -349,6 +437,21 @@ func (c *webServiceClient) GetAppStorage(ctx context.Context, req *connect.Reque
 	return c.getAppStorage.CallUnary(ctx, req)
 }
 
+// ListAppBackups calls platform.server.v1.WebService.ListAppBackups.
+func (c *webServiceClient) ListAppBackups(ctx context.Context, req *connect.Request[v1.ListAppBackupsRequest]) (*connect.Response[v1.ListAppBackupsResponse], error) {
+	return c.listAppBackups.CallUnary(ctx, req)
+}
+
+// RestoreApp calls platform.server.v1.WebService.RestoreApp.
+func (c *webServiceClient) RestoreApp(ctx context.Context, req *connect.Request[v1.RestoreAppRequest]) (*connect.Response[v1.RestoreAppResponse], error) {
+	return c.restoreApp.CallUnary(ctx, req)
+}
+
+// PreviewAppChange calls platform.server.v1.WebService.PreviewAppChange.
+func (c *webServiceClient) PreviewAppChange(ctx context.Context, req *connect.Request[v1.PreviewAppChangeRequest]) (*connect.Response[v1.PreviewAppChangeResponse], error) {
+	return c.previewAppChange.CallUnary(ctx, req)
+}
+
 // ShutdownHost calls platform.server.v1.WebService.ShutdownHost.
 func (c *webServiceClient) ShutdownHost(ctx context.Context, req *connect.Request[v1.ShutdownHostRequest]) (*connect.Response[v1.ShutdownHostResponse], error) {
 	return c.shutdownHost.CallUnary(ctx, req)
@@ -384,6 +487,26 @@ func (c *webServiceClient) SetDeviceSettings(ctx context.Context, req *connect.R
 	return c.setDeviceSettings.CallUnary(ctx, req)
 }
 
+// GetCACertificate calls platform.server.v1.WebService.GetCACertificate.
+func (c *webServiceClient) GetCACertificate(ctx context.Context, req *connect.Request[v1.GetCACertificateRequest]) (*connect.Response[v1.GetCACertificateResponse], error) {
+	return c.getCACertificate.CallUnary(ctx, req)
+}
+
+// ListAccounts calls platform.server.v1.WebService.ListAccounts.
+func (c *webServiceClient) ListAccounts(ctx context.Context, req *connect.Request[v1.ListAccountsRequest]) (*connect.Response[v1.ListAccountsResponse], error) {
+	return c.listAccounts.CallUnary(ctx, req)
+}
+
+// SaveAccount calls platform.server.v1.WebService.SaveAccount.
+func (c *webServiceClient) SaveAccount(ctx context.Context, req *connect.Request[v1.SaveAccountRequest]) (*connect.Response[v1.SaveAccountResponse], error) {
+	return c.saveAccount.CallUnary(ctx, req)
+}
+
+// DeleteAccount calls platform.server.v1.WebService.DeleteAccount.
+func (c *webServiceClient) DeleteAccount(ctx context.Context, req *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error) {
+	return c.deleteAccount.CallUnary(ctx, req)
+}
+
 // EnableSecureTunnelling calls platform.server.v1.WebService.EnableSecureTunnelling.
 func (c *webServiceClient) EnableSecureTunnelling(ctx context.Context, req *connect.Request[v1.EnableSecureTunnellingRequest]) (*connect.Response[v1.EnableSecureTunnellingResponse], error) {
 	return c.enableSecureTunnelling.CallUnary(ctx, req)
@@ -430,6 +553,12 @@ type WebServiceHandler interface {
 	GetAppsInStore(context.Context, *connect.Request[v1.GetAppsInStoreRequest]) (*connect.Response[v1.GetAppsInStoreResponse], error)
 	// Get all installed app storage volumes
 	GetAppStorage(context.Context, *connect.Request[v1.GetAppStorageRequest]) (*connect.Response[v1.GetAppStorageResponse], error)
+	// Get all backups of an installed app
+	ListAppBackups(context.Context, *connect.Request[v1.ListAppBackupsRequest]) (*connect.Response[v1.ListAppBackupsResponse], error)
+	// Restore an installed app from one of its backups
+	RestoreApp(context.Context, *connect.Request[v1.RestoreAppRequest]) (*connect.Response[v1.RestoreAppResponse], error)
+	// Preview the changes of installing or updating a Home Cloud application without applying them
+	PreviewAppChange(context.Context, *connect.Request[v1.PreviewAppChangeRequest]) (*connect.Response[v1.PreviewAppChangeResponse], error)
 	// Shutdown the host machine running Home Cloud
 	ShutdownHost(context.Context, *connect.Request[v1.ShutdownHostRequest]) (*connect.Response[v1.ShutdownHostResponse], error)
 	// Restart the host machine running Home Cloud
@@ -444,6 +573,14 @@ type WebServiceHandler interface {
 	GetDeviceSettings(context.Context, *connect.Request[v1.GetDeviceSettingsRequest]) (*connect.Response[v1.GetDeviceSettingsResponse], error)
 	// Set the device settings
 	SetDeviceSettings(context.Context, *connect.Request[v1.SetDeviceSettingsRequest]) (*connect.Response[v1.SetDeviceSettingsResponse], error)
+	// Get the root certificate of the local CA which issues certificates for app routes
+	GetCACertificate(context.Context, *connect.Request[v1.GetCACertificateRequest]) (*connect.Response[v1.GetCACertificateResponse], error)
+	// List the Home Cloud accounts which users sign in to apps with
+	ListAccounts(context.Context, *connect.Request[v1.ListAccountsRequest]) (*connect.Response[v1.ListAccountsResponse], error)
+	// Create a Home Cloud account or update its email and password
+	SaveAccount(context.Context, *connect.Request[v1.SaveAccountRequest]) (*connect.Response[v1.SaveAccountResponse], error)
+	// Delete a Home Cloud account
+	DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error)
 	// Enables the remote access feature
 	EnableSecureTunnelling(context.Context, *connect.Request[v1.EnableSecureTunnellingRequest]) (*connect.Response[v1.EnableSecureTunnellingResponse], error)
 	// Disables the remote access feature
@@ -506,6 +643,24 @@ func NewWebServiceHandler(svc WebServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(webServiceGetAppStorageMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	webServiceListAppBackupsHandler := connect.NewUnaryHandler(
+		WebServiceListAppBackupsProcedure,
+		svc.ListAppBackups,
+		connect.WithSchema(webServiceListAppBackupsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	webServiceRestoreAppHandler := connect.NewUnaryHandler(
+		WebServiceRestoreAppProcedure,
+		svc.RestoreApp,
+		connect.WithSchema(webServiceRestoreAppMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	webServicePreviewAppChangeHandler := connect.NewUnaryHandler(
+		WebServicePreviewAppChangeProcedure,
+		svc.PreviewAppChange,
+		connect.WithSchema(webServicePreviewAppChangeMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	webServiceShutdownHostHandler := connect.NewUnaryHandler(
 		WebServiceShutdownHostProcedure,
 		svc.ShutdownHost,
@@ -548,6 +703,30 @@ func NewWebServiceHandler(svc WebServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(webServiceSetDeviceSettingsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	webServiceGetCACertificateHandler := connect.NewUnaryHandler(
+		WebServiceGetCACertificateProcedure,
+		svc.GetCACertificate,
+		connect.WithSchema(webServiceGetCACertificateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	webServiceListAccountsHandler := connect.NewUnaryHandler(
+		WebServiceListAccountsProcedure,
+		svc.ListAccounts,
+		connect.WithSchema(webServiceListAccountsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	webServiceSaveAccountHandler := connect.NewUnaryHandler(
+		WebServiceSaveAccountProcedure,
+		svc.SaveAccount,
+		connect.WithSchema(webServiceSaveAccountMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	webServiceDeleteAccountHandler := connect.NewUnaryHandler(
+		WebServiceDeleteAccountProcedure,
+		svc.DeleteAccount,
+		connect.WithSchema(webServiceDeleteAccountMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	webServiceEnableSecureTunnellingHandler := connect.NewUnaryHandler(
 		WebServiceEnableSecureTunnellingProcedure,
 		svc.EnableSecureTunnelling,
@@ -600,6 +779,12 @@ func NewWebServiceHandler(svc WebServiceHandler, opts ...connect.HandlerOption)
 			webServiceGetAppsInStoreHandler.ServeHTTP(w, r)
 		case WebServiceGetAppStorageProcedure:
 			webServiceGetAppStorageHandler.ServeHTTP(w, r)
+		case WebServiceListAppBackupsProcedure:
+			webServiceListAppBackupsHandler.ServeHTTP(w, r)
+		case WebServiceRestoreAppProcedure:
+			webServiceRestoreAppHandler.ServeHTTP(w, r)
+		case WebServicePreviewAppChangeProcedure:
+			webServicePreviewAppChangeHandler.ServeHTTP(w, r)
 		case WebServiceShutdownHostProcedure:
 			webServiceShutdownHostHandler.ServeHTTP(w, r)
 		case WebServiceRestartHostProcedure:
@@ -614,6 +799,14 @@ func NewWebServiceHandler(svc WebServiceHandler, opts ...connect.HandlerOption)
 			webServiceGetDeviceSettingsHandler.ServeHTTP(w, r)
 		case WebServiceSetDeviceSettingsProcedure:
 			webServiceSetDeviceSettingsHandler.ServeHTTP(w, r)
+		case WebServiceGetCACertificateProcedure:
+			webServiceGetCACertificateHandler.ServeHTTP(w, r)
+		case WebServiceListAccountsProcedure:
+			webServiceListAccountsHandler.ServeHTTP(w, r)
+		case WebServiceSaveAccountProcedure:
+			webServiceSaveAccountHandler.ServeHTTP(w, r)
+		case WebServiceDeleteAccountProcedure:
+			webServiceDeleteAccountHandler.ServeHTTP(w, r)
 		case WebServiceEnableSecureTunnellingProcedure:
 			webServiceEnableSecureTunnellingHandler.ServeHTTP(w, r)
 		case WebServiceDisableSecureTunnellingProcedure:
@@ -663,6 +856,18 @@ func (UnimplementedWebServiceHandler) GetAppStorage(context.Context, *connect.Re
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("platform.server.v1.WebService.GetAppStorage is not implemented"))
 }
 
+func (UnimplementedWebServiceHandler) ListAppBackups(context.Context, *connect.Request[v1.ListAppBackupsRequest]) (*connect.Response[v1.ListAppBackupsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("platform.server.v1.WebService.ListAppBackups is not implemented"))
+}
+
+func (UnimplementedWebServiceHandler) RestoreApp(context.Context, *connect.Request[v1.RestoreAppRequest]) (*connect.Response[v1.RestoreAppResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("platform.server.v1.WebService.RestoreApp is not implemented"))
+}
+
+func (UnimplementedWebServiceHandler) PreviewAppChange(context.Context, *connect.Request[v1.PreviewAppChangeRequest]) (*connect.Response[v1.PreviewAppChangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("platform.server.v1.WebService.PreviewAppChange is not implemented"))
+}
+
 func (UnimplementedWebServiceHandler) ShutdownHost(context.Context, *connect.Request[v1.ShutdownHostRequest]) (*connect.Response[v1.ShutdownHostResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("platform.server.v1.WebService.ShutdownHost is not implemented"))
 }
@@ -691,6 +896,22 @@ func (UnimplementedWebServiceHandler) SetDeviceSettings(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("platform.server.v1.WebService.SetDeviceSettings is not implemented"))
 }
 
+func (UnimplementedWebServiceHandler) GetCACertificate(context.Context, *connect.Request[v1.GetCACertificateRequest]) (*connect.Response[v1.GetCACertificateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("platform.server.v1.WebService.GetCACertificate is not implemented"))
+}
+
+func (UnimplementedWebServiceHandler) ListAccounts(context.Context, *connect.Request[v1.ListAccountsRequest]) (*connect.Response[v1.ListAccountsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("platform.server.v1.WebService.ListAccounts is not implemented"))
+}
+
+func (UnimplementedWebServiceHandler) SaveAccount(context.Context, *connect.Request[v1.SaveAccountRequest]) (*connect.Response[v1.SaveAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("platform.server.v1.WebService.SaveAccount is not implemented"))
+}
+
+func (UnimplementedWebServiceHandler) DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("platform.server.v1.WebService.DeleteAccount is not implemented"))
+}
+
 func (UnimplementedWebServiceHandler) EnableSecureTunnelling(context.Context, *connect.Request[v1.EnableSecureTunnellingRequest]) (*connect.Response[v1.EnableSecureTunnellingResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("platform.server.v1.WebService.EnableSecureTunnelling is not implemented"))
 }
@@ -40,6 +40,24 @@ export const getAppsInStore: typeof WebService["method"]["getAppsInStore"];
  * @generated from rpc platform.server.v1.WebService.GetAppStorage
  */
 export const getAppStorage: typeof WebService["method"]["getAppStorage"];
+/**
+ * Get all backups of an installed app
+ *
+ * @generated from rpc platform.server.v1.WebService.ListAppBackups
+ */
+export const listAppBackups: typeof WebService["method"]["listAppBackups"];
+/**
+ * Restore an installed app from one of its backups
+ *
+ * @generated from rpc platform.server.v1.WebService.RestoreApp
+ */
+export const restoreApp: typeof WebService["method"]["restoreApp"];
+/**
+ * Preview the changes of installing or updating a Home Cloud application without applying them
+ *
+ * @generated from rpc platform.server.v1.WebService.PreviewAppChange
+ */
+export const previewAppChange: typeof WebService["method"]["previewAppChange"];
 /**
  * Shutdown the host machine running Home Cloud
  *
@@ -82,6 +100,30 @@ export const getDeviceSettings: typeof WebService["method"]["getDeviceSettings"]
  * @generated from rpc platform.server.v1.WebService.SetDeviceSettings
  */
 export const setDeviceSettings: typeof WebService["method"]["setDeviceSettings"];
+/**
+ * Get the root certificate of the local CA which issues certificates for app routes
+ *
+ * @generated from rpc platform.server.v1.WebService.GetCACertificate
+ */
+export const getCACertificate: typeof WebService["method"]["getCACertificate"];
+/**
+ * List the Home Cloud accounts which users sign in to apps with
+ *
+ * @generated from rpc platform.server.v1.WebService.ListAccounts
+ */
+export const listAccounts: typeof WebService["method"]["listAccounts"];
+/**
+ * Create a Home Cloud account or update its email and password
+ *
+ * @generated from rpc platform.server.v1.WebService.SaveAccount
+ */
+export const saveAccount: typeof WebService["method"]["saveAccount"];
+/**
+ * Delete a Home Cloud account
+ *
+ * @generated from rpc platform.server.v1.WebService.DeleteAccount
+ */
+export const deleteAccount: typeof WebService["method"]["deleteAccount"];
 /**
  * Enables the remote access feature
  *
@@ -46,6 +46,27 @@ export const getAppsInStore = WebService.method.getAppsInStore;
  */
 export const getAppStorage = WebService.method.getAppStorage;
 
+/**
+ * Get all backups of an installed app
+ *
+ * @generated from rpc platform.server.v1.WebService.ListAppBackups
+ */
+export const listAppBackups = WebService.method.listAppBackups;
+
+/**
+ * Restore an installed app from one of its backups
+ *
+ * @generated from rpc platform.server.v1.WebService.RestoreApp
+ */
+export const restoreApp = WebService.method.restoreApp;
+
+/**
+ * Preview the changes of installing or updating a Home Cloud application without applying them
+ *
+ * @generated from rpc platform.server.v1.WebService.PreviewAppChange
+ */
+export const previewAppChange = WebService.method.previewAppChange;
+
 /**
  * Shutdown the host machine running Home Cloud
  *
@@ -95,6 +116,34 @@ export const getDeviceSettings = WebService.method.getDeviceSettings;
  */
 export const setDeviceSettings = WebService.method.setDeviceSettings;
 
+/**
+ * Get the root certificate of the local CA which issues certificates for app routes
+ *
+ * @generated from rpc platform.server.v1.WebService.GetCACertificate
+ */
+export const getCACertificate = WebService.method.getCACertificate;
+
+/**
+ * List the Home Cloud accounts which users sign in to apps with
+ *
+ * @generated from rpc platform.server.v1.WebService.ListAccounts
+ */
+export const listAccounts = WebService.method.listAccounts;
+
+/**
+ * Create a Home Cloud account or update its email and password
+ *
+ * @generated from rpc platform.server.v1.WebService.SaveAccount
+ */
+export const saveAccount = WebService.method.saveAccount;
+
+/**
+ * Delete a Home Cloud account
+ *
+ * @generated from rpc platform.server.v1.WebService.DeleteAccount
+ */
+export const deleteAccount = WebService.method.deleteAccount;
+
 /**
  * Enables the remote access feature
  *
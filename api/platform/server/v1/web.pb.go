@@ -70,6 +70,58 @@ func (AppStatus) EnumDescriptor() ([]byte, []int) {
 	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{0}
 }
 
+type ChangeType int32
+
+const (
+	ChangeType_CHANGE_TYPE_UNSPECIFIED ChangeType = 0
+	ChangeType_CHANGE_TYPE_ADDED       ChangeType = 1
+	ChangeType_CHANGE_TYPE_REMOVED     ChangeType = 2
+	ChangeType_CHANGE_TYPE_MODIFIED    ChangeType = 3
+)
+
+// Enum value maps for ChangeType.
+var (
+	ChangeType_name = map[int32]string{
+		0: "CHANGE_TYPE_UNSPECIFIED",
+		1: "CHANGE_TYPE_ADDED",
+		2: "CHANGE_TYPE_REMOVED",
+		3: "CHANGE_TYPE_MODIFIED",
+	}
+	ChangeType_value = map[string]int32{
+		"CHANGE_TYPE_UNSPECIFIED": 0,
+		"CHANGE_TYPE_ADDED":       1,
+		"CHANGE_TYPE_REMOVED":     2,
+		"CHANGE_TYPE_MODIFIED":    3,
+	}
+)
+
+func (x ChangeType) Enum() *ChangeType {
+	p := new(ChangeType)
+	*p = x
+	return p
+}
+
+func (x ChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_platform_server_v1_web_proto_enumTypes[1].Descriptor()
+}
+
+func (ChangeType) Type() protoreflect.EnumType {
+	return &file_platform_server_v1_web_proto_enumTypes[1]
+}
+
+func (x ChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeType.Descriptor instead.
+func (ChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{1}
+}
+
 type ShutdownHostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -705,6 +757,10 @@ type AppHealth struct {
 	Name    string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name" bun:"name" csv:"name" pg:"name" yaml:"name"`
 	Status  AppStatus   `protobuf:"varint,2,opt,name=status,proto3,enum=platform.server.v1.AppStatus" json:"status" bun:"status" csv:"status" pg:"status" yaml:"status"`
 	Display *AppDisplay `protobuf:"bytes,3,opt,name=display,proto3" json:"display" bun:"display" csv:"display" pg:"display" yaml:"display"`
+	// phase of the App install lifecycle (e.g. Installing, Installed, Failed)
+	Phase string `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase" bun:"phase" csv:"phase" pg:"phase" yaml:"phase"`
+	// last error encountered while reconciling the App
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error" bun:"error" csv:"error" pg:"error" yaml:"error"`
 }
 
 func (x *AppHealth) Reset() {
@@ -760,6 +816,20 @@ func (x *AppHealth) GetDisplay() *AppDisplay {
 	return nil
 }
 
+func (x *AppHealth) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *AppHealth) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 type AppDisplay struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1163,14 +1233,14 @@ func (*SetDeviceSettingsResponse) Descriptor() ([]byte, []int) {
 	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{22}
 }
 
-type GetAppStorageRequest struct {
+type GetCACertificateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetAppStorageRequest) Reset() {
-	*x = GetAppStorageRequest{}
+func (x *GetCACertificateRequest) Reset() {
+	*x = GetCACertificateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_platform_server_v1_web_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1178,13 +1248,13 @@ func (x *GetAppStorageRequest) Reset() {
 	}
 }
 
-func (x *GetAppStorageRequest) String() string {
+func (x *GetCACertificateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAppStorageRequest) ProtoMessage() {}
+func (*GetCACertificateRequest) ProtoMessage() {}
 
-func (x *GetAppStorageRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCACertificateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_platform_server_v1_web_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1196,21 +1266,22 @@ func (x *GetAppStorageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAppStorageRequest.ProtoReflect.Descriptor instead.
-func (*GetAppStorageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCACertificateRequest.ProtoReflect.Descriptor instead.
+func (*GetCACertificateRequest) Descriptor() ([]byte, []int) {
 	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{23}
 }
 
-type GetAppStorageResponse struct {
+type GetCACertificateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Apps []*AppStorage `protobuf:"bytes,1,rep,name=apps,proto3" json:"apps" bun:"apps" csv:"apps" pg:"apps" yaml:"apps"`
+	// PEM encoded certificate to install as a trusted root on devices
+	Certificate string `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate" bun:"certificate" csv:"certificate" pg:"certificate" yaml:"certificate"`
 }
 
-func (x *GetAppStorageResponse) Reset() {
-	*x = GetAppStorageResponse{}
+func (x *GetCACertificateResponse) Reset() {
+	*x = GetCACertificateResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_platform_server_v1_web_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1218,13 +1289,13 @@ func (x *GetAppStorageResponse) Reset() {
 	}
 }
 
-func (x *GetAppStorageResponse) String() string {
+func (x *GetCACertificateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAppStorageResponse) ProtoMessage() {}
+func (*GetCACertificateResponse) ProtoMessage() {}
 
-func (x *GetAppStorageResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCACertificateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_platform_server_v1_web_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1236,29 +1307,26 @@ func (x *GetAppStorageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAppStorageResponse.ProtoReflect.Descriptor instead.
-func (*GetAppStorageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCACertificateResponse.ProtoReflect.Descriptor instead.
+func (*GetCACertificateResponse) Descriptor() ([]byte, []int) {
 	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetAppStorageResponse) GetApps() []*AppStorage {
+func (x *GetCACertificateResponse) GetCertificate() string {
 	if x != nil {
-		return x.Apps
+		return x.Certificate
 	}
-	return nil
+	return ""
 }
 
-type AppStorage struct {
+type ListAccountsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	AppName string   `protobuf:"bytes,1,opt,name=app_name,json=appName,proto3" json:"app_name" bun:"app_name" csv:"app_name" pg:"app_name" yaml:"appName"`
-	Volumes []string `protobuf:"bytes,2,rep,name=volumes,proto3" json:"volumes" bun:"volumes" csv:"volumes" pg:"volumes" yaml:"volumes"`
 }
 
-func (x *AppStorage) Reset() {
-	*x = AppStorage{}
+func (x *ListAccountsRequest) Reset() {
+	*x = ListAccountsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_platform_server_v1_web_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1266,13 +1334,13 @@ func (x *AppStorage) Reset() {
 	}
 }
 
-func (x *AppStorage) String() string {
+func (x *ListAccountsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppStorage) ProtoMessage() {}
+func (*ListAccountsRequest) ProtoMessage() {}
 
-func (x *AppStorage) ProtoReflect() protoreflect.Message {
+func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_platform_server_v1_web_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1284,33 +1352,21 @@ func (x *AppStorage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppStorage.ProtoReflect.Descriptor instead.
-func (*AppStorage) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
 	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *AppStorage) GetAppName() string {
-	if x != nil {
-		return x.AppName
-	}
-	return ""
-}
-
-func (x *AppStorage) GetVolumes() []string {
-	if x != nil {
-		return x.Volumes
-	}
-	return nil
-}
-
-type EnableSecureTunnellingRequest struct {
+type ListAccountsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Accounts []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts" bun:"accounts" csv:"accounts" pg:"accounts" yaml:"accounts"`
 }
 
-func (x *EnableSecureTunnellingRequest) Reset() {
-	*x = EnableSecureTunnellingRequest{}
+func (x *ListAccountsResponse) Reset() {
+	*x = ListAccountsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_platform_server_v1_web_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1318,13 +1374,13 @@ func (x *EnableSecureTunnellingRequest) Reset() {
 	}
 }
 
-func (x *EnableSecureTunnellingRequest) String() string {
+func (x *ListAccountsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnableSecureTunnellingRequest) ProtoMessage() {}
+func (*ListAccountsResponse) ProtoMessage() {}
 
-func (x *EnableSecureTunnellingRequest) ProtoReflect() protoreflect.Message {
+func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_platform_server_v1_web_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1336,19 +1392,36 @@ func (x *EnableSecureTunnellingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnableSecureTunnellingRequest.ProtoReflect.Descriptor instead.
-func (*EnableSecureTunnellingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
 	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{26}
 }
 
-type EnableSecureTunnellingResponse struct {
+func (x *ListAccountsResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type SaveAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username" bun:"username" csv:"username" pg:"username" yaml:"username"`
+	Email    string `protobuf:"bytes,2,opt,name=email,proto3" json:"email" bun:"email" csv:"email" pg:"email" yaml:"email"`
+	// required when creating an account, the current password is kept when empty
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password" bun:"password" csv:"password" pg:"password" yaml:"password"`
+	// required to change your own account unless signed in as an admin (only admins can create or
+	// change other accounts)
+	CurrentPassword string `protobuf:"bytes,4,opt,name=current_password,json=currentPassword,proto3" json:"current_password" bun:"current_password" csv:"current_password" pg:"current_password" yaml:"currentPassword"`
+	// only admins can change who is an admin and the last admin can't be demoted
+	Admin bool `protobuf:"varint,5,opt,name=admin,proto3" json:"admin" bun:"admin" csv:"admin" pg:"admin" yaml:"admin"`
 }
 
-func (x *EnableSecureTunnellingResponse) Reset() {
-	*x = EnableSecureTunnellingResponse{}
+func (x *SaveAccountRequest) Reset() {
+	*x = SaveAccountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_platform_server_v1_web_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1356,13 +1429,13 @@ func (x *EnableSecureTunnellingResponse) Reset() {
 	}
 }
 
-func (x *EnableSecureTunnellingResponse) String() string {
+func (x *SaveAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnableSecureTunnellingResponse) ProtoMessage() {}
+func (*SaveAccountRequest) ProtoMessage() {}
 
-func (x *EnableSecureTunnellingResponse) ProtoReflect() protoreflect.Message {
+func (x *SaveAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_platform_server_v1_web_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1374,19 +1447,54 @@ func (x *EnableSecureTunnellingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnableSecureTunnellingResponse.ProtoReflect.Descriptor instead.
-func (*EnableSecureTunnellingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SaveAccountRequest.ProtoReflect.Descriptor instead.
+func (*SaveAccountRequest) Descriptor() ([]byte, []int) {
 	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{27}
 }
 
-type DisableSecureTunnellingRequest struct {
+func (x *SaveAccountRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SaveAccountRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SaveAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *SaveAccountRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *SaveAccountRequest) GetAdmin() bool {
+	if x != nil {
+		return x.Admin
+	}
+	return false
+}
+
+type SaveAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DisableSecureTunnellingRequest) Reset() {
-	*x = DisableSecureTunnellingRequest{}
+func (x *SaveAccountResponse) Reset() {
+	*x = SaveAccountResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_platform_server_v1_web_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1394,13 +1502,13 @@ func (x *DisableSecureTunnellingRequest) Reset() {
 	}
 }
 
-func (x *DisableSecureTunnellingRequest) String() string {
+func (x *SaveAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisableSecureTunnellingRequest) ProtoMessage() {}
+func (*SaveAccountResponse) ProtoMessage() {}
 
-func (x *DisableSecureTunnellingRequest) ProtoReflect() protoreflect.Message {
+func (x *SaveAccountResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_platform_server_v1_web_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1412,19 +1520,24 @@ func (x *DisableSecureTunnellingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisableSecureTunnellingRequest.ProtoReflect.Descriptor instead.
-func (*DisableSecureTunnellingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SaveAccountResponse.ProtoReflect.Descriptor instead.
+func (*SaveAccountResponse) Descriptor() ([]byte, []int) {
 	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{28}
 }
 
-type DisableSecureTunnellingResponse struct {
+type DeleteAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username" bun:"username" csv:"username" pg:"username" yaml:"username"`
+	// required to delete your own account unless signed in as an admin (only admins can delete other
+	// accounts and the last admin can't be deleted)
+	CurrentPassword string `protobuf:"bytes,2,opt,name=current_password,json=currentPassword,proto3" json:"current_password" bun:"current_password" csv:"current_password" pg:"current_password" yaml:"currentPassword"`
 }
 
-func (x *DisableSecureTunnellingResponse) Reset() {
-	*x = DisableSecureTunnellingResponse{}
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_platform_server_v1_web_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1432,13 +1545,13 @@ func (x *DisableSecureTunnellingResponse) Reset() {
 	}
 }
 
-func (x *DisableSecureTunnellingResponse) String() string {
+func (x *DeleteAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisableSecureTunnellingResponse) ProtoMessage() {}
+func (*DeleteAccountRequest) ProtoMessage() {}
 
-func (x *DisableSecureTunnellingResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_platform_server_v1_web_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1450,22 +1563,33 @@ func (x *DisableSecureTunnellingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisableSecureTunnellingResponse.ProtoReflect.Descriptor instead.
-func (*DisableSecureTunnellingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
 	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{29}
 }
 
-type RegisterToLocatorRequest struct {
+func (x *DeleteAccountRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *DeleteAccountRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+type DeleteAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	LocatorAddress     string `protobuf:"bytes,1,opt,name=locator_address,json=locatorAddress,proto3" json:"locator_address" bun:"locator_address" csv:"locator_address" pg:"locator_address" yaml:"locatorAddress"`
-	WireguardInterface string `protobuf:"bytes,2,opt,name=wireguard_interface,json=wireguardInterface,proto3" json:"wireguard_interface" bun:"wireguard_interface" csv:"wireguard_interface" pg:"wireguard_interface" yaml:"wireguardInterface"` // TODO: eventually this is where the access key will be included that the user receives after purchasing the locator subscription
 }
 
-func (x *RegisterToLocatorRequest) Reset() {
-	*x = RegisterToLocatorRequest{}
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_platform_server_v1_web_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1473,13 +1597,13 @@ func (x *RegisterToLocatorRequest) Reset() {
 	}
 }
 
-func (x *RegisterToLocatorRequest) String() string {
+func (x *DeleteAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterToLocatorRequest) ProtoMessage() {}
+func (*DeleteAccountResponse) ProtoMessage() {}
 
-func (x *RegisterToLocatorRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_platform_server_v1_web_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1491,33 +1615,19 @@ func (x *RegisterToLocatorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterToLocatorRequest.ProtoReflect.Descriptor instead.
-func (*RegisterToLocatorRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
 	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *RegisterToLocatorRequest) GetLocatorAddress() string {
-	if x != nil {
-		return x.LocatorAddress
-	}
-	return ""
-}
-
-func (x *RegisterToLocatorRequest) GetWireguardInterface() string {
-	if x != nil {
-		return x.WireguardInterface
-	}
-	return ""
-}
-
-type RegisterToLocatorResponse struct {
+type GetAppStorageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *RegisterToLocatorResponse) Reset() {
-	*x = RegisterToLocatorResponse{}
+func (x *GetAppStorageRequest) Reset() {
+	*x = GetAppStorageRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_platform_server_v1_web_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1525,13 +1635,13 @@ func (x *RegisterToLocatorResponse) Reset() {
 	}
 }
 
-func (x *RegisterToLocatorResponse) String() string {
+func (x *GetAppStorageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterToLocatorResponse) ProtoMessage() {}
+func (*GetAppStorageRequest) ProtoMessage() {}
 
-func (x *RegisterToLocatorResponse) ProtoReflect() protoreflect.Message {
+func (x *GetAppStorageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_platform_server_v1_web_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1543,22 +1653,21 @@ func (x *RegisterToLocatorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterToLocatorResponse.ProtoReflect.Descriptor instead.
-func (*RegisterToLocatorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAppStorageRequest.ProtoReflect.Descriptor instead.
+func (*GetAppStorageRequest) Descriptor() ([]byte, []int) {
 	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{31}
 }
 
-type DeregisterFromLocatorRequest struct {
+type GetAppStorageResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LocatorAddress     string `protobuf:"bytes,1,opt,name=locator_address,json=locatorAddress,proto3" json:"locator_address" bun:"locator_address" csv:"locator_address" pg:"locator_address" yaml:"locatorAddress"`
-	WireguardInterface string `protobuf:"bytes,2,opt,name=wireguard_interface,json=wireguardInterface,proto3" json:"wireguard_interface" bun:"wireguard_interface" csv:"wireguard_interface" pg:"wireguard_interface" yaml:"wireguardInterface"`
+	Apps []*AppStorage `protobuf:"bytes,1,rep,name=apps,proto3" json:"apps" bun:"apps" csv:"apps" pg:"apps" yaml:"apps"`
 }
 
-func (x *DeregisterFromLocatorRequest) Reset() {
-	*x = DeregisterFromLocatorRequest{}
+func (x *GetAppStorageResponse) Reset() {
+	*x = GetAppStorageResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_platform_server_v1_web_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1566,13 +1675,13 @@ func (x *DeregisterFromLocatorRequest) Reset() {
 	}
 }
 
-func (x *DeregisterFromLocatorRequest) String() string {
+func (x *GetAppStorageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeregisterFromLocatorRequest) ProtoMessage() {}
+func (*GetAppStorageResponse) ProtoMessage() {}
 
-func (x *DeregisterFromLocatorRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAppStorageResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_platform_server_v1_web_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1584,33 +1693,29 @@ func (x *DeregisterFromLocatorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeregisterFromLocatorRequest.ProtoReflect.Descriptor instead.
-func (*DeregisterFromLocatorRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAppStorageResponse.ProtoReflect.Descriptor instead.
+func (*GetAppStorageResponse) Descriptor() ([]byte, []int) {
 	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *DeregisterFromLocatorRequest) GetLocatorAddress() string {
-	if x != nil {
-		return x.LocatorAddress
-	}
-	return ""
-}
-
-func (x *DeregisterFromLocatorRequest) GetWireguardInterface() string {
+func (x *GetAppStorageResponse) GetApps() []*AppStorage {
 	if x != nil {
-		return x.WireguardInterface
+		return x.Apps
 	}
-	return ""
+	return nil
 }
 
-type DeregisterFromLocatorResponse struct {
+type AppStorage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	AppName string   `protobuf:"bytes,1,opt,name=app_name,json=appName,proto3" json:"app_name" bun:"app_name" csv:"app_name" pg:"app_name" yaml:"appName"`
+	Volumes []string `protobuf:"bytes,2,rep,name=volumes,proto3" json:"volumes" bun:"volumes" csv:"volumes" pg:"volumes" yaml:"volumes"`
 }
 
-func (x *DeregisterFromLocatorResponse) Reset() {
-	*x = DeregisterFromLocatorResponse{}
+func (x *AppStorage) Reset() {
+	*x = AppStorage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_platform_server_v1_web_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1618,13 +1723,13 @@ func (x *DeregisterFromLocatorResponse) Reset() {
 	}
 }
 
-func (x *DeregisterFromLocatorResponse) String() string {
+func (x *AppStorage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeregisterFromLocatorResponse) ProtoMessage() {}
+func (*AppStorage) ProtoMessage() {}
 
-func (x *DeregisterFromLocatorResponse) ProtoReflect() protoreflect.Message {
+func (x *AppStorage) ProtoReflect() protoreflect.Message {
 	mi := &file_platform_server_v1_web_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1636,21 +1741,957 @@ func (x *DeregisterFromLocatorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeregisterFromLocatorResponse.ProtoReflect.Descriptor instead.
-func (*DeregisterFromLocatorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppStorage.ProtoReflect.Descriptor instead.
+func (*AppStorage) Descriptor() ([]byte, []int) {
 	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{33}
 }
 
-type GetComponentVersionsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *GetComponentVersionsRequest) Reset() {
+func (x *AppStorage) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+func (x *AppStorage) GetVolumes() []string {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+type ListAppBackupsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppName string `protobuf:"bytes,1,opt,name=app_name,json=appName,proto3" json:"app_name" bun:"app_name" csv:"app_name" pg:"app_name" yaml:"appName"`
+}
+
+func (x *ListAppBackupsRequest) Reset() {
+	*x = ListAppBackupsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_server_v1_web_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAppBackupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAppBackupsRequest) ProtoMessage() {}
+
+func (x *ListAppBackupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_server_v1_web_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAppBackupsRequest.ProtoReflect.Descriptor instead.
+func (*ListAppBackupsRequest) Descriptor() ([]byte, []int) {
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListAppBackupsRequest) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+type ListAppBackupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Backups []*AppBackup `protobuf:"bytes,1,rep,name=backups,proto3" json:"backups" bun:"backups" csv:"backups" pg:"backups" yaml:"backups"`
+}
+
+func (x *ListAppBackupsResponse) Reset() {
+	*x = ListAppBackupsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_server_v1_web_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAppBackupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAppBackupsResponse) ProtoMessage() {}
+
+func (x *ListAppBackupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_server_v1_web_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAppBackupsResponse.ProtoReflect.Descriptor instead.
+func (*ListAppBackupsResponse) Descriptor() ([]byte, []int) {
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListAppBackupsResponse) GetBackups() []*AppBackup {
+	if x != nil {
+		return x.Backups
+	}
+	return nil
+}
+
+type AppBackup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name" bun:"name" csv:"name" pg:"name" yaml:"name"`
+	AppName string `protobuf:"bytes,2,opt,name=app_name,json=appName,proto3" json:"app_name" bun:"app_name" csv:"app_name" pg:"app_name" yaml:"appName"`
+	// Running, Completed, or Failed
+	Phase string `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase" bun:"phase" csv:"phase" pg:"phase" yaml:"phase"`
+	// RFC 3339 creation time
+	Created  string `protobuf:"bytes,4,opt,name=created,proto3" json:"created" bun:"created" csv:"created" pg:"created" yaml:"created"`
+	Location string `protobuf:"bytes,5,opt,name=location,proto3" json:"location" bun:"location" csv:"location" pg:"location" yaml:"location"`
+	Error    string `protobuf:"bytes,6,opt,name=error,proto3" json:"error" bun:"error" csv:"error" pg:"error" yaml:"error"`
+}
+
+func (x *AppBackup) Reset() {
+	*x = AppBackup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_server_v1_web_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppBackup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppBackup) ProtoMessage() {}
+
+func (x *AppBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_server_v1_web_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppBackup.ProtoReflect.Descriptor instead.
+func (*AppBackup) Descriptor() ([]byte, []int) {
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AppBackup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AppBackup) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+func (x *AppBackup) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *AppBackup) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *AppBackup) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *AppBackup) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RestoreAppRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppName string `protobuf:"bytes,1,opt,name=app_name,json=appName,proto3" json:"app_name" bun:"app_name" csv:"app_name" pg:"app_name" yaml:"appName"`
+	// name of a completed backup of the app
+	Backup string `protobuf:"bytes,2,opt,name=backup,proto3" json:"backup" bun:"backup" csv:"backup" pg:"backup" yaml:"backup"`
+}
+
+func (x *RestoreAppRequest) Reset() {
+	*x = RestoreAppRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_server_v1_web_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreAppRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAppRequest) ProtoMessage() {}
+
+func (x *RestoreAppRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_server_v1_web_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAppRequest.ProtoReflect.Descriptor instead.
+func (*RestoreAppRequest) Descriptor() ([]byte, []int) {
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RestoreAppRequest) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+func (x *RestoreAppRequest) GetBackup() string {
+	if x != nil {
+		return x.Backup
+	}
+	return ""
+}
+
+type RestoreAppResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name of the restore which progress events are sent for
+	Restore string `protobuf:"bytes,1,opt,name=restore,proto3" json:"restore" bun:"restore" csv:"restore" pg:"restore" yaml:"restore"`
+}
+
+func (x *RestoreAppResponse) Reset() {
+	*x = RestoreAppResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_server_v1_web_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreAppResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAppResponse) ProtoMessage() {}
+
+func (x *RestoreAppResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_server_v1_web_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAppResponse.ProtoReflect.Descriptor instead.
+func (*RestoreAppResponse) Descriptor() ([]byte, []int) {
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RestoreAppResponse) GetRestore() string {
+	if x != nil {
+		return x.Restore
+	}
+	return ""
+}
+
+type PreviewAppChangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chart   string `protobuf:"bytes,1,opt,name=chart,proto3" json:"chart" bun:"chart" csv:"chart" pg:"chart" yaml:"chart"`
+	Repo    string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo" bun:"repo" csv:"repo" pg:"repo" yaml:"repo"`
+	Release string `protobuf:"bytes,3,opt,name=release,proto3" json:"release" bun:"release" csv:"release" pg:"release" yaml:"release"`
+	Values  string `protobuf:"bytes,4,opt,name=values,proto3" json:"values" bun:"values" csv:"values" pg:"values" yaml:"values"`
+	Version string `protobuf:"bytes,5,opt,name=version,proto3" json:"version" bun:"version" csv:"version" pg:"version" yaml:"version"`
+}
+
+func (x *PreviewAppChangeRequest) Reset() {
+	*x = PreviewAppChangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_server_v1_web_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewAppChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewAppChangeRequest) ProtoMessage() {}
+
+func (x *PreviewAppChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_server_v1_web_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewAppChangeRequest.ProtoReflect.Descriptor instead.
+func (*PreviewAppChangeRequest) Descriptor() ([]byte, []int) {
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PreviewAppChangeRequest) GetChart() string {
+	if x != nil {
+		return x.Chart
+	}
+	return ""
+}
+
+func (x *PreviewAppChangeRequest) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *PreviewAppChangeRequest) GetRelease() string {
+	if x != nil {
+		return x.Release
+	}
+	return ""
+}
+
+func (x *PreviewAppChangeRequest) GetValues() string {
+	if x != nil {
+		return x.Values
+	}
+	return ""
+}
+
+func (x *PreviewAppChangeRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type PreviewAppChangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// version of the installed chart (empty if the app isn't installed)
+	InstalledVersion string `protobuf:"bytes,1,opt,name=installed_version,json=installedVersion,proto3" json:"installed_version" bun:"installed_version" csv:"installed_version" pg:"installed_version" yaml:"installedVersion"`
+	// changes of the objects rendered by the chart
+	ManifestChanges []*ManifestChange `protobuf:"bytes,2,rep,name=manifest_changes,json=manifestChanges,proto3" json:"manifest_changes" bun:"manifest_changes" csv:"manifest_changes" pg:"manifest_changes" yaml:"manifestChanges"`
+	// changes of the secrets, databases, volumes, routes, etc. created for the app
+	DependencyChanges []*DependencyChange `protobuf:"bytes,3,rep,name=dependency_changes,json=dependencyChanges,proto3" json:"dependency_changes" bun:"dependency_changes" csv:"dependency_changes" pg:"dependency_changes" yaml:"dependencyChanges"`
+}
+
+func (x *PreviewAppChangeResponse) Reset() {
+	*x = PreviewAppChangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_server_v1_web_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewAppChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewAppChangeResponse) ProtoMessage() {}
+
+func (x *PreviewAppChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_server_v1_web_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewAppChangeResponse.ProtoReflect.Descriptor instead.
+func (*PreviewAppChangeResponse) Descriptor() ([]byte, []int) {
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PreviewAppChangeResponse) GetInstalledVersion() string {
+	if x != nil {
+		return x.InstalledVersion
+	}
+	return ""
+}
+
+func (x *PreviewAppChangeResponse) GetManifestChanges() []*ManifestChange {
+	if x != nil {
+		return x.ManifestChanges
+	}
+	return nil
+}
+
+func (x *PreviewAppChangeResponse) GetDependencyChanges() []*DependencyChange {
+	if x != nil {
+		return x.DependencyChanges
+	}
+	return nil
+}
+
+type ManifestChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind      string     `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind" bun:"kind" csv:"kind" pg:"kind" yaml:"kind"`
+	Namespace string     `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace" bun:"namespace" csv:"namespace" pg:"namespace" yaml:"namespace"`
+	Name      string     `protobuf:"bytes,3,opt,name=name,proto3" json:"name" bun:"name" csv:"name" pg:"name" yaml:"name"`
+	Type      ChangeType `protobuf:"varint,4,opt,name=type,proto3,enum=platform.server.v1.ChangeType" json:"type" bun:"type" csv:"type" pg:"type" yaml:"type"`
+	// unified diff of the object manifest (the data of secrets is hidden)
+	Diff string `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff" bun:"diff" csv:"diff" pg:"diff" yaml:"diff"`
+}
+
+func (x *ManifestChange) Reset() {
+	*x = ManifestChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_server_v1_web_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManifestChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManifestChange) ProtoMessage() {}
+
+func (x *ManifestChange) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_server_v1_web_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManifestChange.ProtoReflect.Descriptor instead.
+func (*ManifestChange) Descriptor() ([]byte, []int) {
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ManifestChange) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ManifestChange) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ManifestChange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ManifestChange) GetType() ChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return ChangeType_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *ManifestChange) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+type DependencyChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// secret, database, volume, route, oidc, resources, or dependency
+	Kind        string     `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind" bun:"kind" csv:"kind" pg:"kind" yaml:"kind"`
+	Name        string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name" bun:"name" csv:"name" pg:"name" yaml:"name"`
+	Type        ChangeType `protobuf:"varint,3,opt,name=type,proto3,enum=platform.server.v1.ChangeType" json:"type" bun:"type" csv:"type" pg:"type" yaml:"type"`
+	Description string     `protobuf:"bytes,4,opt,name=description,proto3" json:"description" bun:"description" csv:"description" pg:"description" yaml:"description"`
+}
+
+func (x *DependencyChange) Reset() {
+	*x = DependencyChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_server_v1_web_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DependencyChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependencyChange) ProtoMessage() {}
+
+func (x *DependencyChange) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_server_v1_web_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependencyChange.ProtoReflect.Descriptor instead.
+func (*DependencyChange) Descriptor() ([]byte, []int) {
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DependencyChange) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *DependencyChange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DependencyChange) GetType() ChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return ChangeType_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *DependencyChange) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type EnableSecureTunnellingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EnableSecureTunnellingRequest) Reset() {
+	*x = EnableSecureTunnellingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_server_v1_web_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableSecureTunnellingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableSecureTunnellingRequest) ProtoMessage() {}
+
+func (x *EnableSecureTunnellingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_server_v1_web_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableSecureTunnellingRequest.ProtoReflect.Descriptor instead.
+func (*EnableSecureTunnellingRequest) Descriptor() ([]byte, []int) {
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{43}
+}
+
+type EnableSecureTunnellingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EnableSecureTunnellingResponse) Reset() {
+	*x = EnableSecureTunnellingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_server_v1_web_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableSecureTunnellingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableSecureTunnellingResponse) ProtoMessage() {}
+
+func (x *EnableSecureTunnellingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_server_v1_web_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableSecureTunnellingResponse.ProtoReflect.Descriptor instead.
+func (*EnableSecureTunnellingResponse) Descriptor() ([]byte, []int) {
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{44}
+}
+
+type DisableSecureTunnellingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DisableSecureTunnellingRequest) Reset() {
+	*x = DisableSecureTunnellingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_server_v1_web_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableSecureTunnellingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableSecureTunnellingRequest) ProtoMessage() {}
+
+func (x *DisableSecureTunnellingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_server_v1_web_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableSecureTunnellingRequest.ProtoReflect.Descriptor instead.
+func (*DisableSecureTunnellingRequest) Descriptor() ([]byte, []int) {
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{45}
+}
+
+type DisableSecureTunnellingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DisableSecureTunnellingResponse) Reset() {
+	*x = DisableSecureTunnellingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_server_v1_web_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableSecureTunnellingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableSecureTunnellingResponse) ProtoMessage() {}
+
+func (x *DisableSecureTunnellingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_server_v1_web_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableSecureTunnellingResponse.ProtoReflect.Descriptor instead.
+func (*DisableSecureTunnellingResponse) Descriptor() ([]byte, []int) {
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{46}
+}
+
+type RegisterToLocatorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocatorAddress     string `protobuf:"bytes,1,opt,name=locator_address,json=locatorAddress,proto3" json:"locator_address" bun:"locator_address" csv:"locator_address" pg:"locator_address" yaml:"locatorAddress"`
+	WireguardInterface string `protobuf:"bytes,2,opt,name=wireguard_interface,json=wireguardInterface,proto3" json:"wireguard_interface" bun:"wireguard_interface" csv:"wireguard_interface" pg:"wireguard_interface" yaml:"wireguardInterface"` // TODO: eventually this is where the access key will be included that the user receives after purchasing the locator subscription
+}
+
+func (x *RegisterToLocatorRequest) Reset() {
+	*x = RegisterToLocatorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_server_v1_web_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterToLocatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterToLocatorRequest) ProtoMessage() {}
+
+func (x *RegisterToLocatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_server_v1_web_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterToLocatorRequest.ProtoReflect.Descriptor instead.
+func (*RegisterToLocatorRequest) Descriptor() ([]byte, []int) {
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RegisterToLocatorRequest) GetLocatorAddress() string {
+	if x != nil {
+		return x.LocatorAddress
+	}
+	return ""
+}
+
+func (x *RegisterToLocatorRequest) GetWireguardInterface() string {
+	if x != nil {
+		return x.WireguardInterface
+	}
+	return ""
+}
+
+type RegisterToLocatorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RegisterToLocatorResponse) Reset() {
+	*x = RegisterToLocatorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_server_v1_web_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterToLocatorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterToLocatorResponse) ProtoMessage() {}
+
+func (x *RegisterToLocatorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_server_v1_web_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterToLocatorResponse.ProtoReflect.Descriptor instead.
+func (*RegisterToLocatorResponse) Descriptor() ([]byte, []int) {
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{48}
+}
+
+type DeregisterFromLocatorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocatorAddress     string `protobuf:"bytes,1,opt,name=locator_address,json=locatorAddress,proto3" json:"locator_address" bun:"locator_address" csv:"locator_address" pg:"locator_address" yaml:"locatorAddress"`
+	WireguardInterface string `protobuf:"bytes,2,opt,name=wireguard_interface,json=wireguardInterface,proto3" json:"wireguard_interface" bun:"wireguard_interface" csv:"wireguard_interface" pg:"wireguard_interface" yaml:"wireguardInterface"`
+}
+
+func (x *DeregisterFromLocatorRequest) Reset() {
+	*x = DeregisterFromLocatorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_server_v1_web_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeregisterFromLocatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeregisterFromLocatorRequest) ProtoMessage() {}
+
+func (x *DeregisterFromLocatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_server_v1_web_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeregisterFromLocatorRequest.ProtoReflect.Descriptor instead.
+func (*DeregisterFromLocatorRequest) Descriptor() ([]byte, []int) {
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeregisterFromLocatorRequest) GetLocatorAddress() string {
+	if x != nil {
+		return x.LocatorAddress
+	}
+	return ""
+}
+
+func (x *DeregisterFromLocatorRequest) GetWireguardInterface() string {
+	if x != nil {
+		return x.WireguardInterface
+	}
+	return ""
+}
+
+type DeregisterFromLocatorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeregisterFromLocatorResponse) Reset() {
+	*x = DeregisterFromLocatorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_server_v1_web_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeregisterFromLocatorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeregisterFromLocatorResponse) ProtoMessage() {}
+
+func (x *DeregisterFromLocatorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_server_v1_web_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeregisterFromLocatorResponse.ProtoReflect.Descriptor instead.
+func (*DeregisterFromLocatorResponse) Descriptor() ([]byte, []int) {
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{50}
+}
+
+type GetComponentVersionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetComponentVersionsRequest) Reset() {
 	*x = GetComponentVersionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[34]
+		mi := &file_platform_server_v1_web_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1663,7 +2704,7 @@ func (x *GetComponentVersionsRequest) String() string {
 func (*GetComponentVersionsRequest) ProtoMessage() {}
 
 func (x *GetComponentVersionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[34]
+	mi := &file_platform_server_v1_web_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1676,7 +2717,7 @@ func (x *GetComponentVersionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetComponentVersionsRequest.ProtoReflect.Descriptor instead.
 func (*GetComponentVersionsRequest) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{34}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{51}
 }
 
 type GetComponentVersionsResponse struct {
@@ -1691,7 +2732,7 @@ type GetComponentVersionsResponse struct {
 func (x *GetComponentVersionsResponse) Reset() {
 	*x = GetComponentVersionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[35]
+		mi := &file_platform_server_v1_web_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1704,7 +2745,7 @@ func (x *GetComponentVersionsResponse) String() string {
 func (*GetComponentVersionsResponse) ProtoMessage() {}
 
 func (x *GetComponentVersionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[35]
+	mi := &file_platform_server_v1_web_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1717,7 +2758,7 @@ func (x *GetComponentVersionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetComponentVersionsResponse.ProtoReflect.Descriptor instead.
 func (*GetComponentVersionsResponse) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{35}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *GetComponentVersionsResponse) GetPlatform() []*v1.ComponentVersion {
@@ -1746,7 +2787,7 @@ type GetSystemLogsRequest struct {
 func (x *GetSystemLogsRequest) Reset() {
 	*x = GetSystemLogsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[36]
+		mi := &file_platform_server_v1_web_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1759,7 +2800,7 @@ func (x *GetSystemLogsRequest) String() string {
 func (*GetSystemLogsRequest) ProtoMessage() {}
 
 func (x *GetSystemLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[36]
+	mi := &file_platform_server_v1_web_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1772,7 +2813,7 @@ func (x *GetSystemLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSystemLogsRequest.ProtoReflect.Descriptor instead.
 func (*GetSystemLogsRequest) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{36}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *GetSystemLogsRequest) GetSinceSeconds() uint32 {
@@ -1796,7 +2837,7 @@ type GetSystemLogsResponse struct {
 func (x *GetSystemLogsResponse) Reset() {
 	*x = GetSystemLogsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[37]
+		mi := &file_platform_server_v1_web_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1809,7 +2850,7 @@ func (x *GetSystemLogsResponse) String() string {
 func (*GetSystemLogsResponse) ProtoMessage() {}
 
 func (x *GetSystemLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[37]
+	mi := &file_platform_server_v1_web_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1822,7 +2863,7 @@ func (x *GetSystemLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSystemLogsResponse.ProtoReflect.Descriptor instead.
 func (*GetSystemLogsResponse) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{37}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *GetSystemLogsResponse) GetLogs() []*v1.Log {
@@ -1864,7 +2905,7 @@ type Apps struct {
 func (x *Apps) Reset() {
 	*x = Apps{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[38]
+		mi := &file_platform_server_v1_web_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1877,7 +2918,7 @@ func (x *Apps) String() string {
 func (*Apps) ProtoMessage() {}
 
 func (x *Apps) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[38]
+	mi := &file_platform_server_v1_web_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1890,7 +2931,7 @@ func (x *Apps) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Apps.ProtoReflect.Descriptor instead.
 func (*Apps) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{38}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *Apps) GetApps() []*App {
@@ -1931,7 +2972,7 @@ type App struct {
 func (x *App) Reset() {
 	*x = App{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[39]
+		mi := &file_platform_server_v1_web_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1944,7 +2985,7 @@ func (x *App) String() string {
 func (*App) ProtoMessage() {}
 
 func (x *App) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[39]
+	mi := &file_platform_server_v1_web_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1957,7 +2998,7 @@ func (x *App) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use App.ProtoReflect.Descriptor instead.
 func (*App) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{39}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *App) GetName() string {
@@ -2078,7 +3119,7 @@ type AppDependency struct {
 func (x *AppDependency) Reset() {
 	*x = AppDependency{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[40]
+		mi := &file_platform_server_v1_web_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2091,7 +3132,7 @@ func (x *AppDependency) String() string {
 func (*AppDependency) ProtoMessage() {}
 
 func (x *AppDependency) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[40]
+	mi := &file_platform_server_v1_web_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2104,7 +3145,7 @@ func (x *AppDependency) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppDependency.ProtoReflect.Descriptor instead.
 func (*AppDependency) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{40}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *AppDependency) GetName() string {
@@ -2141,7 +3182,7 @@ type AppRunningStatus struct {
 func (x *AppRunningStatus) Reset() {
 	*x = AppRunningStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[41]
+		mi := &file_platform_server_v1_web_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2154,7 +3195,7 @@ func (x *AppRunningStatus) String() string {
 func (*AppRunningStatus) ProtoMessage() {}
 
 func (x *AppRunningStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[41]
+	mi := &file_platform_server_v1_web_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2167,7 +3208,7 @@ func (x *AppRunningStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppRunningStatus.ProtoReflect.Descriptor instead.
 func (*AppRunningStatus) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{41}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *AppRunningStatus) GetName() string {
@@ -2202,7 +3243,7 @@ type Entries struct {
 func (x *Entries) Reset() {
 	*x = Entries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[42]
+		mi := &file_platform_server_v1_web_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2215,7 +3256,7 @@ func (x *Entries) String() string {
 func (*Entries) ProtoMessage() {}
 
 func (x *Entries) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[42]
+	mi := &file_platform_server_v1_web_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2228,7 +3269,7 @@ func (x *Entries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Entries.ProtoReflect.Descriptor instead.
 func (*Entries) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{42}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *Entries) GetApps() []*App {
@@ -2253,7 +3294,7 @@ type SystemVersion struct {
 func (x *SystemVersion) Reset() {
 	*x = SystemVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[43]
+		mi := &file_platform_server_v1_web_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2266,7 +3307,7 @@ func (x *SystemVersion) String() string {
 func (*SystemVersion) ProtoMessage() {}
 
 func (x *SystemVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[43]
+	mi := &file_platform_server_v1_web_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2279,7 +3320,7 @@ func (x *SystemVersion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemVersion.ProtoReflect.Descriptor instead.
 func (*SystemVersion) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{43}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *SystemVersion) GetVersion() string {
@@ -2329,7 +3370,7 @@ type IstioVersion struct {
 func (x *IstioVersion) Reset() {
 	*x = IstioVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[44]
+		mi := &file_platform_server_v1_web_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2342,7 +3383,7 @@ func (x *IstioVersion) String() string {
 func (*IstioVersion) ProtoMessage() {}
 
 func (x *IstioVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[44]
+	mi := &file_platform_server_v1_web_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2355,7 +3396,7 @@ func (x *IstioVersion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IstioVersion.ProtoReflect.Descriptor instead.
 func (*IstioVersion) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{44}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *IstioVersion) GetRepo() string {
@@ -2384,7 +3425,7 @@ type GatewayAPIVersion struct {
 func (x *GatewayAPIVersion) Reset() {
 	*x = GatewayAPIVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[45]
+		mi := &file_platform_server_v1_web_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2397,7 +3438,7 @@ func (x *GatewayAPIVersion) String() string {
 func (*GatewayAPIVersion) ProtoMessage() {}
 
 func (x *GatewayAPIVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[45]
+	mi := &file_platform_server_v1_web_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2410,7 +3451,7 @@ func (x *GatewayAPIVersion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewayAPIVersion.ProtoReflect.Descriptor instead.
 func (*GatewayAPIVersion) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{45}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *GatewayAPIVersion) GetUrl() string {
@@ -2439,7 +3480,7 @@ type ServerVersion struct {
 func (x *ServerVersion) Reset() {
 	*x = ServerVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[46]
+		mi := &file_platform_server_v1_web_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2452,7 +3493,7 @@ func (x *ServerVersion) String() string {
 func (*ServerVersion) ProtoMessage() {}
 
 func (x *ServerVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[46]
+	mi := &file_platform_server_v1_web_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2465,7 +3506,7 @@ func (x *ServerVersion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerVersion.ProtoReflect.Descriptor instead.
 func (*ServerVersion) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{46}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *ServerVersion) GetImage() string {
@@ -2494,7 +3535,7 @@ type DaemonVersion struct {
 func (x *DaemonVersion) Reset() {
 	*x = DaemonVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[47]
+		mi := &file_platform_server_v1_web_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2507,7 +3548,7 @@ func (x *DaemonVersion) String() string {
 func (*DaemonVersion) ProtoMessage() {}
 
 func (x *DaemonVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[47]
+	mi := &file_platform_server_v1_web_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2520,7 +3561,7 @@ func (x *DaemonVersion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DaemonVersion.ProtoReflect.Descriptor instead.
 func (*DaemonVersion) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{47}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *DaemonVersion) GetImage() string {
@@ -2552,7 +3593,7 @@ type AppStoreEntries struct {
 func (x *AppStoreEntries) Reset() {
 	*x = AppStoreEntries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[48]
+		mi := &file_platform_server_v1_web_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2565,7 +3606,7 @@ func (x *AppStoreEntries) String() string {
 func (*AppStoreEntries) ProtoMessage() {}
 
 func (x *AppStoreEntries) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[48]
+	mi := &file_platform_server_v1_web_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2578,7 +3619,7 @@ func (x *AppStoreEntries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppStoreEntries.ProtoReflect.Descriptor instead.
 func (*AppStoreEntries) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{48}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *AppStoreEntries) GetApiVersion() string {
@@ -2627,7 +3668,7 @@ type DeviceSettings struct {
 func (x *DeviceSettings) Reset() {
 	*x = DeviceSettings{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[49]
+		mi := &file_platform_server_v1_web_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2640,7 +3681,7 @@ func (x *DeviceSettings) String() string {
 func (*DeviceSettings) ProtoMessage() {}
 
 func (x *DeviceSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[49]
+	mi := &file_platform_server_v1_web_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2653,7 +3694,7 @@ func (x *DeviceSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeviceSettings.ProtoReflect.Descriptor instead.
 func (*DeviceSettings) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{49}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *DeviceSettings) GetAutoUpdateApps() bool {
@@ -2705,6 +3746,71 @@ func (x *DeviceSettings) GetAppStores() []*AppStore {
 	return nil
 }
 
+// Home Cloud account (the password is never returned)
+type Account struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username" bun:"username" csv:"username" pg:"username" yaml:"username"`
+	Email    string `protobuf:"bytes,2,opt,name=email,proto3" json:"email" bun:"email" csv:"email" pg:"email" yaml:"email"`
+	// admins can create, change and delete all accounts
+	Admin bool `protobuf:"varint,3,opt,name=admin,proto3" json:"admin" bun:"admin" csv:"admin" pg:"admin" yaml:"admin"`
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_server_v1_web_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_server_v1_web_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Account) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Account) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Account) GetAdmin() bool {
+	if x != nil {
+		return x.Admin
+	}
+	return false
+}
+
 type SecureTunnelingSettings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2717,7 +3823,7 @@ type SecureTunnelingSettings struct {
 func (x *SecureTunnelingSettings) Reset() {
 	*x = SecureTunnelingSettings{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[50]
+		mi := &file_platform_server_v1_web_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2730,7 +3836,7 @@ func (x *SecureTunnelingSettings) String() string {
 func (*SecureTunnelingSettings) ProtoMessage() {}
 
 func (x *SecureTunnelingSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[50]
+	mi := &file_platform_server_v1_web_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2743,7 +3849,7 @@ func (x *SecureTunnelingSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecureTunnelingSettings.ProtoReflect.Descriptor instead.
 func (*SecureTunnelingSettings) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{50}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *SecureTunnelingSettings) GetEnabled() bool {
@@ -2776,7 +3882,7 @@ type WireguardInterface struct {
 func (x *WireguardInterface) Reset() {
 	*x = WireguardInterface{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[51]
+		mi := &file_platform_server_v1_web_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2789,7 +3895,7 @@ func (x *WireguardInterface) String() string {
 func (*WireguardInterface) ProtoMessage() {}
 
 func (x *WireguardInterface) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[51]
+	mi := &file_platform_server_v1_web_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2802,7 +3908,7 @@ func (x *WireguardInterface) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WireguardInterface.ProtoReflect.Descriptor instead.
 func (*WireguardInterface) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{51}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *WireguardInterface) GetId() string {
@@ -2859,7 +3965,7 @@ type AppStore struct {
 func (x *AppStore) Reset() {
 	*x = AppStore{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[52]
+		mi := &file_platform_server_v1_web_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2872,7 +3978,7 @@ func (x *AppStore) String() string {
 func (*AppStore) ProtoMessage() {}
 
 func (x *AppStore) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[52]
+	mi := &file_platform_server_v1_web_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2885,7 +3991,7 @@ func (x *AppStore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppStore.ProtoReflect.Descriptor instead.
 func (*AppStore) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{52}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *AppStore) GetUrl() string {
@@ -2911,7 +4017,7 @@ type SubscribeRequest struct {
 func (x *SubscribeRequest) Reset() {
 	*x = SubscribeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[53]
+		mi := &file_platform_server_v1_web_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2924,7 +4030,7 @@ func (x *SubscribeRequest) String() string {
 func (*SubscribeRequest) ProtoMessage() {}
 
 func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[53]
+	mi := &file_platform_server_v1_web_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2937,7 +4043,7 @@ func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeRequest) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{53}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{71}
 }
 
 type ServerEvent struct {
@@ -2950,13 +4056,14 @@ type ServerEvent struct {
 	//	*ServerEvent_Heartbeat
 	//	*ServerEvent_Error
 	//	*ServerEvent_AppInstalled
+	//	*ServerEvent_AppRestore
 	Event isServerEvent_Event `protobuf_oneof:"event"`
 }
 
 func (x *ServerEvent) Reset() {
 	*x = ServerEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[54]
+		mi := &file_platform_server_v1_web_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2969,7 +4076,7 @@ func (x *ServerEvent) String() string {
 func (*ServerEvent) ProtoMessage() {}
 
 func (x *ServerEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[54]
+	mi := &file_platform_server_v1_web_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2982,7 +4089,7 @@ func (x *ServerEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerEvent.ProtoReflect.Descriptor instead.
 func (*ServerEvent) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{54}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{72}
 }
 
 func (m *ServerEvent) GetEvent() isServerEvent_Event {
@@ -3013,6 +4120,13 @@ func (x *ServerEvent) GetAppInstalled() *AppInstalledEvent {
 	return nil
 }
 
+func (x *ServerEvent) GetAppRestore() *AppRestoreEvent {
+	if x, ok := x.GetEvent().(*ServerEvent_AppRestore); ok {
+		return x.AppRestore
+	}
+	return nil
+}
+
 type isServerEvent_Event interface {
 	isServerEvent_Event()
 }
@@ -3029,12 +4143,18 @@ type ServerEvent_AppInstalled struct {
 	AppInstalled *AppInstalledEvent `protobuf:"bytes,3,opt,name=app_installed,json=appInstalled,proto3,oneof" bun:"app_installed" csv:"app_installed" json:"app_installed" pg:"app_installed" yaml:"appInstalled"`
 }
 
+type ServerEvent_AppRestore struct {
+	AppRestore *AppRestoreEvent `protobuf:"bytes,4,opt,name=app_restore,json=appRestore,proto3,oneof" bun:"app_restore" csv:"app_restore" json:"app_restore" pg:"app_restore" yaml:"appRestore"`
+}
+
 func (*ServerEvent_Heartbeat) isServerEvent_Event() {}
 
 func (*ServerEvent_Error) isServerEvent_Event() {}
 
 func (*ServerEvent_AppInstalled) isServerEvent_Event() {}
 
+func (*ServerEvent_AppRestore) isServerEvent_Event() {}
+
 type HeartbeatEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3044,7 +4164,7 @@ type HeartbeatEvent struct {
 func (x *HeartbeatEvent) Reset() {
 	*x = HeartbeatEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[55]
+		mi := &file_platform_server_v1_web_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3057,7 +4177,7 @@ func (x *HeartbeatEvent) String() string {
 func (*HeartbeatEvent) ProtoMessage() {}
 
 func (x *HeartbeatEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[55]
+	mi := &file_platform_server_v1_web_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3070,7 +4190,7 @@ func (x *HeartbeatEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HeartbeatEvent.ProtoReflect.Descriptor instead.
 func (*HeartbeatEvent) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{55}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{73}
 }
 
 type ErrorEvent struct {
@@ -3084,7 +4204,7 @@ type ErrorEvent struct {
 func (x *ErrorEvent) Reset() {
 	*x = ErrorEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[56]
+		mi := &file_platform_server_v1_web_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3097,7 +4217,7 @@ func (x *ErrorEvent) String() string {
 func (*ErrorEvent) ProtoMessage() {}
 
 func (x *ErrorEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[56]
+	mi := &file_platform_server_v1_web_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3110,7 +4230,7 @@ func (x *ErrorEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrorEvent.ProtoReflect.Descriptor instead.
 func (*ErrorEvent) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{56}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *ErrorEvent) GetError() string {
@@ -3131,7 +4251,7 @@ type AppInstalledEvent struct {
 func (x *AppInstalledEvent) Reset() {
 	*x = AppInstalledEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[57]
+		mi := &file_platform_server_v1_web_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3144,7 +4264,7 @@ func (x *AppInstalledEvent) String() string {
 func (*AppInstalledEvent) ProtoMessage() {}
 
 func (x *AppInstalledEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[57]
+	mi := &file_platform_server_v1_web_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3157,7 +4277,7 @@ func (x *AppInstalledEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppInstalledEvent.ProtoReflect.Descriptor instead.
 func (*AppInstalledEvent) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{57}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *AppInstalledEvent) GetName() string {
@@ -3167,6 +4287,78 @@ func (x *AppInstalledEvent) GetName() string {
 	return ""
 }
 
+type AppRestoreEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppName string `protobuf:"bytes,1,opt,name=app_name,json=appName,proto3" json:"app_name" bun:"app_name" csv:"app_name" pg:"app_name" yaml:"appName"`
+	Restore string `protobuf:"bytes,2,opt,name=restore,proto3" json:"restore" bun:"restore" csv:"restore" pg:"restore" yaml:"restore"`
+	// Stopping, Restoring, Completed, or Failed
+	Phase string `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase" bun:"phase" csv:"phase" pg:"phase" yaml:"phase"`
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error" bun:"error" csv:"error" pg:"error" yaml:"error"`
+}
+
+func (x *AppRestoreEvent) Reset() {
+	*x = AppRestoreEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_server_v1_web_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppRestoreEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppRestoreEvent) ProtoMessage() {}
+
+func (x *AppRestoreEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_server_v1_web_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppRestoreEvent.ProtoReflect.Descriptor instead.
+func (*AppRestoreEvent) Descriptor() ([]byte, []int) {
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *AppRestoreEvent) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+func (x *AppRestoreEvent) GetRestore() string {
+	if x != nil {
+		return x.Restore
+	}
+	return ""
+}
+
+func (x *AppRestoreEvent) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *AppRestoreEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 type RegisterPeerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3176,7 +4368,7 @@ type RegisterPeerRequest struct {
 func (x *RegisterPeerRequest) Reset() {
 	*x = RegisterPeerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[58]
+		mi := &file_platform_server_v1_web_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3189,7 +4381,7 @@ func (x *RegisterPeerRequest) String() string {
 func (*RegisterPeerRequest) ProtoMessage() {}
 
 func (x *RegisterPeerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[58]
+	mi := &file_platform_server_v1_web_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3202,7 +4394,7 @@ func (x *RegisterPeerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterPeerRequest.ProtoReflect.Descriptor instead.
 func (*RegisterPeerRequest) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{58}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{77}
 }
 
 type RegisterPeerResponse struct {
@@ -3225,7 +4417,7 @@ type RegisterPeerResponse struct {
 func (x *RegisterPeerResponse) Reset() {
 	*x = RegisterPeerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[59]
+		mi := &file_platform_server_v1_web_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3238,7 +4430,7 @@ func (x *RegisterPeerResponse) String() string {
 func (*RegisterPeerResponse) ProtoMessage() {}
 
 func (x *RegisterPeerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[59]
+	mi := &file_platform_server_v1_web_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3251,7 +4443,7 @@ func (x *RegisterPeerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterPeerResponse.ProtoReflect.Descriptor instead.
 func (*RegisterPeerResponse) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{59}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *RegisterPeerResponse) GetId() string {
@@ -3321,7 +4513,7 @@ type DeregisterPeerRequest struct {
 func (x *DeregisterPeerRequest) Reset() {
 	*x = DeregisterPeerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[60]
+		mi := &file_platform_server_v1_web_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3334,7 +4526,7 @@ func (x *DeregisterPeerRequest) String() string {
 func (*DeregisterPeerRequest) ProtoMessage() {}
 
 func (x *DeregisterPeerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[60]
+	mi := &file_platform_server_v1_web_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3347,7 +4539,7 @@ func (x *DeregisterPeerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeregisterPeerRequest.ProtoReflect.Descriptor instead.
 func (*DeregisterPeerRequest) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{60}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *DeregisterPeerRequest) GetId() string {
@@ -3366,7 +4558,7 @@ type DeregisterPeerResponse struct {
 func (x *DeregisterPeerResponse) Reset() {
 	*x = DeregisterPeerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_platform_server_v1_web_proto_msgTypes[61]
+		mi := &file_platform_server_v1_web_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3379,7 +4571,7 @@ func (x *DeregisterPeerResponse) String() string {
 func (*DeregisterPeerResponse) ProtoMessage() {}
 
 func (x *DeregisterPeerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_platform_server_v1_web_proto_msgTypes[61]
+	mi := &file_platform_server_v1_web_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3392,7 +4584,7 @@ func (x *DeregisterPeerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeregisterPeerResponse.ProtoReflect.Descriptor instead.
 func (*DeregisterPeerResponse) Descriptor() ([]byte, []int) {
-	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{61}
+	return file_platform_server_v1_web_proto_rawDescGZIP(), []int{80}
 }
 
 var File_platform_server_v1_web_proto protoreflect.FileDescriptor
@@ -3446,7 +4638,7 @@ var file_platform_server_v1_web_proto_rawDesc = []byte{
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
 	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x48, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x22, 0x90, 0x01, 0x0a,
+	0x61, 0x6c, 0x74, 0x68, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x22, 0xbc, 0x01, 0x0a,
 	0x09, 0x41, 0x70, 0x70, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
 	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35,
 	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d,
@@ -3455,452 +4647,625 @@ var file_platform_server_v1_web_proto_rawDesc = []byte{
 	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
 	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x44,
-	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x22,
-	0x5d, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x63, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x17,
-	0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x64, 0x61, 0x65, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41,
-	0x70, 0x70, 0x73, 0x49, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x45, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x73, 0x49, 0x6e, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x61,
-	0x70, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6c, 0x61, 0x74,
-	0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x70, 0x70, 0x52, 0x04, 0x61, 0x70, 0x70, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x5b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3e, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x73, 0x22, 0x5a, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5d, 0x0a, 0x0a, 0x41,
+	0x70, 0x70, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x69, 0x63, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x73, 0x49,
+	0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x45, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x73, 0x49, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x61, 0x70, 0x70, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x04,
+	0x61, 0x70, 0x70, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x5b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a,
 	0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x22, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
 	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x1b, 0x0a,
-	0x19, 0x53, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65,
-	0x74, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x4b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x61,
-	0x70, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6c, 0x61, 0x74,
-	0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x04, 0x61, 0x70, 0x70, 0x73, 0x22,
-	0x41, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a,
+	0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x5a, 0x0a,
+	0x18, 0x53, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x08, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x65, 0x74,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x41, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x3c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x41, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22,
+	0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
+	0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x12, 0x53, 0x61, 0x76, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x29, 0x0a, 0x10,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0x15, 0x0a,
+	0x13, 0x53, 0x61, 0x76, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
+	0x04, 0x61, 0x70, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x04, 0x61, 0x70, 0x70,
+	0x73, 0x22, 0x41, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x73, 0x22, 0x32, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x42,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
 	0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x73, 0x22, 0x1f, 0x0a, 0x1d, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x63, 0x75,
-	0x72, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x20, 0x0a, 0x1e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x63,
-	0x75, 0x72, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
-	0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x21, 0x0a, 0x1f, 0x44, 0x69, 0x73, 0x61, 0x62,
-	0x6c, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x6c, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x74, 0x0a, 0x18, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f,
-	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x2f, 0x0a, 0x13, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x77, 0x69,
-	0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
-	0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78, 0x0a,
-	0x1c, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a,
-	0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75,
-	0x61, 0x72, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x12, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x65, 0x72, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74,
-	0x66, 0x6f, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6c, 0x61,
-	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6c, 0x61,
-	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22, 0x3b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x65,
-	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2b, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73,
-	0x22, 0x33, 0x0a, 0x04, 0x41, 0x70, 0x70, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x61, 0x70, 0x70, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
-	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x52,
-	0x04, 0x61, 0x70, 0x70, 0x73, 0x22, 0x9b, 0x04, 0x0a, 0x03, 0x41, 0x70, 0x70, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
-	0x70, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x63,
-	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69,
-	0x67, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73,
-	0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x12, 0x45, 0x0a, 0x0c,
-	0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x44, 0x65, 0x70, 0x65, 0x6e,
-	0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
-	0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x68, 0x6f, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x73, 0x12, 0x4a, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
-	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x2e,
-	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x72, 0x65, 0x61, 0x64, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
-	0x65, 0x61, 0x64, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x65, 0x64, 0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x5d, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
-	0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
-	0x72, 0x79, 0x22, 0x77, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x36, 0x0a, 0x07, 0x45,
-	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x61, 0x70, 0x70, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x04, 0x61,
-	0x70, 0x70, 0x73, 0x22, 0x9f, 0x02, 0x0a, 0x0d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x36, 0x0a, 0x05, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x51, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x70, 0x70, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x09,
+	0x41, 0x70, 0x70, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x46, 0x0a, 0x11, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x22, 0x2e, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x70, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x17, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41, 0x70,
+	0x70, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63,
+	0x68, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xeb, 0x01, 0x0a, 0x18, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x41, 0x70, 0x70, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4d,
+	0x0a, 0x10, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0f, 0x6d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x53, 0x0a,
+	0x12, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x11, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x0e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64,
+	0x69, 0x66, 0x66, 0x22, 0x90, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x32, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e,
 	0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x05, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x12, 0x46, 0x0a, 0x0b, 0x67, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x5f, 0x61, 0x70, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70,
-	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x50, 0x49, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x70, 0x69, 0x12,
-	0x39, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x21, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x06, 0x64, 0x61,
-	0x65, 0x6d, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x6c, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1f, 0x0a, 0x1d, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x20, 0x0a, 0x1e, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x21, 0x0a, 0x1f, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x74,
+	0x0a, 0x18, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x54, 0x6f, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x78, 0x0a, 0x1c, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46,
+	0x72, 0x6f, 0x6d, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x77, 0x69,
+	0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x44,
+	0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x0a, 0x1b,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x1c,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x08,
+	0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x3c,
+	0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22, 0x3b, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x73, 0x22, 0x33, 0x0a, 0x04, 0x41, 0x70, 0x70, 0x73, 0x12, 0x2b, 0x0a, 0x04,
+	0x61, 0x70, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6c, 0x61,
 	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x64,
-	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x22, 0x3c, 0x0a, 0x0c, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x22, 0x3f, 0x0a, 0x11, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x50,
-	0x49, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74,
-	0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x37, 0x0a,
-	0x0d, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14,
-	0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x96, 0x02, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70,
-	0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x67,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x72, 0x61, 0x77,
-	0x5f, 0x63, 0x68, 0x61, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x72, 0x61, 0x77, 0x43, 0x68, 0x61, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x4a, 0x0a,
-	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30,
+	0x41, 0x70, 0x70, 0x52, 0x04, 0x61, 0x70, 0x70, 0x73, 0x22, 0x9b, 0x04, 0x0a, 0x03, 0x41, 0x70,
+	0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x72, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73,
+	0x12, 0x45, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x44,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x6d, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x70, 0x70, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x64, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x64, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5d, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x44, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x77, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x52, 0x75, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x36, 0x0a, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x61, 0x70,
+	0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70,
+	0x70, 0x52, 0x04, 0x61, 0x70, 0x70, 0x73, 0x22, 0x9f, 0x02, 0x0a, 0x0d, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x05, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x12, 0x46, 0x0a, 0x0b, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x61, 0x70, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x50, 0x49,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x41, 0x70, 0x69, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x39,
+	0x0a, 0x06, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
 	0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x54, 0x0a, 0x0c, 0x45, 0x6e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6c, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x22, 0x3c, 0x0a, 0x0c, 0x49, 0x73, 0x74,
+	0x69, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3f, 0x0a, 0x11, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x41, 0x50, 0x49, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61,
+	0x67, 0x22, 0x37, 0x0a, 0x0d, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x96, 0x02, 0x0a, 0x0f, 0x41,
+	0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12, 0x22, 0x0a,
+	0x0d, 0x72, 0x61, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x61, 0x77, 0x43, 0x68, 0x61, 0x72, 0x74, 0x55, 0x72,
+	0x6c, 0x12, 0x4a, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x54, 0x0a,
+	0x0c, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xa4, 0x03, 0x0a, 0x0e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x70, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x73,
+	0x12, 0x2c, 0x0a, 0x12, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x61, 0x75,
+	0x74, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x67,
+	0x0a, 0x19, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x17,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x69, 0x6e, 0x67, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x61, 0x70, 0x70, 0x73, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x61, 0x75, 0x74, 0x6f, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x70, 0x70, 0x73, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3d,
+	0x0a, 0x1b, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x18, 0x61, 0x75, 0x74, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3b, 0x0a,
+	0x0a, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x09, 0x61, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x51, 0x0a, 0x07, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0x8e, 0x01,
+	0x0a, 0x17, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x69, 0x6e,
+	0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x59, 0x0a, 0x14, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x13, 0x77, 0x69, 0x72, 0x65, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22, 0xb5,
+	0x01, 0x0a, 0x12, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x74, 0x75, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x74, 0x75, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x27, 0x0a,
+	0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22, 0x40, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x22, 0x0a, 0x0d, 0x72, 0x61, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x72,
+	0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x61, 0x77,
+	0x43, 0x68, 0x61, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x22, 0x12, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa8, 0x02, 0x0a,
+	0x0b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x09,
+	0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
+	0x12, 0x36, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48,
+	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x4c, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x5f,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x5f, 0x72, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x48, 0x00, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x07,
+	0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x10, 0x0a, 0x0e, 0x48, 0x65, 0x61, 0x72, 0x74,
+	0x62, 0x65, 0x61, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x22, 0x0a, 0x0a, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x27, 0x0a,
+	0x11, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x72, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70,
+	0x68, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x15, 0x0a, 0x13, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x97, 0x02, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x65,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6e, 0x73, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x64,
+	0x6e, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22, 0x27, 0x0a, 0x15, 0x44,
+	0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x59,
+	0x0a, 0x09, 0x41, 0x70, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x16, 0x41,
+	0x50, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x50, 0x50, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x01, 0x12,
+	0x18, 0x0a, 0x14, 0x41, 0x50, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
+	0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x02, 0x2a, 0x73, 0x0a, 0x0a, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x43,
+	0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x03, 0x32, 0xe5,
+	0x16, 0x0a, 0x0a, 0x57, 0x65, 0x62, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a,
+	0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x24, 0x2e, 0x70, 0x6c, 0x61,
 	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x70, 0x70, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0xa4, 0x03, 0x0a, 0x0e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x5f, 0x61, 0x70, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x75,
-	0x74, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x73, 0x12, 0x2c, 0x0a, 0x12,
-	0x61, 0x75, 0x74, 0x6f, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x61, 0x75, 0x74, 0x6f, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x67, 0x0a, 0x19, 0x73, 0x65,
-	0x63, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
-	0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x69,
-	0x6e, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x17, 0x73, 0x65, 0x63, 0x75,
-	0x72, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x39, 0x0a, 0x19, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61,
-	0x70, 0x70, 0x73, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x16, 0x61, 0x75, 0x74, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70,
-	0x70, 0x73, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x1b, 0x61, 0x75,
-	0x74, 0x6f, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x18, 0x61, 0x75, 0x74, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x61, 0x70, 0x70,
-	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5d, 0x0a, 0x0a, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x41, 0x70, 0x70, 0x12, 0x25, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70,
+	0x70, 0x12, 0x24, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5a, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x12, 0x24, 0x2e,
 	0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x09, 0x61, 0x70, 0x70,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x63, 0x75, 0x72,
-	0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x59, 0x0a, 0x14,
-	0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x6c, 0x61,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x70, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x0f,
+	0x41, 0x70, 0x70, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12,
+	0x2a, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x70, 0x70, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x41, 0x70, 0x70, 0x73, 0x49, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x29, 0x2e, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x73, 0x49, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x70, 0x70, 0x73, 0x49, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x28, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a,
+	0x0e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x12,
+	0x29, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x6c, 0x61,
 	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x57, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x52, 0x13, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x12, 0x57, 0x69, 0x72, 0x65,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x75, 0x6e, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x75, 0x6e,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f,
-	0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22,
-	0x40, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75,
-	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x22, 0x0a,
-	0x0d, 0x72, 0x61, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x61, 0x77, 0x43, 0x68, 0x61, 0x72, 0x74, 0x55, 0x72,
-	0x6c, 0x22, 0x12, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe0, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
-	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66,
-	0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65,
-	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x09,
-	0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x36, 0x0a, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66,
-	0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x4c, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66,
-	0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70,
-	0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48,
-	0x00, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x42,
-	0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x10, 0x0a, 0x0e, 0x48, 0x65, 0x61, 0x72,
-	0x74, 0x62, 0x65, 0x61, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x22, 0x0a, 0x0a, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x27,
-	0x0a, 0x11, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x97,
-	0x02, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x65, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6e, 0x73, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
-	0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f,
-	0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22, 0x27, 0x0a, 0x15, 0x44, 0x65, 0x72, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50,
-	0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x59, 0x0a, 0x09, 0x41,
-	0x70, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x50, 0x50, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x50, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14,
-	0x41, 0x50, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x48, 0x45, 0x41,
-	0x4c, 0x54, 0x48, 0x59, 0x10, 0x02, 0x32, 0x8a, 0x11, 0x0a, 0x0a, 0x57, 0x65, 0x62, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x12, 0x24, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66,
-	0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5d, 0x0a,
-	0x0a, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x12, 0x25, 0x2e, 0x70, 0x6c,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x41, 0x70, 0x70, 0x12, 0x25, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x41, 0x70, 0x70, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2b, 0x2e, 0x70, 0x6c,
 	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x41,
-	0x70, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x09,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x12, 0x24, 0x2e, 0x70, 0x6c, 0x61, 0x74,
-	0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x25, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x41, 0x70, 0x70, 0x12, 0x24, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x6c,
+	0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41, 0x70, 0x70, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x41, 0x70, 0x70, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x0c, 0x53, 0x68, 0x75, 0x74,
+	0x64, 0x6f, 0x77, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x27, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68,
+	0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x48,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a,
+	0x0b, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x26, 0x2e, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x69, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x29, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7b, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x2f, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x28, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x72, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x2c, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2c, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x41,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x2e, 0x70, 0x6c,
 	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x73, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x2a, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
-	0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70,
-	0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x73, 0x48, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x69, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x73, 0x49, 0x6e, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x12, 0x29, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70,
-	0x73, 0x49, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2a, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x73, 0x49, 0x6e, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a,
-	0x0d, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x28,
-	0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x41, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66,
 	0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x70, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x0c, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77,
-	0x6e, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x27, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x75, 0x74, 0x64,
-	0x6f, 0x77, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
-	0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x48, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0b, 0x52, 0x65,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x26, 0x2e, 0x70, 0x6c, 0x61, 0x74,
-	0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x27, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x29,
-	0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x6c, 0x61, 0x74,
-	0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x2f, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x30, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
-	0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x28, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x29, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4c, 0x6f,
-	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x73, 0x12, 0x2c, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2d, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x72, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2c, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x81, 0x01, 0x0a, 0x16, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53,
-	0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12,
-	0x31, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72,
-	0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65,
+	0x74, 0x43, 0x41, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a,
+	0x0b, 0x53, 0x61, 0x76, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x2e, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x66, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x28, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x81, 0x01, 0x0a, 0x16, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x12, 0x31, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65,
 	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65,
 	0x63, 0x75, 0x72, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x84, 0x01, 0x0a, 0x17, 0x44, 0x69, 0x73,
-	0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x6c, 0x69, 0x6e, 0x67, 0x12, 0x32, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c,
 	0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x6c, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66,
-	0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69,
-	0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x72, 0x0a, 0x11, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x6f, 0x72, 0x12, 0x2c, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x54, 0x6f, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x54, 0x6f, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x7e, 0x0a, 0x15, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x46, 0x72, 0x6f, 0x6d, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x2e, 0x70,
-	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31,
-	0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x72,
-	0x6f, 0x6d, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50,
-	0x65, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70,
-	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x0e, 0x44, 0x65, 0x72, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x65, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x70, 0x6c, 0x61,
-	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x68, 0x6f, 0x6d, 0x65, 0x2d, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2d, 0x69, 0x6f, 0x2f,
-	0x63, 0x6f, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
-	0x6d, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x84, 0x01, 0x0a, 0x17,
+	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x32, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x72, 0x0a, 0x11, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2c, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x54, 0x6f, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7e, 0x0a, 0x15, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x30, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46,
+	0x72, 0x6f, 0x6d, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x31, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x46, 0x72, 0x6f, 0x6d, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x50, 0x65, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x65, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x0e, 0x44,
+	0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x65, 0x65, 0x72, 0x12, 0x29, 0x2e,
+	0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x65, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x6f, 0x6d, 0x65, 0x2d, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2d,
+	0x69, 0x6f, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3915,152 +5280,193 @@ func file_platform_server_v1_web_proto_rawDescGZIP() []byte {
 	return file_platform_server_v1_web_proto_rawDescData
 }
 
-var file_platform_server_v1_web_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_platform_server_v1_web_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
+var file_platform_server_v1_web_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_platform_server_v1_web_proto_msgTypes = make([]protoimpl.MessageInfo, 83)
 var file_platform_server_v1_web_proto_goTypes = []any{
 	(AppStatus)(0),                          // 0: platform.server.v1.AppStatus
-	(*ShutdownHostRequest)(nil),             // 1: platform.server.v1.ShutdownHostRequest
-	(*ShutdownHostResponse)(nil),            // 2: platform.server.v1.ShutdownHostResponse
-	(*RestartHostRequest)(nil),              // 3: platform.server.v1.RestartHostRequest
-	(*RestartHostResponse)(nil),             // 4: platform.server.v1.RestartHostResponse
-	(*InstallAppRequest)(nil),               // 5: platform.server.v1.InstallAppRequest
-	(*InstallAppResponse)(nil),              // 6: platform.server.v1.InstallAppResponse
-	(*UpdateAppRequest)(nil),                // 7: platform.server.v1.UpdateAppRequest
-	(*UpdateAppResponse)(nil),               // 8: platform.server.v1.UpdateAppResponse
-	(*DeleteAppRequest)(nil),                // 9: platform.server.v1.DeleteAppRequest
-	(*DeleteAppResponse)(nil),               // 10: platform.server.v1.DeleteAppResponse
-	(*ImageVersion)(nil),                    // 11: platform.server.v1.ImageVersion
-	(*AppsHealthCheckRequest)(nil),          // 12: platform.server.v1.AppsHealthCheckRequest
-	(*AppsHealthCheckResponse)(nil),         // 13: platform.server.v1.AppsHealthCheckResponse
-	(*AppHealth)(nil),                       // 14: platform.server.v1.AppHealth
-	(*AppDisplay)(nil),                      // 15: platform.server.v1.AppDisplay
-	(*GetSystemStatsRequest)(nil),           // 16: platform.server.v1.GetSystemStatsRequest
-	(*GetSystemStatsResponse)(nil),          // 17: platform.server.v1.GetSystemStatsResponse
-	(*GetAppsInStoreRequest)(nil),           // 18: platform.server.v1.GetAppsInStoreRequest
-	(*GetAppsInStoreResponse)(nil),          // 19: platform.server.v1.GetAppsInStoreResponse
-	(*GetDeviceSettingsRequest)(nil),        // 20: platform.server.v1.GetDeviceSettingsRequest
-	(*GetDeviceSettingsResponse)(nil),       // 21: platform.server.v1.GetDeviceSettingsResponse
-	(*SetDeviceSettingsRequest)(nil),        // 22: platform.server.v1.SetDeviceSettingsRequest
-	(*SetDeviceSettingsResponse)(nil),       // 23: platform.server.v1.SetDeviceSettingsResponse
-	(*GetAppStorageRequest)(nil),            // 24: platform.server.v1.GetAppStorageRequest
-	(*GetAppStorageResponse)(nil),           // 25: platform.server.v1.GetAppStorageResponse
-	(*AppStorage)(nil),                      // 26: platform.server.v1.AppStorage
-	(*EnableSecureTunnellingRequest)(nil),   // 27: platform.server.v1.EnableSecureTunnellingRequest
-	(*EnableSecureTunnellingResponse)(nil),  // 28: platform.server.v1.EnableSecureTunnellingResponse
-	(*DisableSecureTunnellingRequest)(nil),  // 29: platform.server.v1.DisableSecureTunnellingRequest
-	(*DisableSecureTunnellingResponse)(nil), // 30: platform.server.v1.DisableSecureTunnellingResponse
-	(*RegisterToLocatorRequest)(nil),        // 31: platform.server.v1.RegisterToLocatorRequest
-	(*RegisterToLocatorResponse)(nil),       // 32: platform.server.v1.RegisterToLocatorResponse
-	(*DeregisterFromLocatorRequest)(nil),    // 33: platform.server.v1.DeregisterFromLocatorRequest
-	(*DeregisterFromLocatorResponse)(nil),   // 34: platform.server.v1.DeregisterFromLocatorResponse
-	(*GetComponentVersionsRequest)(nil),     // 35: platform.server.v1.GetComponentVersionsRequest
-	(*GetComponentVersionsResponse)(nil),    // 36: platform.server.v1.GetComponentVersionsResponse
-	(*GetSystemLogsRequest)(nil),            // 37: platform.server.v1.GetSystemLogsRequest
-	(*GetSystemLogsResponse)(nil),           // 38: platform.server.v1.GetSystemLogsResponse
-	(*Apps)(nil),                            // 39: platform.server.v1.Apps
-	(*App)(nil),                             // 40: platform.server.v1.App
-	(*AppDependency)(nil),                   // 41: platform.server.v1.AppDependency
-	(*AppRunningStatus)(nil),                // 42: platform.server.v1.AppRunningStatus
-	(*Entries)(nil),                         // 43: platform.server.v1.Entries
-	(*SystemVersion)(nil),                   // 44: platform.server.v1.SystemVersion
-	(*IstioVersion)(nil),                    // 45: platform.server.v1.IstioVersion
-	(*GatewayAPIVersion)(nil),               // 46: platform.server.v1.GatewayAPIVersion
-	(*ServerVersion)(nil),                   // 47: platform.server.v1.ServerVersion
-	(*DaemonVersion)(nil),                   // 48: platform.server.v1.DaemonVersion
-	(*AppStoreEntries)(nil),                 // 49: platform.server.v1.AppStoreEntries
-	(*DeviceSettings)(nil),                  // 50: platform.server.v1.DeviceSettings
-	(*SecureTunnelingSettings)(nil),         // 51: platform.server.v1.SecureTunnelingSettings
-	(*WireguardInterface)(nil),              // 52: platform.server.v1.WireguardInterface
-	(*AppStore)(nil),                        // 53: platform.server.v1.AppStore
-	(*SubscribeRequest)(nil),                // 54: platform.server.v1.SubscribeRequest
-	(*ServerEvent)(nil),                     // 55: platform.server.v1.ServerEvent
-	(*HeartbeatEvent)(nil),                  // 56: platform.server.v1.HeartbeatEvent
-	(*ErrorEvent)(nil),                      // 57: platform.server.v1.ErrorEvent
-	(*AppInstalledEvent)(nil),               // 58: platform.server.v1.AppInstalledEvent
-	(*RegisterPeerRequest)(nil),             // 59: platform.server.v1.RegisterPeerRequest
-	(*RegisterPeerResponse)(nil),            // 60: platform.server.v1.RegisterPeerResponse
-	(*DeregisterPeerRequest)(nil),           // 61: platform.server.v1.DeregisterPeerRequest
-	(*DeregisterPeerResponse)(nil),          // 62: platform.server.v1.DeregisterPeerResponse
-	nil,                                     // 63: platform.server.v1.App.AnnotationsEntry
-	nil,                                     // 64: platform.server.v1.AppStoreEntries.EntriesEntry
-	(*v1.SystemStats)(nil),                  // 65: platform.daemon.v1.SystemStats
-	(*v1.ComponentVersion)(nil),             // 66: platform.daemon.v1.ComponentVersion
-	(*v1.Log)(nil),                          // 67: platform.daemon.v1.Log
+	(ChangeType)(0),                         // 1: platform.server.v1.ChangeType
+	(*ShutdownHostRequest)(nil),             // 2: platform.server.v1.ShutdownHostRequest
+	(*ShutdownHostResponse)(nil),            // 3: platform.server.v1.ShutdownHostResponse
+	(*RestartHostRequest)(nil),              // 4: platform.server.v1.RestartHostRequest
+	(*RestartHostResponse)(nil),             // 5: platform.server.v1.RestartHostResponse
+	(*InstallAppRequest)(nil),               // 6: platform.server.v1.InstallAppRequest
+	(*InstallAppResponse)(nil),              // 7: platform.server.v1.InstallAppResponse
+	(*UpdateAppRequest)(nil),                // 8: platform.server.v1.UpdateAppRequest
+	(*UpdateAppResponse)(nil),               // 9: platform.server.v1.UpdateAppResponse
+	(*DeleteAppRequest)(nil),                // 10: platform.server.v1.DeleteAppRequest
+	(*DeleteAppResponse)(nil),               // 11: platform.server.v1.DeleteAppResponse
+	(*ImageVersion)(nil),                    // 12: platform.server.v1.ImageVersion
+	(*AppsHealthCheckRequest)(nil),          // 13: platform.server.v1.AppsHealthCheckRequest
+	(*AppsHealthCheckResponse)(nil),         // 14: platform.server.v1.AppsHealthCheckResponse
+	(*AppHealth)(nil),                       // 15: platform.server.v1.AppHealth
+	(*AppDisplay)(nil),                      // 16: platform.server.v1.AppDisplay
+	(*GetSystemStatsRequest)(nil),           // 17: platform.server.v1.GetSystemStatsRequest
+	(*GetSystemStatsResponse)(nil),          // 18: platform.server.v1.GetSystemStatsResponse
+	(*GetAppsInStoreRequest)(nil),           // 19: platform.server.v1.GetAppsInStoreRequest
+	(*GetAppsInStoreResponse)(nil),          // 20: platform.server.v1.GetAppsInStoreResponse
+	(*GetDeviceSettingsRequest)(nil),        // 21: platform.server.v1.GetDeviceSettingsRequest
+	(*GetDeviceSettingsResponse)(nil),       // 22: platform.server.v1.GetDeviceSettingsResponse
+	(*SetDeviceSettingsRequest)(nil),        // 23: platform.server.v1.SetDeviceSettingsRequest
+	(*SetDeviceSettingsResponse)(nil),       // 24: platform.server.v1.SetDeviceSettingsResponse
+	(*GetCACertificateRequest)(nil),         // 25: platform.server.v1.GetCACertificateRequest
+	(*GetCACertificateResponse)(nil),        // 26: platform.server.v1.GetCACertificateResponse
+	(*ListAccountsRequest)(nil),             // 27: platform.server.v1.ListAccountsRequest
+	(*ListAccountsResponse)(nil),            // 28: platform.server.v1.ListAccountsResponse
+	(*SaveAccountRequest)(nil),              // 29: platform.server.v1.SaveAccountRequest
+	(*SaveAccountResponse)(nil),             // 30: platform.server.v1.SaveAccountResponse
+	(*DeleteAccountRequest)(nil),            // 31: platform.server.v1.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),           // 32: platform.server.v1.DeleteAccountResponse
+	(*GetAppStorageRequest)(nil),            // 33: platform.server.v1.GetAppStorageRequest
+	(*GetAppStorageResponse)(nil),           // 34: platform.server.v1.GetAppStorageResponse
+	(*AppStorage)(nil),                      // 35: platform.server.v1.AppStorage
+	(*ListAppBackupsRequest)(nil),           // 36: platform.server.v1.ListAppBackupsRequest
+	(*ListAppBackupsResponse)(nil),          // 37: platform.server.v1.ListAppBackupsResponse
+	(*AppBackup)(nil),                       // 38: platform.server.v1.AppBackup
+	(*RestoreAppRequest)(nil),               // 39: platform.server.v1.RestoreAppRequest
+	(*RestoreAppResponse)(nil),              // 40: platform.server.v1.RestoreAppResponse
+	(*PreviewAppChangeRequest)(nil),         // 41: platform.server.v1.PreviewAppChangeRequest
+	(*PreviewAppChangeResponse)(nil),        // 42: platform.server.v1.PreviewAppChangeResponse
+	(*ManifestChange)(nil),                  // 43: platform.server.v1.ManifestChange
+	(*DependencyChange)(nil),                // 44: platform.server.v1.DependencyChange
+	(*EnableSecureTunnellingRequest)(nil),   // 45: platform.server.v1.EnableSecureTunnellingRequest
+	(*EnableSecureTunnellingResponse)(nil),  // 46: platform.server.v1.EnableSecureTunnellingResponse
+	(*DisableSecureTunnellingRequest)(nil),  // 47: platform.server.v1.DisableSecureTunnellingRequest
+	(*DisableSecureTunnellingResponse)(nil), // 48: platform.server.v1.DisableSecureTunnellingResponse
+	(*RegisterToLocatorRequest)(nil),        // 49: platform.server.v1.RegisterToLocatorRequest
+	(*RegisterToLocatorResponse)(nil),       // 50: platform.server.v1.RegisterToLocatorResponse
+	(*DeregisterFromLocatorRequest)(nil),    // 51: platform.server.v1.DeregisterFromLocatorRequest
+	(*DeregisterFromLocatorResponse)(nil),   // 52: platform.server.v1.DeregisterFromLocatorResponse
+	(*GetComponentVersionsRequest)(nil),     // 53: platform.server.v1.GetComponentVersionsRequest
+	(*GetComponentVersionsResponse)(nil),    // 54: platform.server.v1.GetComponentVersionsResponse
+	(*GetSystemLogsRequest)(nil),            // 55: platform.server.v1.GetSystemLogsRequest
+	(*GetSystemLogsResponse)(nil),           // 56: platform.server.v1.GetSystemLogsResponse
+	(*Apps)(nil),                            // 57: platform.server.v1.Apps
+	(*App)(nil),                             // 58: platform.server.v1.App
+	(*AppDependency)(nil),                   // 59: platform.server.v1.AppDependency
+	(*AppRunningStatus)(nil),                // 60: platform.server.v1.AppRunningStatus
+	(*Entries)(nil),                         // 61: platform.server.v1.Entries
+	(*SystemVersion)(nil),                   // 62: platform.server.v1.SystemVersion
+	(*IstioVersion)(nil),                    // 63: platform.server.v1.IstioVersion
+	(*GatewayAPIVersion)(nil),               // 64: platform.server.v1.GatewayAPIVersion
+	(*ServerVersion)(nil),                   // 65: platform.server.v1.ServerVersion
+	(*DaemonVersion)(nil),                   // 66: platform.server.v1.DaemonVersion
+	(*AppStoreEntries)(nil),                 // 67: platform.server.v1.AppStoreEntries
+	(*DeviceSettings)(nil),                  // 68: platform.server.v1.DeviceSettings
+	(*Account)(nil),                         // 69: platform.server.v1.Account
+	(*SecureTunnelingSettings)(nil),         // 70: platform.server.v1.SecureTunnelingSettings
+	(*WireguardInterface)(nil),              // 71: platform.server.v1.WireguardInterface
+	(*AppStore)(nil),                        // 72: platform.server.v1.AppStore
+	(*SubscribeRequest)(nil),                // 73: platform.server.v1.SubscribeRequest
+	(*ServerEvent)(nil),                     // 74: platform.server.v1.ServerEvent
+	(*HeartbeatEvent)(nil),                  // 75: platform.server.v1.HeartbeatEvent
+	(*ErrorEvent)(nil),                      // 76: platform.server.v1.ErrorEvent
+	(*AppInstalledEvent)(nil),               // 77: platform.server.v1.AppInstalledEvent
+	(*AppRestoreEvent)(nil),                 // 78: platform.server.v1.AppRestoreEvent
+	(*RegisterPeerRequest)(nil),             // 79: platform.server.v1.RegisterPeerRequest
+	(*RegisterPeerResponse)(nil),            // 80: platform.server.v1.RegisterPeerResponse
+	(*DeregisterPeerRequest)(nil),           // 81: platform.server.v1.DeregisterPeerRequest
+	(*DeregisterPeerResponse)(nil),          // 82: platform.server.v1.DeregisterPeerResponse
+	nil,                                     // 83: platform.server.v1.App.AnnotationsEntry
+	nil,                                     // 84: platform.server.v1.AppStoreEntries.EntriesEntry
+	(*v1.SystemStats)(nil),                  // 85: platform.daemon.v1.SystemStats
+	(*v1.ComponentVersion)(nil),             // 86: platform.daemon.v1.ComponentVersion
+	(*v1.Log)(nil),                          // 87: platform.daemon.v1.Log
 }
 var file_platform_server_v1_web_proto_depIdxs = []int32{
-	14, // 0: platform.server.v1.AppsHealthCheckResponse.checks:type_name -> platform.server.v1.AppHealth
+	15, // 0: platform.server.v1.AppsHealthCheckResponse.checks:type_name -> platform.server.v1.AppHealth
 	0,  // 1: platform.server.v1.AppHealth.status:type_name -> platform.server.v1.AppStatus
-	15, // 2: platform.server.v1.AppHealth.display:type_name -> platform.server.v1.AppDisplay
-	65, // 3: platform.server.v1.GetSystemStatsResponse.stats:type_name -> platform.daemon.v1.SystemStats
-	40, // 4: platform.server.v1.GetAppsInStoreResponse.apps:type_name -> platform.server.v1.App
-	50, // 5: platform.server.v1.GetDeviceSettingsResponse.settings:type_name -> platform.server.v1.DeviceSettings
-	50, // 6: platform.server.v1.SetDeviceSettingsRequest.settings:type_name -> platform.server.v1.DeviceSettings
-	26, // 7: platform.server.v1.GetAppStorageResponse.apps:type_name -> platform.server.v1.AppStorage
-	66, // 8: platform.server.v1.GetComponentVersionsResponse.platform:type_name -> platform.daemon.v1.ComponentVersion
-	66, // 9: platform.server.v1.GetComponentVersionsResponse.system:type_name -> platform.daemon.v1.ComponentVersion
-	67, // 10: platform.server.v1.GetSystemLogsResponse.logs:type_name -> platform.daemon.v1.Log
-	40, // 11: platform.server.v1.Apps.apps:type_name -> platform.server.v1.App
-	41, // 12: platform.server.v1.App.dependencies:type_name -> platform.server.v1.AppDependency
-	63, // 13: platform.server.v1.App.annotations:type_name -> platform.server.v1.App.AnnotationsEntry
-	0,  // 14: platform.server.v1.AppRunningStatus.status:type_name -> platform.server.v1.AppStatus
-	40, // 15: platform.server.v1.Entries.apps:type_name -> platform.server.v1.App
-	45, // 16: platform.server.v1.SystemVersion.istio:type_name -> platform.server.v1.IstioVersion
-	46, // 17: platform.server.v1.SystemVersion.gateway_api:type_name -> platform.server.v1.GatewayAPIVersion
-	47, // 18: platform.server.v1.SystemVersion.server:type_name -> platform.server.v1.ServerVersion
-	48, // 19: platform.server.v1.SystemVersion.daemon:type_name -> platform.server.v1.DaemonVersion
-	64, // 20: platform.server.v1.AppStoreEntries.entries:type_name -> platform.server.v1.AppStoreEntries.EntriesEntry
-	51, // 21: platform.server.v1.DeviceSettings.secure_tunneling_settings:type_name -> platform.server.v1.SecureTunnelingSettings
-	53, // 22: platform.server.v1.DeviceSettings.app_stores:type_name -> platform.server.v1.AppStore
-	52, // 23: platform.server.v1.SecureTunnelingSettings.wireguard_interfaces:type_name -> platform.server.v1.WireguardInterface
-	56, // 24: platform.server.v1.ServerEvent.heartbeat:type_name -> platform.server.v1.HeartbeatEvent
-	57, // 25: platform.server.v1.ServerEvent.error:type_name -> platform.server.v1.ErrorEvent
-	58, // 26: platform.server.v1.ServerEvent.app_installed:type_name -> platform.server.v1.AppInstalledEvent
-	39, // 27: platform.server.v1.AppStoreEntries.EntriesEntry.value:type_name -> platform.server.v1.Apps
-	54, // 28: platform.server.v1.WebService.Subscribe:input_type -> platform.server.v1.SubscribeRequest
-	5,  // 29: platform.server.v1.WebService.InstallApp:input_type -> platform.server.v1.InstallAppRequest
-	7,  // 30: platform.server.v1.WebService.UpdateApp:input_type -> platform.server.v1.UpdateAppRequest
-	9,  // 31: platform.server.v1.WebService.DeleteApp:input_type -> platform.server.v1.DeleteAppRequest
-	12, // 32: platform.server.v1.WebService.AppsHealthCheck:input_type -> platform.server.v1.AppsHealthCheckRequest
-	18, // 33: platform.server.v1.WebService.GetAppsInStore:input_type -> platform.server.v1.GetAppsInStoreRequest
-	24, // 34: platform.server.v1.WebService.GetAppStorage:input_type -> platform.server.v1.GetAppStorageRequest
-	1,  // 35: platform.server.v1.WebService.ShutdownHost:input_type -> platform.server.v1.ShutdownHostRequest
-	3,  // 36: platform.server.v1.WebService.RestartHost:input_type -> platform.server.v1.RestartHostRequest
-	16, // 37: platform.server.v1.WebService.GetSystemStats:input_type -> platform.server.v1.GetSystemStatsRequest
-	35, // 38: platform.server.v1.WebService.GetComponentVersions:input_type -> platform.server.v1.GetComponentVersionsRequest
-	37, // 39: platform.server.v1.WebService.GetSystemLogs:input_type -> platform.server.v1.GetSystemLogsRequest
-	20, // 40: platform.server.v1.WebService.GetDeviceSettings:input_type -> platform.server.v1.GetDeviceSettingsRequest
-	22, // 41: platform.server.v1.WebService.SetDeviceSettings:input_type -> platform.server.v1.SetDeviceSettingsRequest
-	27, // 42: platform.server.v1.WebService.EnableSecureTunnelling:input_type -> platform.server.v1.EnableSecureTunnellingRequest
-	29, // 43: platform.server.v1.WebService.DisableSecureTunnelling:input_type -> platform.server.v1.DisableSecureTunnellingRequest
-	31, // 44: platform.server.v1.WebService.RegisterToLocator:input_type -> platform.server.v1.RegisterToLocatorRequest
-	33, // 45: platform.server.v1.WebService.DeregisterFromLocator:input_type -> platform.server.v1.DeregisterFromLocatorRequest
-	59, // 46: platform.server.v1.WebService.RegisterPeer:input_type -> platform.server.v1.RegisterPeerRequest
-	61, // 47: platform.server.v1.WebService.DeregisterPeer:input_type -> platform.server.v1.DeregisterPeerRequest
-	55, // 48: platform.server.v1.WebService.Subscribe:output_type -> platform.server.v1.ServerEvent
-	6,  // 49: platform.server.v1.WebService.InstallApp:output_type -> platform.server.v1.InstallAppResponse
-	8,  // 50: platform.server.v1.WebService.UpdateApp:output_type -> platform.server.v1.UpdateAppResponse
-	10, // 51: platform.server.v1.WebService.DeleteApp:output_type -> platform.server.v1.DeleteAppResponse
-	13, // 52: platform.server.v1.WebService.AppsHealthCheck:output_type -> platform.server.v1.AppsHealthCheckResponse
-	19, // 53: platform.server.v1.WebService.GetAppsInStore:output_type -> platform.server.v1.GetAppsInStoreResponse
-	25, // 54: platform.server.v1.WebService.GetAppStorage:output_type -> platform.server.v1.GetAppStorageResponse
-	2,  // 55: platform.server.v1.WebService.ShutdownHost:output_type -> platform.server.v1.ShutdownHostResponse
-	4,  // 56: platform.server.v1.WebService.RestartHost:output_type -> platform.server.v1.RestartHostResponse
-	17, // 57: platform.server.v1.WebService.GetSystemStats:output_type -> platform.server.v1.GetSystemStatsResponse
-	36, // 58: platform.server.v1.WebService.GetComponentVersions:output_type -> platform.server.v1.GetComponentVersionsResponse
-	38, // 59: platform.server.v1.WebService.GetSystemLogs:output_type -> platform.server.v1.GetSystemLogsResponse
-	21, // 60: platform.server.v1.WebService.GetDeviceSettings:output_type -> platform.server.v1.GetDeviceSettingsResponse
-	23, // 61: platform.server.v1.WebService.SetDeviceSettings:output_type -> platform.server.v1.SetDeviceSettingsResponse
-	28, // 62: platform.server.v1.WebService.EnableSecureTunnelling:output_type -> platform.server.v1.EnableSecureTunnellingResponse
-	30, // 63: platform.server.v1.WebService.DisableSecureTunnelling:output_type -> platform.server.v1.DisableSecureTunnellingResponse
-	32, // 64: platform.server.v1.WebService.RegisterToLocator:output_type -> platform.server.v1.RegisterToLocatorResponse
-	34, // 65: platform.server.v1.WebService.DeregisterFromLocator:output_type -> platform.server.v1.DeregisterFromLocatorResponse
-	60, // 66: platform.server.v1.WebService.RegisterPeer:output_type -> platform.server.v1.RegisterPeerResponse
-	62, // 67: platform.server.v1.WebService.DeregisterPeer:output_type -> platform.server.v1.DeregisterPeerResponse
-	48, // [48:68] is the sub-list for method output_type
-	28, // [28:48] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	16, // 2: platform.server.v1.AppHealth.display:type_name -> platform.server.v1.AppDisplay
+	85, // 3: platform.server.v1.GetSystemStatsResponse.stats:type_name -> platform.daemon.v1.SystemStats
+	58, // 4: platform.server.v1.GetAppsInStoreResponse.apps:type_name -> platform.server.v1.App
+	68, // 5: platform.server.v1.GetDeviceSettingsResponse.settings:type_name -> platform.server.v1.DeviceSettings
+	68, // 6: platform.server.v1.SetDeviceSettingsRequest.settings:type_name -> platform.server.v1.DeviceSettings
+	69, // 7: platform.server.v1.ListAccountsResponse.accounts:type_name -> platform.server.v1.Account
+	35, // 8: platform.server.v1.GetAppStorageResponse.apps:type_name -> platform.server.v1.AppStorage
+	38, // 9: platform.server.v1.ListAppBackupsResponse.backups:type_name -> platform.server.v1.AppBackup
+	43, // 10: platform.server.v1.PreviewAppChangeResponse.manifest_changes:type_name -> platform.server.v1.ManifestChange
+	44, // 11: platform.server.v1.PreviewAppChangeResponse.dependency_changes:type_name -> platform.server.v1.DependencyChange
+	1,  // 12: platform.server.v1.ManifestChange.type:type_name -> platform.server.v1.ChangeType
+	1,  // 13: platform.server.v1.DependencyChange.type:type_name -> platform.server.v1.ChangeType
+	86, // 14: platform.server.v1.GetComponentVersionsResponse.platform:type_name -> platform.daemon.v1.ComponentVersion
+	86, // 15: platform.server.v1.GetComponentVersionsResponse.system:type_name -> platform.daemon.v1.ComponentVersion
+	87, // 16: platform.server.v1.GetSystemLogsResponse.logs:type_name -> platform.daemon.v1.Log
+	58, // 17: platform.server.v1.Apps.apps:type_name -> platform.server.v1.App
+	59, // 18: platform.server.v1.App.dependencies:type_name -> platform.server.v1.AppDependency
+	83, // 19: platform.server.v1.App.annotations:type_name -> platform.server.v1.App.AnnotationsEntry
+	0,  // 20: platform.server.v1.AppRunningStatus.status:type_name -> platform.server.v1.AppStatus
+	58, // 21: platform.server.v1.Entries.apps:type_name -> platform.server.v1.App
+	63, // 22: platform.server.v1.SystemVersion.istio:type_name -> platform.server.v1.IstioVersion
+	64, // 23: platform.server.v1.SystemVersion.gateway_api:type_name -> platform.server.v1.GatewayAPIVersion
+	65, // 24: platform.server.v1.SystemVersion.server:type_name -> platform.server.v1.ServerVersion
+	66, // 25: platform.server.v1.SystemVersion.daemon:type_name -> platform.server.v1.DaemonVersion
+	84, // 26: platform.server.v1.AppStoreEntries.entries:type_name -> platform.server.v1.AppStoreEntries.EntriesEntry
+	70, // 27: platform.server.v1.DeviceSettings.secure_tunneling_settings:type_name -> platform.server.v1.SecureTunnelingSettings
+	72, // 28: platform.server.v1.DeviceSettings.app_stores:type_name -> platform.server.v1.AppStore
+	71, // 29: platform.server.v1.SecureTunnelingSettings.wireguard_interfaces:type_name -> platform.server.v1.WireguardInterface
+	75, // 30: platform.server.v1.ServerEvent.heartbeat:type_name -> platform.server.v1.HeartbeatEvent
+	76, // 31: platform.server.v1.ServerEvent.error:type_name -> platform.server.v1.ErrorEvent
+	77, // 32: platform.server.v1.ServerEvent.app_installed:type_name -> platform.server.v1.AppInstalledEvent
+	78, // 33: platform.server.v1.ServerEvent.app_restore:type_name -> platform.server.v1.AppRestoreEvent
+	57, // 34: platform.server.v1.AppStoreEntries.EntriesEntry.value:type_name -> platform.server.v1.Apps
+	73, // 35: platform.server.v1.WebService.Subscribe:input_type -> platform.server.v1.SubscribeRequest
+	6,  // 36: platform.server.v1.WebService.InstallApp:input_type -> platform.server.v1.InstallAppRequest
+	8,  // 37: platform.server.v1.WebService.UpdateApp:input_type -> platform.server.v1.UpdateAppRequest
+	10, // 38: platform.server.v1.WebService.DeleteApp:input_type -> platform.server.v1.DeleteAppRequest
+	13, // 39: platform.server.v1.WebService.AppsHealthCheck:input_type -> platform.server.v1.AppsHealthCheckRequest
+	19, // 40: platform.server.v1.WebService.GetAppsInStore:input_type -> platform.server.v1.GetAppsInStoreRequest
+	33, // 41: platform.server.v1.WebService.GetAppStorage:input_type -> platform.server.v1.GetAppStorageRequest
+	36, // 42: platform.server.v1.WebService.ListAppBackups:input_type -> platform.server.v1.ListAppBackupsRequest
+	39, // 43: platform.server.v1.WebService.RestoreApp:input_type -> platform.server.v1.RestoreAppRequest
+	41, // 44: platform.server.v1.WebService.PreviewAppChange:input_type -> platform.server.v1.PreviewAppChangeRequest
+	2,  // 45: platform.server.v1.WebService.ShutdownHost:input_type -> platform.server.v1.ShutdownHostRequest
+	4,  // 46: platform.server.v1.WebService.RestartHost:input_type -> platform.server.v1.RestartHostRequest
+	17, // 47: platform.server.v1.WebService.GetSystemStats:input_type -> platform.server.v1.GetSystemStatsRequest
+	53, // 48: platform.server.v1.WebService.GetComponentVersions:input_type -> platform.server.v1.GetComponentVersionsRequest
+	55, // 49: platform.server.v1.WebService.GetSystemLogs:input_type -> platform.server.v1.GetSystemLogsRequest
+	21, // 50: platform.server.v1.WebService.GetDeviceSettings:input_type -> platform.server.v1.GetDeviceSettingsRequest
+	23, // 51: platform.server.v1.WebService.SetDeviceSettings:input_type -> platform.server.v1.SetDeviceSettingsRequest
+	25, // 52: platform.server.v1.WebService.GetCACertificate:input_type -> platform.server.v1.GetCACertificateRequest
+	27, // 53: platform.server.v1.WebService.ListAccounts:input_type -> platform.server.v1.ListAccountsRequest
+	29, // 54: platform.server.v1.WebService.SaveAccount:input_type -> platform.server.v1.SaveAccountRequest
+	31, // 55: platform.server.v1.WebService.DeleteAccount:input_type -> platform.server.v1.DeleteAccountRequest
+	45, // 56: platform.server.v1.WebService.EnableSecureTunnelling:input_type -> platform.server.v1.EnableSecureTunnellingRequest
+	47, // 57: platform.server.v1.WebService.DisableSecureTunnelling:input_type -> platform.server.v1.DisableSecureTunnellingRequest
+	49, // 58: platform.server.v1.WebService.RegisterToLocator:input_type -> platform.server.v1.RegisterToLocatorRequest
+	51, // 59: platform.server.v1.WebService.DeregisterFromLocator:input_type -> platform.server.v1.DeregisterFromLocatorRequest
+	79, // 60: platform.server.v1.WebService.RegisterPeer:input_type -> platform.server.v1.RegisterPeerRequest
+	81, // 61: platform.server.v1.WebService.DeregisterPeer:input_type -> platform.server.v1.DeregisterPeerRequest
+	74, // 62: platform.server.v1.WebService.Subscribe:output_type -> platform.server.v1.ServerEvent
+	7,  // 63: platform.server.v1.WebService.InstallApp:output_type -> platform.server.v1.InstallAppResponse
+	9,  // 64: platform.server.v1.WebService.UpdateApp:output_type -> platform.server.v1.UpdateAppResponse
+	11, // 65: platform.server.v1.WebService.DeleteApp:output_type -> platform.server.v1.DeleteAppResponse
+	14, // 66: platform.server.v1.WebService.AppsHealthCheck:output_type -> platform.server.v1.AppsHealthCheckResponse
+	20, // 67: platform.server.v1.WebService.GetAppsInStore:output_type -> platform.server.v1.GetAppsInStoreResponse
+	34, // 68: platform.server.v1.WebService.GetAppStorage:output_type -> platform.server.v1.GetAppStorageResponse
+	37, // 69: platform.server.v1.WebService.ListAppBackups:output_type -> platform.server.v1.ListAppBackupsResponse
+	40, // 70: platform.server.v1.WebService.RestoreApp:output_type -> platform.server.v1.RestoreAppResponse
+	42, // 71: platform.server.v1.WebService.PreviewAppChange:output_type -> platform.server.v1.PreviewAppChangeResponse
+	3,  // 72: platform.server.v1.WebService.ShutdownHost:output_type -> platform.server.v1.ShutdownHostResponse
+	5,  // 73: platform.server.v1.WebService.RestartHost:output_type -> platform.server.v1.RestartHostResponse
+	18, // 74: platform.server.v1.WebService.GetSystemStats:output_type -> platform.server.v1.GetSystemStatsResponse
+	54, // 75: platform.server.v1.WebService.GetComponentVersions:output_type -> platform.server.v1.GetComponentVersionsResponse
+	56, // 76: platform.server.v1.WebService.GetSystemLogs:output_type -> platform.server.v1.GetSystemLogsResponse
+	22, // 77: platform.server.v1.WebService.GetDeviceSettings:output_type -> platform.server.v1.GetDeviceSettingsResponse
+	24, // 78: platform.server.v1.WebService.SetDeviceSettings:output_type -> platform.server.v1.SetDeviceSettingsResponse
+	26, // 79: platform.server.v1.WebService.GetCACertificate:output_type -> platform.server.v1.GetCACertificateResponse
+	28, // 80: platform.server.v1.WebService.ListAccounts:output_type -> platform.server.v1.ListAccountsResponse
+	30, // 81: platform.server.v1.WebService.SaveAccount:output_type -> platform.server.v1.SaveAccountResponse
+	32, // 82: platform.server.v1.WebService.DeleteAccount:output_type -> platform.server.v1.DeleteAccountResponse
+	46, // 83: platform.server.v1.WebService.EnableSecureTunnelling:output_type -> platform.server.v1.EnableSecureTunnellingResponse
+	48, // 84: platform.server.v1.WebService.DisableSecureTunnelling:output_type -> platform.server.v1.DisableSecureTunnellingResponse
+	50, // 85: platform.server.v1.WebService.RegisterToLocator:output_type -> platform.server.v1.RegisterToLocatorResponse
+	52, // 86: platform.server.v1.WebService.DeregisterFromLocator:output_type -> platform.server.v1.DeregisterFromLocatorResponse
+	80, // 87: platform.server.v1.WebService.RegisterPeer:output_type -> platform.server.v1.RegisterPeerResponse
+	82, // 88: platform.server.v1.WebService.DeregisterPeer:output_type -> platform.server.v1.DeregisterPeerResponse
+	62, // [62:89] is the sub-list for method output_type
+	35, // [35:62] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_platform_server_v1_web_proto_init() }
@@ -4081,8 +5487,116 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*ShutdownHostResponse); i {
+		file_platform_server_v1_web_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*ShutdownHostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_platform_server_v1_web_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*RestartHostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_platform_server_v1_web_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*RestartHostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_platform_server_v1_web_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*InstallAppRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_platform_server_v1_web_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*InstallAppResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_platform_server_v1_web_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateAppRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_platform_server_v1_web_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateAppResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_platform_server_v1_web_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteAppRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_platform_server_v1_web_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteAppResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_platform_server_v1_web_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ImageVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4093,8 +5607,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*RestartHostRequest); i {
+		file_platform_server_v1_web_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*AppsHealthCheckRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4105,8 +5619,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*RestartHostResponse); i {
+		file_platform_server_v1_web_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*AppsHealthCheckResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4117,8 +5631,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*InstallAppRequest); i {
+		file_platform_server_v1_web_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*AppHealth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4129,8 +5643,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*InstallAppResponse); i {
+		file_platform_server_v1_web_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*AppDisplay); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4141,8 +5655,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateAppRequest); i {
+		file_platform_server_v1_web_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*GetSystemStatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4153,8 +5667,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateAppResponse); i {
+		file_platform_server_v1_web_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*GetSystemStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4165,8 +5679,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteAppRequest); i {
+		file_platform_server_v1_web_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAppsInStoreRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4177,8 +5691,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteAppResponse); i {
+		file_platform_server_v1_web_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAppsInStoreResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4189,8 +5703,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*ImageVersion); i {
+		file_platform_server_v1_web_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDeviceSettingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4201,8 +5715,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*AppsHealthCheckRequest); i {
+		file_platform_server_v1_web_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDeviceSettingsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4213,8 +5727,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*AppsHealthCheckResponse); i {
+		file_platform_server_v1_web_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*SetDeviceSettingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4225,8 +5739,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*AppHealth); i {
+		file_platform_server_v1_web_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*SetDeviceSettingsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4237,8 +5751,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*AppDisplay); i {
+		file_platform_server_v1_web_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCACertificateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4249,8 +5763,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*GetSystemStatsRequest); i {
+		file_platform_server_v1_web_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCACertificateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4261,8 +5775,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*GetSystemStatsResponse); i {
+		file_platform_server_v1_web_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAccountsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4273,8 +5787,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAppsInStoreRequest); i {
+		file_platform_server_v1_web_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAccountsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4285,8 +5799,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAppsInStoreResponse); i {
+		file_platform_server_v1_web_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*SaveAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4297,8 +5811,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*GetDeviceSettingsRequest); i {
+		file_platform_server_v1_web_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*SaveAccountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4309,8 +5823,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*GetDeviceSettingsResponse); i {
+		file_platform_server_v1_web_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4321,8 +5835,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*SetDeviceSettingsRequest); i {
+		file_platform_server_v1_web_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteAccountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4333,8 +5847,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*SetDeviceSettingsResponse); i {
+		file_platform_server_v1_web_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAppStorageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4345,8 +5859,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAppStorageRequest); i {
+		file_platform_server_v1_web_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAppStorageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4357,8 +5871,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAppStorageResponse); i {
+		file_platform_server_v1_web_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*AppStorage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4369,8 +5883,8 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*AppStorage); i {
+		file_platform_server_v1_web_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAppBackupsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4381,7 +5895,103 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[26].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAppBackupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_platform_server_v1_web_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*AppBackup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_platform_server_v1_web_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreAppRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_platform_server_v1_web_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreAppResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_platform_server_v1_web_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*PreviewAppChangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_platform_server_v1_web_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*PreviewAppChangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_platform_server_v1_web_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*ManifestChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_platform_server_v1_web_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*DependencyChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_platform_server_v1_web_proto_msgTypes[43].Exporter = func(v any, i int) any {
 			switch v := v.(*EnableSecureTunnellingRequest); i {
 			case 0:
 				return &v.state
@@ -4393,7 +6003,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[27].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[44].Exporter = func(v any, i int) any {
 			switch v := v.(*EnableSecureTunnellingResponse); i {
 			case 0:
 				return &v.state
@@ -4405,7 +6015,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[28].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[45].Exporter = func(v any, i int) any {
 			switch v := v.(*DisableSecureTunnellingRequest); i {
 			case 0:
 				return &v.state
@@ -4417,7 +6027,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[29].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[46].Exporter = func(v any, i int) any {
 			switch v := v.(*DisableSecureTunnellingResponse); i {
 			case 0:
 				return &v.state
@@ -4429,7 +6039,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[30].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[47].Exporter = func(v any, i int) any {
 			switch v := v.(*RegisterToLocatorRequest); i {
 			case 0:
 				return &v.state
@@ -4441,7 +6051,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[31].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[48].Exporter = func(v any, i int) any {
 			switch v := v.(*RegisterToLocatorResponse); i {
 			case 0:
 				return &v.state
@@ -4453,7 +6063,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[32].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[49].Exporter = func(v any, i int) any {
 			switch v := v.(*DeregisterFromLocatorRequest); i {
 			case 0:
 				return &v.state
@@ -4465,7 +6075,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[33].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[50].Exporter = func(v any, i int) any {
 			switch v := v.(*DeregisterFromLocatorResponse); i {
 			case 0:
 				return &v.state
@@ -4477,7 +6087,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[34].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[51].Exporter = func(v any, i int) any {
 			switch v := v.(*GetComponentVersionsRequest); i {
 			case 0:
 				return &v.state
@@ -4489,7 +6099,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[35].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[52].Exporter = func(v any, i int) any {
 			switch v := v.(*GetComponentVersionsResponse); i {
 			case 0:
 				return &v.state
@@ -4501,7 +6111,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[36].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[53].Exporter = func(v any, i int) any {
 			switch v := v.(*GetSystemLogsRequest); i {
 			case 0:
 				return &v.state
@@ -4513,7 +6123,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[37].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[54].Exporter = func(v any, i int) any {
 			switch v := v.(*GetSystemLogsResponse); i {
 			case 0:
 				return &v.state
@@ -4525,7 +6135,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[38].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[55].Exporter = func(v any, i int) any {
 			switch v := v.(*Apps); i {
 			case 0:
 				return &v.state
@@ -4537,7 +6147,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[39].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[56].Exporter = func(v any, i int) any {
 			switch v := v.(*App); i {
 			case 0:
 				return &v.state
@@ -4549,7 +6159,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[40].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[57].Exporter = func(v any, i int) any {
 			switch v := v.(*AppDependency); i {
 			case 0:
 				return &v.state
@@ -4561,7 +6171,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[41].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[58].Exporter = func(v any, i int) any {
 			switch v := v.(*AppRunningStatus); i {
 			case 0:
 				return &v.state
@@ -4573,7 +6183,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[42].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[59].Exporter = func(v any, i int) any {
 			switch v := v.(*Entries); i {
 			case 0:
 				return &v.state
@@ -4585,7 +6195,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[43].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[60].Exporter = func(v any, i int) any {
 			switch v := v.(*SystemVersion); i {
 			case 0:
 				return &v.state
@@ -4597,7 +6207,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[44].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[61].Exporter = func(v any, i int) any {
 			switch v := v.(*IstioVersion); i {
 			case 0:
 				return &v.state
@@ -4609,7 +6219,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[45].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[62].Exporter = func(v any, i int) any {
 			switch v := v.(*GatewayAPIVersion); i {
 			case 0:
 				return &v.state
@@ -4621,7 +6231,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[46].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[63].Exporter = func(v any, i int) any {
 			switch v := v.(*ServerVersion); i {
 			case 0:
 				return &v.state
@@ -4633,7 +6243,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[47].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[64].Exporter = func(v any, i int) any {
 			switch v := v.(*DaemonVersion); i {
 			case 0:
 				return &v.state
@@ -4645,7 +6255,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[48].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[65].Exporter = func(v any, i int) any {
 			switch v := v.(*AppStoreEntries); i {
 			case 0:
 				return &v.state
@@ -4657,7 +6267,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[49].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[66].Exporter = func(v any, i int) any {
 			switch v := v.(*DeviceSettings); i {
 			case 0:
 				return &v.state
@@ -4669,7 +6279,19 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[50].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*Account); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_platform_server_v1_web_proto_msgTypes[68].Exporter = func(v any, i int) any {
 			switch v := v.(*SecureTunnelingSettings); i {
 			case 0:
 				return &v.state
@@ -4681,7 +6303,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[51].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[69].Exporter = func(v any, i int) any {
 			switch v := v.(*WireguardInterface); i {
 			case 0:
 				return &v.state
@@ -4693,7 +6315,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[52].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[70].Exporter = func(v any, i int) any {
 			switch v := v.(*AppStore); i {
 			case 0:
 				return &v.state
@@ -4705,7 +6327,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[53].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[71].Exporter = func(v any, i int) any {
 			switch v := v.(*SubscribeRequest); i {
 			case 0:
 				return &v.state
@@ -4717,7 +6339,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[54].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[72].Exporter = func(v any, i int) any {
 			switch v := v.(*ServerEvent); i {
 			case 0:
 				return &v.state
@@ -4729,7 +6351,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[55].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[73].Exporter = func(v any, i int) any {
 			switch v := v.(*HeartbeatEvent); i {
 			case 0:
 				return &v.state
@@ -4741,7 +6363,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[56].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[74].Exporter = func(v any, i int) any {
 			switch v := v.(*ErrorEvent); i {
 			case 0:
 				return &v.state
@@ -4753,7 +6375,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[57].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[75].Exporter = func(v any, i int) any {
 			switch v := v.(*AppInstalledEvent); i {
 			case 0:
 				return &v.state
@@ -4765,7 +6387,19 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[58].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*AppRestoreEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_platform_server_v1_web_proto_msgTypes[77].Exporter = func(v any, i int) any {
 			switch v := v.(*RegisterPeerRequest); i {
 			case 0:
 				return &v.state
@@ -4777,7 +6411,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[59].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[78].Exporter = func(v any, i int) any {
 			switch v := v.(*RegisterPeerResponse); i {
 			case 0:
 				return &v.state
@@ -4789,7 +6423,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[60].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[79].Exporter = func(v any, i int) any {
 			switch v := v.(*DeregisterPeerRequest); i {
 			case 0:
 				return &v.state
@@ -4801,7 +6435,7 @@ func file_platform_server_v1_web_proto_init() {
 				return nil
 			}
 		}
-		file_platform_server_v1_web_proto_msgTypes[61].Exporter = func(v any, i int) any {
+		file_platform_server_v1_web_proto_msgTypes[80].Exporter = func(v any, i int) any {
 			switch v := v.(*DeregisterPeerResponse); i {
 			case 0:
 				return &v.state
@@ -4814,18 +6448,19 @@ func file_platform_server_v1_web_proto_init() {
 			}
 		}
 	}
-	file_platform_server_v1_web_proto_msgTypes[54].OneofWrappers = []any{
+	file_platform_server_v1_web_proto_msgTypes[72].OneofWrappers = []any{
 		(*ServerEvent_Heartbeat)(nil),
 		(*ServerEvent_Error)(nil),
 		(*ServerEvent_AppInstalled)(nil),
+		(*ServerEvent_AppRestore)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_platform_server_v1_web_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   64,
+			NumEnums:      2,
+			NumMessages:   83,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
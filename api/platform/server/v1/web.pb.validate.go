@@ -1473,6 +1473,10 @@ func (m *AppHealth) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Phase
+
+	// no validation rules for Error
+
 	if len(errors) > 0 {
 		return AppHealthMultiError(errors)
 	}
@@ -2592,42 +2596,146 @@ var _ interface {
 	ErrorName() string
 } = SetDeviceSettingsResponseValidationError{}
 
-// Validate checks the field values on GetAppStorageRequest with the rules
+// Validate checks the field values on GetCACertificateRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAppStorageRequest) Validate() error {
+func (m *GetCACertificateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAppStorageRequest with the rules
+// ValidateAll checks the field values on GetCACertificateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCACertificateRequestMultiError, or nil if none found.
+func (m *GetCACertificateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCACertificateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetCACertificateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCACertificateRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCACertificateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCACertificateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCACertificateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCACertificateRequestMultiError) AllErrors() []error { return m }
+
+// GetCACertificateRequestValidationError is the validation error returned by
+// GetCACertificateRequest.Validate if the designated constraints aren't met.
+type GetCACertificateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCACertificateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCACertificateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCACertificateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCACertificateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCACertificateRequestValidationError) ErrorName() string {
+	return "GetCACertificateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCACertificateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCACertificateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCACertificateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCACertificateRequestValidationError{}
+
+// Validate checks the field values on GetCACertificateResponse with the rules
 // defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCACertificateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCACertificateResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAppStorageRequestMultiError, or nil if none found.
-func (m *GetAppStorageRequest) ValidateAll() error {
+// GetCACertificateResponseMultiError, or nil if none found.
+func (m *GetCACertificateResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAppStorageRequest) validate(all bool) error {
+func (m *GetCACertificateResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Certificate
+
 	if len(errors) > 0 {
-		return GetAppStorageRequestMultiError(errors)
+		return GetCACertificateResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAppStorageRequestMultiError is an error wrapping multiple validation
-// errors returned by GetAppStorageRequest.ValidateAll() if the designated
+// GetCACertificateResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCACertificateResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetAppStorageRequestMultiError []error
+type GetCACertificateResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAppStorageRequestMultiError) Error() string {
+func (m GetCACertificateResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2636,11 +2744,11 @@ func (m GetAppStorageRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAppStorageRequestMultiError) AllErrors() []error { return m }
+func (m GetCACertificateResponseMultiError) AllErrors() []error { return m }
 
-// GetAppStorageRequestValidationError is the validation error returned by
-// GetAppStorageRequest.Validate if the designated constraints aren't met.
-type GetAppStorageRequestValidationError struct {
+// GetCACertificateResponseValidationError is the validation error returned by
+// GetCACertificateResponse.Validate if the designated constraints aren't met.
+type GetCACertificateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2648,24 +2756,24 @@ type GetAppStorageRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAppStorageRequestValidationError) Field() string { return e.field }
+func (e GetCACertificateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAppStorageRequestValidationError) Reason() string { return e.reason }
+func (e GetCACertificateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAppStorageRequestValidationError) Cause() error { return e.cause }
+func (e GetCACertificateResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAppStorageRequestValidationError) Key() bool { return e.key }
+func (e GetCACertificateResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAppStorageRequestValidationError) ErrorName() string {
-	return "GetAppStorageRequestValidationError"
+func (e GetCACertificateResponseValidationError) ErrorName() string {
+	return "GetCACertificateResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAppStorageRequestValidationError) Error() string {
+func (e GetCACertificateResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2677,14 +2785,14 @@ func (e GetAppStorageRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAppStorageRequest.%s: %s%s",
+		"invalid %sGetCACertificateResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAppStorageRequestValidationError{}
+var _ error = GetCACertificateResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2692,47 +2800,149 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAppStorageRequestValidationError{}
+} = GetCACertificateResponseValidationError{}
 
-// Validate checks the field values on GetAppStorageResponse with the rules
+// Validate checks the field values on ListAccountsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAppStorageResponse) Validate() error {
+func (m *ListAccountsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAppStorageResponse with the rules
+// ValidateAll checks the field values on ListAccountsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAppStorageResponseMultiError, or nil if none found.
-func (m *GetAppStorageResponse) ValidateAll() error {
+// ListAccountsRequestMultiError, or nil if none found.
+func (m *ListAccountsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAppStorageResponse) validate(all bool) error {
+func (m *ListAccountsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetApps() {
+	if len(errors) > 0 {
+		return ListAccountsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAccountsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAccountsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAccountsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAccountsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAccountsRequestMultiError) AllErrors() []error { return m }
+
+// ListAccountsRequestValidationError is the validation error returned by
+// ListAccountsRequest.Validate if the designated constraints aren't met.
+type ListAccountsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAccountsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAccountsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAccountsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAccountsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAccountsRequestValidationError) ErrorName() string {
+	return "ListAccountsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAccountsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAccountsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAccountsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAccountsRequestValidationError{}
+
+// Validate checks the field values on ListAccountsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAccountsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAccountsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAccountsResponseMultiError, or nil if none found.
+func (m *ListAccountsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAccountsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAccounts() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAppStorageResponseValidationError{
-						field:  fmt.Sprintf("Apps[%v]", idx),
+					errors = append(errors, ListAccountsResponseValidationError{
+						field:  fmt.Sprintf("Accounts[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAppStorageResponseValidationError{
-						field:  fmt.Sprintf("Apps[%v]", idx),
+					errors = append(errors, ListAccountsResponseValidationError{
+						field:  fmt.Sprintf("Accounts[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -2740,8 +2950,8 @@ func (m *GetAppStorageResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetAppStorageResponseValidationError{
-					field:  fmt.Sprintf("Apps[%v]", idx),
+				return ListAccountsResponseValidationError{
+					field:  fmt.Sprintf("Accounts[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2751,19 +2961,1737 @@ func (m *GetAppStorageResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetAppStorageResponseMultiError(errors)
+		return ListAccountsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAccountsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAccountsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAccountsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAccountsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAccountsResponseMultiError) AllErrors() []error { return m }
+
+// ListAccountsResponseValidationError is the validation error returned by
+// ListAccountsResponse.Validate if the designated constraints aren't met.
+type ListAccountsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAccountsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAccountsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAccountsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAccountsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAccountsResponseValidationError) ErrorName() string {
+	return "ListAccountsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAccountsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAccountsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAccountsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAccountsResponseValidationError{}
+
+// Validate checks the field values on SaveAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SaveAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SaveAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SaveAccountRequestMultiError, or nil if none found.
+func (m *SaveAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SaveAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Username
+
+	// no validation rules for Email
+
+	// no validation rules for Password
+
+	// no validation rules for CurrentPassword
+
+	// no validation rules for Admin
+
+	if len(errors) > 0 {
+		return SaveAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SaveAccountRequestMultiError is an error wrapping multiple validation errors
+// returned by SaveAccountRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SaveAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SaveAccountRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SaveAccountRequestMultiError) AllErrors() []error { return m }
+
+// SaveAccountRequestValidationError is the validation error returned by
+// SaveAccountRequest.Validate if the designated constraints aren't met.
+type SaveAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SaveAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SaveAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SaveAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SaveAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SaveAccountRequestValidationError) ErrorName() string {
+	return "SaveAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SaveAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSaveAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SaveAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SaveAccountRequestValidationError{}
+
+// Validate checks the field values on SaveAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SaveAccountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SaveAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SaveAccountResponseMultiError, or nil if none found.
+func (m *SaveAccountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SaveAccountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SaveAccountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SaveAccountResponseMultiError is an error wrapping multiple validation
+// errors returned by SaveAccountResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SaveAccountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SaveAccountResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SaveAccountResponseMultiError) AllErrors() []error { return m }
+
+// SaveAccountResponseValidationError is the validation error returned by
+// SaveAccountResponse.Validate if the designated constraints aren't met.
+type SaveAccountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SaveAccountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SaveAccountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SaveAccountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SaveAccountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SaveAccountResponseValidationError) ErrorName() string {
+	return "SaveAccountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SaveAccountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSaveAccountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SaveAccountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SaveAccountResponseValidationError{}
+
+// Validate checks the field values on DeleteAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAccountRequestMultiError, or nil if none found.
+func (m *DeleteAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Username
+
+	// no validation rules for CurrentPassword
+
+	if len(errors) > 0 {
+		return DeleteAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteAccountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAccountRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAccountRequestMultiError) AllErrors() []error { return m }
+
+// DeleteAccountRequestValidationError is the validation error returned by
+// DeleteAccountRequest.Validate if the designated constraints aren't met.
+type DeleteAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAccountRequestValidationError) ErrorName() string {
+	return "DeleteAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAccountRequestValidationError{}
+
+// Validate checks the field values on DeleteAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAccountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAccountResponseMultiError, or nil if none found.
+func (m *DeleteAccountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAccountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteAccountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAccountResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteAccountResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAccountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAccountResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAccountResponseMultiError) AllErrors() []error { return m }
+
+// DeleteAccountResponseValidationError is the validation error returned by
+// DeleteAccountResponse.Validate if the designated constraints aren't met.
+type DeleteAccountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAccountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAccountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAccountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAccountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAccountResponseValidationError) ErrorName() string {
+	return "DeleteAccountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAccountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAccountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAccountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAccountResponseValidationError{}
+
+// Validate checks the field values on GetAppStorageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAppStorageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAppStorageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAppStorageRequestMultiError, or nil if none found.
+func (m *GetAppStorageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAppStorageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetAppStorageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAppStorageRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAppStorageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAppStorageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAppStorageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAppStorageRequestMultiError) AllErrors() []error { return m }
+
+// GetAppStorageRequestValidationError is the validation error returned by
+// GetAppStorageRequest.Validate if the designated constraints aren't met.
+type GetAppStorageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAppStorageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAppStorageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAppStorageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAppStorageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAppStorageRequestValidationError) ErrorName() string {
+	return "GetAppStorageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAppStorageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAppStorageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAppStorageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAppStorageRequestValidationError{}
+
+// Validate checks the field values on GetAppStorageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAppStorageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAppStorageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAppStorageResponseMultiError, or nil if none found.
+func (m *GetAppStorageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAppStorageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetApps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAppStorageResponseValidationError{
+						field:  fmt.Sprintf("Apps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAppStorageResponseValidationError{
+						field:  fmt.Sprintf("Apps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAppStorageResponseValidationError{
+					field:  fmt.Sprintf("Apps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetAppStorageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAppStorageResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAppStorageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAppStorageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAppStorageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAppStorageResponseMultiError) AllErrors() []error { return m }
+
+// GetAppStorageResponseValidationError is the validation error returned by
+// GetAppStorageResponse.Validate if the designated constraints aren't met.
+type GetAppStorageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAppStorageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAppStorageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAppStorageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAppStorageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAppStorageResponseValidationError) ErrorName() string {
+	return "GetAppStorageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAppStorageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAppStorageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAppStorageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAppStorageResponseValidationError{}
+
+// Validate checks the field values on AppStorage with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AppStorage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AppStorage with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AppStorageMultiError, or
+// nil if none found.
+func (m *AppStorage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AppStorage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppName
+
+	if len(errors) > 0 {
+		return AppStorageMultiError(errors)
+	}
+
+	return nil
+}
+
+// AppStorageMultiError is an error wrapping multiple validation errors
+// returned by AppStorage.ValidateAll() if the designated constraints aren't met.
+type AppStorageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppStorageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppStorageMultiError) AllErrors() []error { return m }
+
+// AppStorageValidationError is the validation error returned by
+// AppStorage.Validate if the designated constraints aren't met.
+type AppStorageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppStorageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppStorageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppStorageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppStorageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppStorageValidationError) ErrorName() string { return "AppStorageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AppStorageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppStorage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppStorageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppStorageValidationError{}
+
+// Validate checks the field values on ListAppBackupsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAppBackupsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAppBackupsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAppBackupsRequestMultiError, or nil if none found.
+func (m *ListAppBackupsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAppBackupsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppName
+
+	if len(errors) > 0 {
+		return ListAppBackupsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAppBackupsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAppBackupsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAppBackupsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAppBackupsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAppBackupsRequestMultiError) AllErrors() []error { return m }
+
+// ListAppBackupsRequestValidationError is the validation error returned by
+// ListAppBackupsRequest.Validate if the designated constraints aren't met.
+type ListAppBackupsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAppBackupsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAppBackupsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAppBackupsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAppBackupsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAppBackupsRequestValidationError) ErrorName() string {
+	return "ListAppBackupsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAppBackupsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAppBackupsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAppBackupsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAppBackupsRequestValidationError{}
+
+// Validate checks the field values on ListAppBackupsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAppBackupsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAppBackupsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAppBackupsResponseMultiError, or nil if none found.
+func (m *ListAppBackupsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAppBackupsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBackups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAppBackupsResponseValidationError{
+						field:  fmt.Sprintf("Backups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAppBackupsResponseValidationError{
+						field:  fmt.Sprintf("Backups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAppBackupsResponseValidationError{
+					field:  fmt.Sprintf("Backups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListAppBackupsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAppBackupsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAppBackupsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAppBackupsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAppBackupsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAppBackupsResponseMultiError) AllErrors() []error { return m }
+
+// ListAppBackupsResponseValidationError is the validation error returned by
+// ListAppBackupsResponse.Validate if the designated constraints aren't met.
+type ListAppBackupsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAppBackupsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAppBackupsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAppBackupsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAppBackupsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAppBackupsResponseValidationError) ErrorName() string {
+	return "ListAppBackupsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAppBackupsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAppBackupsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAppBackupsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAppBackupsResponseValidationError{}
+
+// Validate checks the field values on AppBackup with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AppBackup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AppBackup with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AppBackupMultiError, or nil
+// if none found.
+func (m *AppBackup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AppBackup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for AppName
+
+	// no validation rules for Phase
+
+	// no validation rules for Created
+
+	// no validation rules for Location
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return AppBackupMultiError(errors)
+	}
+
+	return nil
+}
+
+// AppBackupMultiError is an error wrapping multiple validation errors returned
+// by AppBackup.ValidateAll() if the designated constraints aren't met.
+type AppBackupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppBackupMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppBackupMultiError) AllErrors() []error { return m }
+
+// AppBackupValidationError is the validation error returned by
+// AppBackup.Validate if the designated constraints aren't met.
+type AppBackupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppBackupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppBackupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppBackupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppBackupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppBackupValidationError) ErrorName() string { return "AppBackupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AppBackupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppBackup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppBackupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppBackupValidationError{}
+
+// Validate checks the field values on RestoreAppRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RestoreAppRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreAppRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreAppRequestMultiError, or nil if none found.
+func (m *RestoreAppRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreAppRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppName
+
+	// no validation rules for Backup
+
+	if len(errors) > 0 {
+		return RestoreAppRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreAppRequestMultiError is an error wrapping multiple validation errors
+// returned by RestoreAppRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RestoreAppRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreAppRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreAppRequestMultiError) AllErrors() []error { return m }
+
+// RestoreAppRequestValidationError is the validation error returned by
+// RestoreAppRequest.Validate if the designated constraints aren't met.
+type RestoreAppRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreAppRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreAppRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreAppRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreAppRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreAppRequestValidationError) ErrorName() string {
+	return "RestoreAppRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreAppRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreAppRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreAppRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreAppRequestValidationError{}
+
+// Validate checks the field values on RestoreAppResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreAppResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreAppResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreAppResponseMultiError, or nil if none found.
+func (m *RestoreAppResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreAppResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Restore
+
+	if len(errors) > 0 {
+		return RestoreAppResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreAppResponseMultiError is an error wrapping multiple validation errors
+// returned by RestoreAppResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RestoreAppResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreAppResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreAppResponseMultiError) AllErrors() []error { return m }
+
+// RestoreAppResponseValidationError is the validation error returned by
+// RestoreAppResponse.Validate if the designated constraints aren't met.
+type RestoreAppResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreAppResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreAppResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreAppResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreAppResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreAppResponseValidationError) ErrorName() string {
+	return "RestoreAppResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreAppResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreAppResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreAppResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreAppResponseValidationError{}
+
+// Validate checks the field values on PreviewAppChangeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewAppChangeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreviewAppChangeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreviewAppChangeRequestMultiError, or nil if none found.
+func (m *PreviewAppChangeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreviewAppChangeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Chart
+
+	// no validation rules for Repo
+
+	// no validation rules for Release
+
+	// no validation rules for Values
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return PreviewAppChangeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreviewAppChangeRequestMultiError is an error wrapping multiple validation
+// errors returned by PreviewAppChangeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PreviewAppChangeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreviewAppChangeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreviewAppChangeRequestMultiError) AllErrors() []error { return m }
+
+// PreviewAppChangeRequestValidationError is the validation error returned by
+// PreviewAppChangeRequest.Validate if the designated constraints aren't met.
+type PreviewAppChangeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewAppChangeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewAppChangeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewAppChangeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewAppChangeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewAppChangeRequestValidationError) ErrorName() string {
+	return "PreviewAppChangeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewAppChangeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewAppChangeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewAppChangeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewAppChangeRequestValidationError{}
+
+// Validate checks the field values on PreviewAppChangeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewAppChangeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreviewAppChangeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreviewAppChangeResponseMultiError, or nil if none found.
+func (m *PreviewAppChangeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreviewAppChangeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstalledVersion
+
+	for idx, item := range m.GetManifestChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PreviewAppChangeResponseValidationError{
+						field:  fmt.Sprintf("ManifestChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PreviewAppChangeResponseValidationError{
+						field:  fmt.Sprintf("ManifestChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PreviewAppChangeResponseValidationError{
+					field:  fmt.Sprintf("ManifestChanges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDependencyChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PreviewAppChangeResponseValidationError{
+						field:  fmt.Sprintf("DependencyChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PreviewAppChangeResponseValidationError{
+						field:  fmt.Sprintf("DependencyChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PreviewAppChangeResponseValidationError{
+					field:  fmt.Sprintf("DependencyChanges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PreviewAppChangeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreviewAppChangeResponseMultiError is an error wrapping multiple validation
+// errors returned by PreviewAppChangeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PreviewAppChangeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreviewAppChangeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreviewAppChangeResponseMultiError) AllErrors() []error { return m }
+
+// PreviewAppChangeResponseValidationError is the validation error returned by
+// PreviewAppChangeResponse.Validate if the designated constraints aren't met.
+type PreviewAppChangeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewAppChangeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewAppChangeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewAppChangeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewAppChangeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewAppChangeResponseValidationError) ErrorName() string {
+	return "PreviewAppChangeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewAppChangeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewAppChangeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewAppChangeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewAppChangeResponseValidationError{}
+
+// Validate checks the field values on ManifestChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ManifestChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ManifestChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ManifestChangeMultiError,
+// or nil if none found.
+func (m *ManifestChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ManifestChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	// no validation rules for Namespace
+
+	// no validation rules for Name
+
+	// no validation rules for Type
+
+	// no validation rules for Diff
+
+	if len(errors) > 0 {
+		return ManifestChangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAppStorageResponseMultiError is an error wrapping multiple validation
-// errors returned by GetAppStorageResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetAppStorageResponseMultiError []error
+// ManifestChangeMultiError is an error wrapping multiple validation errors
+// returned by ManifestChange.ValidateAll() if the designated constraints
+// aren't met.
+type ManifestChangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAppStorageResponseMultiError) Error() string {
+func (m ManifestChangeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2772,11 +4700,11 @@ func (m GetAppStorageResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAppStorageResponseMultiError) AllErrors() []error { return m }
+func (m ManifestChangeMultiError) AllErrors() []error { return m }
 
-// GetAppStorageResponseValidationError is the validation error returned by
-// GetAppStorageResponse.Validate if the designated constraints aren't met.
-type GetAppStorageResponseValidationError struct {
+// ManifestChangeValidationError is the validation error returned by
+// ManifestChange.Validate if the designated constraints aren't met.
+type ManifestChangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2784,24 +4712,22 @@ type GetAppStorageResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAppStorageResponseValidationError) Field() string { return e.field }
+func (e ManifestChangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAppStorageResponseValidationError) Reason() string { return e.reason }
+func (e ManifestChangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAppStorageResponseValidationError) Cause() error { return e.cause }
+func (e ManifestChangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAppStorageResponseValidationError) Key() bool { return e.key }
+func (e ManifestChangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAppStorageResponseValidationError) ErrorName() string {
-	return "GetAppStorageResponseValidationError"
-}
+func (e ManifestChangeValidationError) ErrorName() string { return "ManifestChangeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetAppStorageResponseValidationError) Error() string {
+func (e ManifestChangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2813,14 +4739,14 @@ func (e GetAppStorageResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAppStorageResponse.%s: %s%s",
+		"invalid %sManifestChange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAppStorageResponseValidationError{}
+var _ error = ManifestChangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -2828,45 +4754,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAppStorageResponseValidationError{}
+} = ManifestChangeValidationError{}
 
-// Validate checks the field values on AppStorage with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AppStorage) Validate() error {
+// Validate checks the field values on DependencyChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DependencyChange) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AppStorage with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AppStorageMultiError, or
-// nil if none found.
-func (m *AppStorage) ValidateAll() error {
+// ValidateAll checks the field values on DependencyChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DependencyChangeMultiError, or nil if none found.
+func (m *DependencyChange) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AppStorage) validate(all bool) error {
+func (m *DependencyChange) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AppName
+	// no validation rules for Kind
+
+	// no validation rules for Name
+
+	// no validation rules for Type
+
+	// no validation rules for Description
 
 	if len(errors) > 0 {
-		return AppStorageMultiError(errors)
+		return DependencyChangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// AppStorageMultiError is an error wrapping multiple validation errors
-// returned by AppStorage.ValidateAll() if the designated constraints aren't met.
-type AppStorageMultiError []error
+// DependencyChangeMultiError is an error wrapping multiple validation errors
+// returned by DependencyChange.ValidateAll() if the designated constraints
+// aren't met.
+type DependencyChangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AppStorageMultiError) Error() string {
+func (m DependencyChangeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2875,11 +4808,11 @@ func (m AppStorageMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AppStorageMultiError) AllErrors() []error { return m }
+func (m DependencyChangeMultiError) AllErrors() []error { return m }
 
-// AppStorageValidationError is the validation error returned by
-// AppStorage.Validate if the designated constraints aren't met.
-type AppStorageValidationError struct {
+// DependencyChangeValidationError is the validation error returned by
+// DependencyChange.Validate if the designated constraints aren't met.
+type DependencyChangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2887,22 +4820,22 @@ type AppStorageValidationError struct {
 }
 
 // Field function returns field value.
-func (e AppStorageValidationError) Field() string { return e.field }
+func (e DependencyChangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AppStorageValidationError) Reason() string { return e.reason }
+func (e DependencyChangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AppStorageValidationError) Cause() error { return e.cause }
+func (e DependencyChangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AppStorageValidationError) Key() bool { return e.key }
+func (e DependencyChangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AppStorageValidationError) ErrorName() string { return "AppStorageValidationError" }
+func (e DependencyChangeValidationError) ErrorName() string { return "DependencyChangeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AppStorageValidationError) Error() string {
+func (e DependencyChangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2914,14 +4847,14 @@ func (e AppStorageValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAppStorage.%s: %s%s",
+		"invalid %sDependencyChange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AppStorageValidationError{}
+var _ error = DependencyChangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -2929,7 +4862,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AppStorageValidationError{}
+} = DependencyChangeValidationError{}
 
 // Validate checks the field values on EnableSecureTunnellingRequest with the
 // rules defined in the proto definition for this message. If any rules are
@@ -5867,6 +7800,110 @@ var _ interface {
 	ErrorName() string
 } = DeviceSettingsValidationError{}
 
+// Validate checks the field values on Account with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Account) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Account with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AccountMultiError, or nil if none found.
+func (m *Account) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Account) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Username
+
+	// no validation rules for Email
+
+	// no validation rules for Admin
+
+	if len(errors) > 0 {
+		return AccountMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccountMultiError is an error wrapping multiple validation errors returned
+// by Account.ValidateAll() if the designated constraints aren't met.
+type AccountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccountMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccountMultiError) AllErrors() []error { return m }
+
+// AccountValidationError is the validation error returned by Account.Validate
+// if the designated constraints aren't met.
+type AccountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccountValidationError) ErrorName() string { return "AccountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccountValidationError{}
+
 // Validate checks the field values on SecureTunnelingSettings with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -6466,6 +8503,47 @@ func (m *ServerEvent) validate(all bool) error {
 			}
 		}
 
+	case *ServerEvent_AppRestore:
+		if v == nil {
+			err := ServerEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAppRestore()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServerEventValidationError{
+						field:  "AppRestore",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServerEventValidationError{
+						field:  "AppRestore",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAppRestore()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServerEventValidationError{
+					field:  "AppRestore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -6852,6 +8930,114 @@ var _ interface {
 	ErrorName() string
 } = AppInstalledEventValidationError{}
 
+// Validate checks the field values on AppRestoreEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AppRestoreEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AppRestoreEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AppRestoreEventMultiError, or nil if none found.
+func (m *AppRestoreEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AppRestoreEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppName
+
+	// no validation rules for Restore
+
+	// no validation rules for Phase
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return AppRestoreEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// AppRestoreEventMultiError is an error wrapping multiple validation errors
+// returned by AppRestoreEvent.ValidateAll() if the designated constraints
+// aren't met.
+type AppRestoreEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppRestoreEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppRestoreEventMultiError) AllErrors() []error { return m }
+
+// AppRestoreEventValidationError is the validation error returned by
+// AppRestoreEvent.Validate if the designated constraints aren't met.
+type AppRestoreEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppRestoreEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppRestoreEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppRestoreEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppRestoreEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppRestoreEventValidationError) ErrorName() string { return "AppRestoreEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AppRestoreEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppRestoreEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppRestoreEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppRestoreEventValidationError{}
+
 // Validate checks the field values on RegisterPeerRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -260,6 +260,20 @@ export declare type AppHealth = Message<"platform.server.v1.AppHealth"> & {
    * @generated from field: platform.server.v1.AppDisplay display = 3;
    */
   display?: AppDisplay;
+
+  /**
+   * phase of the App install lifecycle (e.g. Installing, Installed, Failed)
+   *
+   * @generated from field: string phase = 4;
+   */
+  phase: string;
+
+  /**
+   * last error encountered while reconciling the App
+   *
+   * @generated from field: string error = 5;
+   */
+  error: string;
 };
 
 /**
@@ -406,6 +420,155 @@ export declare type SetDeviceSettingsResponse = Message<"platform.server.v1.SetD
  */
 export declare const SetDeviceSettingsResponseSchema: GenMessage<SetDeviceSettingsResponse>;
 
+/**
+ * @generated from message platform.server.v1.GetCACertificateRequest
+ */
+export declare type GetCACertificateRequest = Message<"platform.server.v1.GetCACertificateRequest"> & {
+};
+
+/**
+ * Describes the message platform.server.v1.GetCACertificateRequest.
+ * Use `create(GetCACertificateRequestSchema)` to create a new message.
+ */
+export declare const GetCACertificateRequestSchema: GenMessage<GetCACertificateRequest>;
+
+/**
+ * @generated from message platform.server.v1.GetCACertificateResponse
+ */
+export declare type GetCACertificateResponse = Message<"platform.server.v1.GetCACertificateResponse"> & {
+  /**
+   * PEM encoded certificate to install as a trusted root on devices
+   *
+   * @generated from field: string certificate = 1;
+   */
+  certificate: string;
+};
+
+/**
+ * Describes the message platform.server.v1.GetCACertificateResponse.
+ * Use `create(GetCACertificateResponseSchema)` to create a new message.
+ */
+export declare const GetCACertificateResponseSchema: GenMessage<GetCACertificateResponse>;
+
+/**
+ * @generated from message platform.server.v1.ListAccountsRequest
+ */
+export declare type ListAccountsRequest = Message<"platform.server.v1.ListAccountsRequest"> & {
+};
+
+/**
+ * Describes the message platform.server.v1.ListAccountsRequest.
+ * Use `create(ListAccountsRequestSchema)` to create a new message.
+ */
+export declare const ListAccountsRequestSchema: GenMessage<ListAccountsRequest>;
+
+/**
+ * @generated from message platform.server.v1.ListAccountsResponse
+ */
+export declare type ListAccountsResponse = Message<"platform.server.v1.ListAccountsResponse"> & {
+  /**
+   * @generated from field: repeated platform.server.v1.Account accounts = 1;
+   */
+  accounts: Account[];
+};
+
+/**
+ * Describes the message platform.server.v1.ListAccountsResponse.
+ * Use `create(ListAccountsResponseSchema)` to create a new message.
+ */
+export declare const ListAccountsResponseSchema: GenMessage<ListAccountsResponse>;
+
+/**
+ * @generated from message platform.server.v1.SaveAccountRequest
+ */
+export declare type SaveAccountRequest = Message<"platform.server.v1.SaveAccountRequest"> & {
+  /**
+   * @generated from field: string username = 1;
+   */
+  username: string;
+
+  /**
+   * @generated from field: string email = 2;
+   */
+  email: string;
+
+  /**
+   * required when creating an account, the current password is kept when empty
+   *
+   * @generated from field: string password = 3;
+   */
+  password: string;
+
+  /**
+   * required to change your own account unless signed in as an admin (only admins can create or
+   * change other accounts)
+   *
+   * @generated from field: string current_password = 4;
+   */
+  currentPassword: string;
+
+  /**
+   * only admins can change who is an admin and the last admin can't be demoted
+   *
+   * @generated from field: bool admin = 5;
+   */
+  admin: boolean;
+};
+
+/**
+ * Describes the message platform.server.v1.SaveAccountRequest.
+ * Use `create(SaveAccountRequestSchema)` to create a new message.
+ */
+export declare const SaveAccountRequestSchema: GenMessage<SaveAccountRequest>;
+
+/**
+ * @generated from message platform.server.v1.SaveAccountResponse
+ */
+export declare type SaveAccountResponse = Message<"platform.server.v1.SaveAccountResponse"> & {
+};
+
+/**
+ * Describes the message platform.server.v1.SaveAccountResponse.
+ * Use `create(SaveAccountResponseSchema)` to create a new message.
+ */
+export declare const SaveAccountResponseSchema: GenMessage<SaveAccountResponse>;
+
+/**
+ * @generated from message platform.server.v1.DeleteAccountRequest
+ */
+export declare type DeleteAccountRequest = Message<"platform.server.v1.DeleteAccountRequest"> & {
+  /**
+   * @generated from field: string username = 1;
+   */
+  username: string;
+
+  /**
+   * required to delete your own account unless signed in as an admin (only admins can delete other
+   * accounts and the last admin can't be deleted)
+   *
+   * @generated from field: string current_password = 2;
+   */
+  currentPassword: string;
+};
+
+/**
+ * Describes the message platform.server.v1.DeleteAccountRequest.
+ * Use `create(DeleteAccountRequestSchema)` to create a new message.
+ */
+export declare const DeleteAccountRequestSchema: GenMessage<DeleteAccountRequest>;
+
+/**
+ * @generated from message platform.server.v1.DeleteAccountResponse
+ */
+export declare type DeleteAccountResponse = Message<"platform.server.v1.DeleteAccountResponse"> & {
+};
+
+/**
+ * Describes the message platform.server.v1.DeleteAccountResponse.
+ * Use `create(DeleteAccountResponseSchema)` to create a new message.
+ */
+export declare const DeleteAccountResponseSchema: GenMessage<DeleteAccountResponse>;
+
 /**
  * @generated from message platform.server.v1.GetAppStorageRequest
  */
@@ -455,6 +618,263 @@ export declare type AppStorage = Message<"platform.server.v1.AppStorage"> & {
  */
 export declare const AppStorageSchema: GenMessage<AppStorage>;
 
+/**
+ * @generated from message platform.server.v1.ListAppBackupsRequest
+ */
+export declare type ListAppBackupsRequest = Message<"platform.server.v1.ListAppBackupsRequest"> & {
+  /**
+   * @generated from field: string app_name = 1;
+   */
+  appName: string;
+};
+
+/**
+ * Describes the message platform.server.v1.ListAppBackupsRequest.
+ * Use `create(ListAppBackupsRequestSchema)` to create a new message.
+ */
+export declare const ListAppBackupsRequestSchema: GenMessage<ListAppBackupsRequest>;
+
+/**
+ * @generated from message platform.server.v1.ListAppBackupsResponse
+ */
+export declare type ListAppBackupsResponse = Message<"platform.server.v1.ListAppBackupsResponse"> & {
+  /**
+   * @generated from field: repeated platform.server.v1.AppBackup backups = 1;
+   */
+  backups: AppBackup[];
+};
+
+/**
+ * Describes the message platform.server.v1.ListAppBackupsResponse.
+ * Use `create(ListAppBackupsResponseSchema)` to create a new message.
+ */
+export declare const ListAppBackupsResponseSchema: GenMessage<ListAppBackupsResponse>;
+
+/**
+ * @generated from message platform.server.v1.AppBackup
+ */
+export declare type AppBackup = Message<"platform.server.v1.AppBackup"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string app_name = 2;
+   */
+  appName: string;
+
+  /**
+   * Running, Completed, or Failed
+   *
+   * @generated from field: string phase = 3;
+   */
+  phase: string;
+
+  /**
+   * RFC 3339 creation time
+   *
+   * @generated from field: string created = 4;
+   */
+  created: string;
+
+  /**
+   * @generated from field: string location = 5;
+   */
+  location: string;
+
+  /**
+   * @generated from field: string error = 6;
+   */
+  error: string;
+};
+
+/**
+ * Describes the message platform.server.v1.AppBackup.
+ * Use `create(AppBackupSchema)` to create a new message.
+ */
+export declare const AppBackupSchema: GenMessage<AppBackup>;
+
+/**
+ * @generated from message platform.server.v1.RestoreAppRequest
+ */
+export declare type RestoreAppRequest = Message<"platform.server.v1.RestoreAppRequest"> & {
+  /**
+   * @generated from field: string app_name = 1;
+   */
+  appName: string;
+
+  /**
+   * name of a completed backup of the app
+   *
+   * @generated from field: string backup = 2;
+   */
+  backup: string;
+};
+
+/**
+ * Describes the message platform.server.v1.RestoreAppRequest.
+ * Use `create(RestoreAppRequestSchema)` to create a new message.
+ */
+export declare const RestoreAppRequestSchema: GenMessage<RestoreAppRequest>;
+
+/**
+ * @generated from message platform.server.v1.RestoreAppResponse
+ */
+export declare type RestoreAppResponse = Message<"platform.server.v1.RestoreAppResponse"> & {
+  /**
+   * name of the restore which progress events are sent for
+   *
+   * @generated from field: string restore = 1;
+   */
+  restore: string;
+};
+
+/**
+ * Describes the message platform.server.v1.RestoreAppResponse.
+ * Use `create(RestoreAppResponseSchema)` to create a new message.
+ */
+export declare const RestoreAppResponseSchema: GenMessage<RestoreAppResponse>;
+
+/**
+ * @generated from message platform.server.v1.PreviewAppChangeRequest
+ */
+export declare type PreviewAppChangeRequest = Message<"platform.server.v1.PreviewAppChangeRequest"> & {
+  /**
+   * @generated from field: string chart = 1;
+   */
+  chart: string;
+
+  /**
+   * @generated from field: string repo = 2;
+   */
+  repo: string;
+
+  /**
+   * @generated from field: string release = 3;
+   */
+  release: string;
+
+  /**
+   * @generated from field: string values = 4;
+   */
+  values: string;
+
+  /**
+   * @generated from field: string version = 5;
+   */
+  version: string;
+};
+
+/**
+ * Describes the message platform.server.v1.PreviewAppChangeRequest.
+ * Use `create(PreviewAppChangeRequestSchema)` to create a new message.
+ */
+export declare const PreviewAppChangeRequestSchema: GenMessage<PreviewAppChangeRequest>;
+
+/**
+ * @generated from message platform.server.v1.PreviewAppChangeResponse
+ */
+export declare type PreviewAppChangeResponse = Message<"platform.server.v1.PreviewAppChangeResponse"> & {
+  /**
+   * version of the installed chart (empty if the app isn't installed)
+   *
+   * @generated from field: string installed_version = 1;
+   */
+  installedVersion: string;
+
+  /**
+   * changes of the objects rendered by the chart
+   *
+   * @generated from field: repeated platform.server.v1.ManifestChange manifest_changes = 2;
+   */
+  manifestChanges: ManifestChange[];
+
+  /**
+   * changes of the secrets, databases, volumes, routes, etc. created for the app
+   *
+   * @generated from field: repeated platform.server.v1.DependencyChange dependency_changes = 3;
+   */
+  dependencyChanges: DependencyChange[];
+};
+
+/**
+ * Describes the message platform.server.v1.PreviewAppChangeResponse.
+ * Use `create(PreviewAppChangeResponseSchema)` to create a new message.
+ */
+export declare const PreviewAppChangeResponseSchema: GenMessage<PreviewAppChangeResponse>;
+
+/**
+ * @generated from message platform.server.v1.ManifestChange
+ */
+export declare type ManifestChange = Message<"platform.server.v1.ManifestChange"> & {
+  /**
+   * @generated from field: string kind = 1;
+   */
+  kind: string;
+
+  /**
+   * @generated from field: string namespace = 2;
+   */
+  namespace: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: platform.server.v1.ChangeType type = 4;
+   */
+  type: ChangeType;
+
+  /**
+   * unified diff of the object manifest (the data of secrets is hidden)
+   *
+   * @generated from field: string diff = 5;
+   */
+  diff: string;
+};
+
+/**
+ * Describes the message platform.server.v1.ManifestChange.
+ * Use `create(ManifestChangeSchema)` to create a new message.
+ */
+export declare const ManifestChangeSchema: GenMessage<ManifestChange>;
+
+/**
+ * @generated from message platform.server.v1.DependencyChange
+ */
+export declare type DependencyChange = Message<"platform.server.v1.DependencyChange"> & {
+  /**
+   * secret, database, volume, route, oidc, resources, or dependency
+   *
+   * @generated from field: string kind = 1;
+   */
+  kind: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: platform.server.v1.ChangeType type = 3;
+   */
+  type: ChangeType;
+
+  /**
+   * @generated from field: string description = 4;
+   */
+  description: string;
+};
+
+/**
+ * Describes the message platform.server.v1.DependencyChange.
+ * Use `create(DependencyChangeSchema)` to create a new message.
+ */
+export declare const DependencyChangeSchema: GenMessage<DependencyChange>;
+
 /**
  * @generated from message platform.server.v1.EnableSecureTunnellingRequest
  */
@@ -1033,6 +1453,36 @@ export declare type DeviceSettings = Message<"platform.server.v1.DeviceSettings"
  */
 export declare const DeviceSettingsSchema: GenMessage<DeviceSettings>;
 
+/**
+ * Home Cloud account (the password is never returned)
+ *
+ * @generated from message platform.server.v1.Account
+ */
+export declare type Account = Message<"platform.server.v1.Account"> & {
+  /**
+   * @generated from field: string username = 1;
+   */
+  username: string;
+
+  /**
+   * @generated from field: string email = 2;
+   */
+  email: string;
+
+  /**
+   * admins can create, change and delete all accounts
+   *
+   * @generated from field: bool admin = 3;
+   */
+  admin: boolean;
+};
+
+/**
+ * Describes the message platform.server.v1.Account.
+ * Use `create(AccountSchema)` to create a new message.
+ */
+export declare const AccountSchema: GenMessage<Account>;
+
 /**
  * @generated from message platform.server.v1.SecureTunnelingSettings
  */
@@ -1153,6 +1603,12 @@ export declare type ServerEvent = Message<"platform.server.v1.ServerEvent"> & {
      */
     value: AppInstalledEvent;
     case: "appInstalled";
+  } | {
+    /**
+     * @generated from field: platform.server.v1.AppRestoreEvent app_restore = 4;
+     */
+    value: AppRestoreEvent;
+    case: "appRestore";
   } | { case: undefined; value?: undefined };
 };
 
@@ -1206,6 +1662,39 @@ export declare type AppInstalledEvent = Message<"platform.server.v1.AppInstalled
  */
 export declare const AppInstalledEventSchema: GenMessage<AppInstalledEvent>;
 
+/**
+ * @generated from message platform.server.v1.AppRestoreEvent
+ */
+export declare type AppRestoreEvent = Message<"platform.server.v1.AppRestoreEvent"> & {
+  /**
+   * @generated from field: string app_name = 1;
+   */
+  appName: string;
+
+  /**
+   * @generated from field: string restore = 2;
+   */
+  restore: string;
+
+  /**
+   * Stopping, Restoring, Completed, or Failed
+   *
+   * @generated from field: string phase = 3;
+   */
+  phase: string;
+
+  /**
+   * @generated from field: string error = 4;
+   */
+  error: string;
+};
+
+/**
+ * Describes the message platform.server.v1.AppRestoreEvent.
+ * Use `create(AppRestoreEventSchema)` to create a new message.
+ */
+export declare const AppRestoreEventSchema: GenMessage<AppRestoreEvent>;
+
 /**
  * @generated from message platform.server.v1.RegisterPeerRequest
  */
@@ -1326,6 +1815,36 @@ export enum AppStatus {
  */
 export declare const AppStatusSchema: GenEnum<AppStatus>;
 
+/**
+ * @generated from enum platform.server.v1.ChangeType
+ */
+export enum ChangeType {
+  /**
+   * @generated from enum value: CHANGE_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CHANGE_TYPE_ADDED = 1;
+   */
+  ADDED = 1,
+
+  /**
+   * @generated from enum value: CHANGE_TYPE_REMOVED = 2;
+   */
+  REMOVED = 2,
+
+  /**
+   * @generated from enum value: CHANGE_TYPE_MODIFIED = 3;
+   */
+  MODIFIED = 3,
+}
+
+/**
+ * Describes the enum platform.server.v1.ChangeType.
+ */
+export declare const ChangeTypeSchema: GenEnum<ChangeType>;
+
 /**
  * @generated from service platform.server.v1.WebService
  */
@@ -1400,6 +1919,36 @@ export declare const WebService: GenService<{
     input: typeof GetAppStorageRequestSchema;
     output: typeof GetAppStorageResponseSchema;
   },
+  /**
+   * Get all backups of an installed app
+   *
+   * @generated from rpc platform.server.v1.WebService.ListAppBackups
+   */
+  listAppBackups: {
+    methodKind: "unary";
+    input: typeof ListAppBackupsRequestSchema;
+    output: typeof ListAppBackupsResponseSchema;
+  },
+  /**
+   * Restore an installed app from one of its backups
+   *
+   * @generated from rpc platform.server.v1.WebService.RestoreApp
+   */
+  restoreApp: {
+    methodKind: "unary";
+    input: typeof RestoreAppRequestSchema;
+    output: typeof RestoreAppResponseSchema;
+  },
+  /**
+   * Preview the changes of installing or updating a Home Cloud application without applying them
+   *
+   * @generated from rpc platform.server.v1.WebService.PreviewAppChange
+   */
+  previewAppChange: {
+    methodKind: "unary";
+    input: typeof PreviewAppChangeRequestSchema;
+    output: typeof PreviewAppChangeResponseSchema;
+  },
   /**
    * Shutdown the host machine running Home Cloud
    *
@@ -1470,6 +2019,46 @@ export declare const WebService: GenService<{
     input: typeof SetDeviceSettingsRequestSchema;
     output: typeof SetDeviceSettingsResponseSchema;
   },
+  /**
+   * Get the root certificate of the local CA which issues certificates for app routes
+   *
+   * @generated from rpc platform.server.v1.WebService.GetCACertificate
+   */
+  getCACertificate: {
+    methodKind: "unary";
+    input: typeof GetCACertificateRequestSchema;
+    output: typeof GetCACertificateResponseSchema;
+  },
+  /**
+   * List the Home Cloud accounts which users sign in to apps with
+   *
+   * @generated from rpc platform.server.v1.WebService.ListAccounts
+   */
+  listAccounts: {
+    methodKind: "unary";
+    input: typeof ListAccountsRequestSchema;
+    output: typeof ListAccountsResponseSchema;
+  },
+  /**
+   * Create a Home Cloud account or update its email and password
+   *
+   * @generated from rpc platform.server.v1.WebService.SaveAccount
+   */
+  saveAccount: {
+    methodKind: "unary";
+    input: typeof SaveAccountRequestSchema;
+    output: typeof SaveAccountResponseSchema;
+  },
+  /**
+   * Delete a Home Cloud account
+   *
+   * @generated from rpc platform.server.v1.WebService.DeleteAccount
+   */
+  deleteAccount: {
+    methodKind: "unary";
+    input: typeof DeleteAccountRequestSchema;
+    output: typeof DeleteAccountResponseSchema;
+  },
   /**
    * Enables the remote access feature
    *
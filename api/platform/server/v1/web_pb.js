@@ -9,7 +9,7 @@ import { file_platform_daemon_v1_system } from "../../daemon/v1/system_pb";
  * Describes the file platform/server/v1/web.proto.
  */
 export const file_platform_server_v1_web = /*@__PURE__*/
-  fileDesc("ChxwbGF0Zm9ybS9zZXJ2ZXIvdjEvd2ViLnByb3RvEhJwbGF0Zm9ybS5zZXJ2ZXIudjEiFQoTU2h1dGRvd25Ib3N0UmVxdWVzdCIWChRTaHV0ZG93bkhvc3RSZXNwb25zZSIUChJSZXN0YXJ0SG9zdFJlcXVlc3QiFQoTUmVzdGFydEhvc3RSZXNwb25zZSJiChFJbnN0YWxsQXBwUmVxdWVzdBINCgVjaGFydBgBIAEoCRIMCgRyZXBvGAIgASgJEg8KB3JlbGVhc2UYAyABKAkSDgoGdmFsdWVzGAQgASgJEg8KB3ZlcnNpb24YBSABKAkiFAoSSW5zdGFsbEFwcFJlc3BvbnNlImEKEFVwZGF0ZUFwcFJlcXVlc3QSDQoFY2hhcnQYASABKAkSDAoEcmVwbxgCIAEoCRIPCgdyZWxlYXNlGAMgASgJEg4KBnZhbHVlcxgEIAEoCRIPCgd2ZXJzaW9uGAUgASgJIhMKEVVwZGF0ZUFwcFJlc3BvbnNlIiMKEERlbGV0ZUFwcFJlcXVlc3QSDwoHcmVsZWFzZRgBIAEoCSITChFEZWxldGVBcHBSZXNwb25zZSJMCgxJbWFnZVZlcnNpb24SDQoFaW1hZ2UYASABKAkSDwoHY3VycmVudBgCIAEoCRIOCgZsYXRlc3QYAyABKAkSDAoEbmFtZRgEIAEoCSIYChZBcHBzSGVhbHRoQ2hlY2tSZXF1ZXN0IkgKF0FwcHNIZWFsdGhDaGVja1Jlc3BvbnNlEi0KBmNoZWNrcxgBIAMoCzIdLnBsYXRmb3JtLnNlcnZlci52MS5BcHBIZWFsdGgieQoJQXBwSGVhbHRoEgwKBG5hbWUYASABKAkSLQoGc3RhdHVzGAIgASgOMh0ucGxhdGZvcm0uc2VydmVyLnYxLkFwcFN0YXR1cxIvCgdkaXNwbGF5GAMgASgLMh4ucGxhdGZvcm0uc2VydmVyLnYxLkFwcERpc3BsYXkiQQoKQXBwRGlzcGxheRIMCgRuYW1lGAEgASgJEhAKCGljb25fdXJsGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJIhcKFUdldFN5c3RlbVN0YXRzUmVxdWVzdCJIChZHZXRTeXN0ZW1TdGF0c1Jlc3BvbnNlEi4KBXN0YXRzGAEgASgLMh8ucGxhdGZvcm0uZGFlbW9uLnYxLlN5c3RlbVN0YXRzIhcKFUdldEFwcHNJblN0b3JlUmVxdWVzdCI/ChZHZXRBcHBzSW5TdG9yZVJlc3BvbnNlEiUKBGFwcHMYASADKAsyFy5wbGF0Zm9ybS5zZXJ2ZXIudjEuQXBwIhoKGEdldERldmljZVNldHRpbmdzUmVxdWVzdCJRChlHZXREZXZpY2VTZXR0aW5nc1Jlc3BvbnNlEjQKCHNldHRpbmdzGAEgASgLMiIucGxhdGZvcm0uc2VydmVyLnYxLkRldmljZVNldHRpbmdzIlAKGFNldERldmljZVNldHRpbmdzUmVxdWVzdBI0CghzZXR0aW5ncxgBIAEoCzIiLnBsYXRmb3JtLnNlcnZlci52MS5EZXZpY2VTZXR0aW5ncyIbChlTZXREZXZpY2VTZXR0aW5nc1Jlc3BvbnNlIhYKFEdldEFwcFN0b3JhZ2VSZXF1ZXN0IkUKFUdldEFwcFN0b3JhZ2VSZXNwb25zZRIsCgRhcHBzGAEgAygLMh4ucGxhdGZvcm0uc2VydmVyLnYxLkFwcFN0b3JhZ2UiLwoKQXBwU3RvcmFnZRIQCghhcHBfbmFtZRgBIAEoCRIPCgd2b2x1bWVzGAIgAygJIh8KHUVuYWJsZVNlY3VyZVR1bm5lbGxpbmdSZXF1ZXN0IiAKHkVuYWJsZVNlY3VyZVR1bm5lbGxpbmdSZXNwb25zZSIgCh5EaXNhYmxlU2VjdXJlVHVubmVsbGluZ1JlcXVlc3QiIQofRGlzYWJsZVNlY3VyZVR1bm5lbGxpbmdSZXNwb25zZSJQChhSZWdpc3RlclRvTG9jYXRvclJlcXVlc3QSFwoPbG9jYXRvcl9hZGRyZXNzGAEgASgJEhsKE3dpcmVndWFyZF9pbnRlcmZhY2UYAiABKAkiGwoZUmVnaXN0ZXJUb0xvY2F0b3JSZXNwb25zZSJUChxEZXJlZ2lzdGVyRnJvbUxvY2F0b3JSZXF1ZXN0EhcKD2xvY2F0b3JfYWRkcmVzcxgBIAEoCRIbChN3aXJlZ3VhcmRfaW50ZXJmYWNlGAIgASgJIh8KHURlcmVnaXN0ZXJGcm9tTG9jYXRvclJlc3BvbnNlIh0KG0dldENvbXBvbmVudFZlcnNpb25zUmVxdWVzdCKMAQocR2V0Q29tcG9uZW50VmVyc2lvbnNSZXNwb25zZRI2CghwbGF0Zm9ybRgBIAMoCzIkLnBsYXRmb3JtLmRhZW1vbi52MS5Db21wb25lbnRWZXJzaW9uEjQKBnN5c3RlbRgCIAMoCzIkLnBsYXRmb3JtLmRhZW1vbi52MS5Db21wb25lbnRWZXJzaW9uIi0KFEdldFN5c3RlbUxvZ3NSZXF1ZXN0EhUKDXNpbmNlX3NlY29uZHMYASABKA0idAoVR2V0U3lzdGVtTG9nc1Jlc3BvbnNlEiUKBGxvZ3MYASADKAsyFy5wbGF0Zm9ybS5kYWVtb24udjEuTG9nEg8KB3NvdXJjZXMYAiADKAkSEgoKbmFtZXNwYWNlcxgDIAMoCRIPCgdkb21haW5zGAQgAygJIi0KBEFwcHMSJQoEYXBwcxgBIAMoCzIXLnBsYXRmb3JtLnNlcnZlci52MS5BcHAihwMKA0FwcBIMCgRuYW1lGAEgASgJEg8KB3ZlcnNpb24YAiABKAkSEwoLYXBwX3ZlcnNpb24YAyABKAkSEwoLZGVzY3JpcHRpb24YBCABKAkSDAoEaWNvbhgFIAEoCRIPCgdjcmVhdGVkGAYgASgJEg4KBmRpZ2VzdBgHIAEoCRIMCgR0eXBlGAggASgJEgwKBHVybHMYCSADKAkSNwoMZGVwZW5kZW5jaWVzGAogAygLMiEucGxhdGZvcm0uc2VydmVyLnYxLkFwcERlcGVuZGVuY3kSDAoEaG9tZRgLIAEoCRIPCgdzb3VyY2VzGAwgAygJEj0KC2Fubm90YXRpb25zGA0gAygLMigucGxhdGZvcm0uc2VydmVyLnYxLkFwcC5Bbm5vdGF0aW9uc0VudHJ5Eg4KBnJlYWRtZRgOIAEoCRIRCglpbnN0YWxsZWQYDyABKAgaMgoQQW5ub3RhdGlvbnNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIkIKDUFwcERlcGVuZGVuY3kSDAoEbmFtZRgBIAEoCRIPCgd2ZXJzaW9uGAIgASgJEhIKCnJlcG9zaXRvcnkYAyABKAkiYAoQQXBwUnVubmluZ1N0YXR1cxIMCgRuYW1lGAEgASgJEg8KB3ZlcnNpb24YAiABKAkSLQoGc3RhdHVzGAMgASgOMh0ucGxhdGZvcm0uc2VydmVyLnYxLkFwcFN0YXR1cyIwCgdFbnRyaWVzEiUKBGFwcHMYASADKAsyFy5wbGF0Zm9ybS5zZXJ2ZXIudjEuQXBwIvMBCg1TeXN0ZW1WZXJzaW9uEg8KB3ZlcnNpb24YASABKAkSLwoFaXN0aW8YAiABKAsyIC5wbGF0Zm9ybS5zZXJ2ZXIudjEuSXN0aW9WZXJzaW9uEjoKC2dhdGV3YXlfYXBpGAMgASgLMiUucGxhdGZvcm0uc2VydmVyLnYxLkdhdGV3YXlBUElWZXJzaW9uEjEKBnNlcnZlchgEIAEoCzIhLnBsYXRmb3JtLnNlcnZlci52MS5TZXJ2ZXJWZXJzaW9uEjEKBmRhZW1vbhgFIAEoCzIhLnBsYXRmb3JtLnNlcnZlci52MS5EYWVtb25WZXJzaW9uIi0KDElzdGlvVmVyc2lvbhIMCgRyZXBvGAEgASgJEg8KB3ZlcnNpb24YAiABKAkiMQoRR2F0ZXdheUFQSVZlcnNpb24SCwoDdXJsGAEgASgJEg8KB3ZlcnNpb24YAiABKAkiKwoNU2VydmVyVmVyc2lvbhINCgVpbWFnZRgBIAEoCRILCgN0YWcYAiABKAkiKwoNRGFlbW9uVmVyc2lvbhINCgVpbWFnZRgBIAEoCRILCgN0YWcYAiABKAki3QEKD0FwcFN0b3JlRW50cmllcxITCgthcGlfdmVyc2lvbhgBIAEoCRIRCglnZW5lcmF0ZWQYAiABKAkSFQoNcmF3X2NoYXJ0X3VybBgDIAEoCRJBCgdlbnRyaWVzGAQgAygLMjAucGxhdGZvcm0uc2VydmVyLnYxLkFwcFN0b3JlRW50cmllcy5FbnRyaWVzRW50cnkaSAoMRW50cmllc0VudHJ5EgsKA2tleRgBIAEoCRInCgV2YWx1ZRgCIAEoCzIYLnBsYXRmb3JtLnNlcnZlci52MS5BcHBzOgI4ASKiAgoORGV2aWNlU2V0dGluZ3MSGAoQYXV0b191cGRhdGVfYXBwcxgBIAEoCBIaChJhdXRvX3VwZGF0ZV9zeXN0ZW0YAiABKAgSTgoZc2VjdXJlX3R1bm5lbGluZ19zZXR0aW5ncxgDIAEoCzIrLnBsYXRmb3JtLnNlcnZlci52MS5TZWN1cmVUdW5uZWxpbmdTZXR0aW5ncxIQCghob3N0bmFtZRgEIAEoCRIhChlhdXRvX3VwZGF0ZV9hcHBzX3NjaGVkdWxlGAUgASgJEiMKG2F1dG9fdXBkYXRlX3N5c3RlbV9zY2hlZHVsZRgGIAEoCRIwCgphcHBfc3RvcmVzGAcgAygLMhwucGxhdGZvcm0uc2VydmVyLnYxLkFwcFN0b3JlInAKF1NlY3VyZVR1bm5lbGluZ1NldHRpbmdzEg8KB2VuYWJsZWQYASABKAgSRAoUd2lyZWd1YXJkX2ludGVyZmFjZXMYAiADKAsyJi5wbGF0Zm9ybS5zZXJ2ZXIudjEuV2lyZWd1YXJkSW50ZXJmYWNlIn4KEldpcmVndWFyZEludGVyZmFjZRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEgwKBHBvcnQYAyABKAUSEgoKcHVibGljX2tleRgEIAEoCRITCgtzdHVuX3NlcnZlchgFIAEoCRIXCg9sb2NhdG9yX3NlcnZlcnMYBiADKAkiLgoIQXBwU3RvcmUSCwoDdXJsGAEgASgJEhUKDXJhd19jaGFydF91cmwYAiABKAkiEgoQU3Vic2NyaWJlUmVxdWVzdCLAAQoLU2VydmVyRXZlbnQSNwoJaGVhcnRiZWF0GAEgASgLMiIucGxhdGZvcm0uc2VydmVyLnYxLkhlYXJ0YmVhdEV2ZW50SAASLwoFZXJyb3IYAiABKAsyHi5wbGF0Zm9ybS5zZXJ2ZXIudjEuRXJyb3JFdmVudEgAEj4KDWFwcF9pbnN0YWxsZWQYAyABKAsyJS5wbGF0Zm9ybS5zZXJ2ZXIudjEuQXBwSW5zdGFsbGVkRXZlbnRIAEIHCgVldmVudCIQCg5IZWFydGJlYXRFdmVudCIbCgpFcnJvckV2ZW50Eg0KBWVycm9yGAEgASgJIiEKEUFwcEluc3RhbGxlZEV2ZW50EgwKBG5hbWUYASABKAkiFQoTUmVnaXN0ZXJQZWVyUmVxdWVzdCK6AQoUUmVnaXN0ZXJQZWVyUmVzcG9uc2USCgoCaWQYASABKAkSEwoLcHJpdmF0ZV9rZXkYAiABKAkSEgoKcHVibGljX2tleRgDIAEoCRIRCglhZGRyZXNzZXMYBCADKAkSEwoLZG5zX3NlcnZlcnMYBSADKAkSGQoRc2VydmVyX3B1YmxpY19rZXkYBiABKAkSEQoJc2VydmVyX2lkGAcgASgJEhcKD2xvY2F0b3Jfc2VydmVycxgIIAMoCSIjChVEZXJlZ2lzdGVyUGVlclJlcXVlc3QSCgoCaWQYASABKAkiGAoWRGVyZWdpc3RlclBlZXJSZXNwb25zZSpZCglBcHBTdGF0dXMSGgoWQVBQX1NUQVRVU19VTlNQRUNJRklFRBAAEhYKEkFQUF9TVEFUVVNfSEVBTFRIWRABEhgKFEFQUF9TVEFUVVNfVU5IRUFMVEhZEAIyihEKCldlYlNlcnZpY2USVgoJU3Vic2NyaWJlEiQucGxhdGZvcm0uc2VydmVyLnYxLlN1YnNjcmliZVJlcXVlc3QaHy5wbGF0Zm9ybS5zZXJ2ZXIudjEuU2VydmVyRXZlbnQiADABEl0KCkluc3RhbGxBcHASJS5wbGF0Zm9ybS5zZXJ2ZXIudjEuSW5zdGFsbEFwcFJlcXVlc3QaJi5wbGF0Zm9ybS5zZXJ2ZXIudjEuSW5zdGFsbEFwcFJlc3BvbnNlIgASWgoJVXBkYXRlQXBwEiQucGxhdGZvcm0uc2VydmVyLnYxLlVwZGF0ZUFwcFJlcXVlc3QaJS5wbGF0Zm9ybS5zZXJ2ZXIudjEuVXBkYXRlQXBwUmVzcG9uc2UiABJaCglEZWxldGVBcHASJC5wbGF0Zm9ybS5zZXJ2ZXIudjEuRGVsZXRlQXBwUmVxdWVzdBolLnBsYXRmb3JtLnNlcnZlci52MS5EZWxldGVBcHBSZXNwb25zZSIAEmwKD0FwcHNIZWFsdGhDaGVjaxIqLnBsYXRmb3JtLnNlcnZlci52MS5BcHBzSGVhbHRoQ2hlY2tSZXF1ZXN0GisucGxhdGZvcm0uc2VydmVyLnYxLkFwcHNIZWFsdGhDaGVja1Jlc3BvbnNlIgASaQoOR2V0QXBwc0luU3RvcmUSKS5wbGF0Zm9ybS5zZXJ2ZXIudjEuR2V0QXBwc0luU3RvcmVSZXF1ZXN0GioucGxhdGZvcm0uc2VydmVyLnYxLkdldEFwcHNJblN0b3JlUmVzcG9uc2UiABJmCg1HZXRBcHBTdG9yYWdlEigucGxhdGZvcm0uc2VydmVyLnYxLkdldEFwcFN0b3JhZ2VSZXF1ZXN0GikucGxhdGZvcm0uc2VydmVyLnYxLkdldEFwcFN0b3JhZ2VSZXNwb25zZSIAEmMKDFNodXRkb3duSG9zdBInLnBsYXRmb3JtLnNlcnZlci52MS5TaHV0ZG93bkhvc3RSZXF1ZXN0GigucGxhdGZvcm0uc2VydmVyLnYxLlNodXRkb3duSG9zdFJlc3BvbnNlIgASYAoLUmVzdGFydEhvc3QSJi5wbGF0Zm9ybS5zZXJ2ZXIudjEuUmVzdGFydEhvc3RSZXF1ZXN0GicucGxhdGZvcm0uc2VydmVyLnYxLlJlc3RhcnRIb3N0UmVzcG9uc2UiABJpCg5HZXRTeXN0ZW1TdGF0cxIpLnBsYXRmb3JtLnNlcnZlci52MS5HZXRTeXN0ZW1TdGF0c1JlcXVlc3QaKi5wbGF0Zm9ybS5zZXJ2ZXIudjEuR2V0U3lzdGVtU3RhdHNSZXNwb25zZSIAEnsKFEdldENvbXBvbmVudFZlcnNpb25zEi8ucGxhdGZvcm0uc2VydmVyLnYxLkdldENvbXBvbmVudFZlcnNpb25zUmVxdWVzdBowLnBsYXRmb3JtLnNlcnZlci52MS5HZXRDb21wb25lbnRWZXJzaW9uc1Jlc3BvbnNlIgASZgoNR2V0U3lzdGVtTG9ncxIoLnBsYXRmb3JtLnNlcnZlci52MS5HZXRTeXN0ZW1Mb2dzUmVxdWVzdBopLnBsYXRmb3JtLnNlcnZlci52MS5HZXRTeXN0ZW1Mb2dzUmVzcG9uc2UiABJyChFHZXREZXZpY2VTZXR0aW5ncxIsLnBsYXRmb3JtLnNlcnZlci52MS5HZXREZXZpY2VTZXR0aW5nc1JlcXVlc3QaLS5wbGF0Zm9ybS5zZXJ2ZXIudjEuR2V0RGV2aWNlU2V0dGluZ3NSZXNwb25zZSIAEnIKEVNldERldmljZVNldHRpbmdzEiwucGxhdGZvcm0uc2VydmVyLnYxLlNldERldmljZVNldHRpbmdzUmVxdWVzdBotLnBsYXRmb3JtLnNlcnZlci52MS5TZXREZXZpY2VTZXR0aW5nc1Jlc3BvbnNlIgASgQEKFkVuYWJsZVNlY3VyZVR1bm5lbGxpbmcSMS5wbGF0Zm9ybS5zZXJ2ZXIudjEuRW5hYmxlU2VjdXJlVHVubmVsbGluZ1JlcXVlc3QaMi5wbGF0Zm9ybS5zZXJ2ZXIudjEuRW5hYmxlU2VjdXJlVHVubmVsbGluZ1Jlc3BvbnNlIgAShAEKF0Rpc2FibGVTZWN1cmVUdW5uZWxsaW5nEjIucGxhdGZvcm0uc2VydmVyLnYxLkRpc2FibGVTZWN1cmVUdW5uZWxsaW5nUmVxdWVzdBozLnBsYXRmb3JtLnNlcnZlci52MS5EaXNhYmxlU2VjdXJlVHVubmVsbGluZ1Jlc3BvbnNlIgAScgoRUmVnaXN0ZXJUb0xvY2F0b3ISLC5wbGF0Zm9ybS5zZXJ2ZXIudjEuUmVnaXN0ZXJUb0xvY2F0b3JSZXF1ZXN0Gi0ucGxhdGZvcm0uc2VydmVyLnYxLlJlZ2lzdGVyVG9Mb2NhdG9yUmVzcG9uc2UiABJ+ChVEZXJlZ2lzdGVyRnJvbUxvY2F0b3ISMC5wbGF0Zm9ybS5zZXJ2ZXIudjEuRGVyZWdpc3RlckZyb21Mb2NhdG9yUmVxdWVzdBoxLnBsYXRmb3JtLnNlcnZlci52MS5EZXJlZ2lzdGVyRnJvbUxvY2F0b3JSZXNwb25zZSIAEmMKDFJlZ2lzdGVyUGVlchInLnBsYXRmb3JtLnNlcnZlci52MS5SZWdpc3RlclBlZXJSZXF1ZXN0GigucGxhdGZvcm0uc2VydmVyLnYxLlJlZ2lzdGVyUGVlclJlc3BvbnNlIgASaQoORGVyZWdpc3RlclBlZXISKS5wbGF0Zm9ybS5zZXJ2ZXIudjEuRGVyZWdpc3RlclBlZXJSZXF1ZXN0GioucGxhdGZvcm0uc2VydmVyLnYxLkRlcmVnaXN0ZXJQZWVyUmVzcG9uc2UiAEI2WjRnaXRodWIuY29tL2hvbWUtY2xvdWQtaW8vY29yZS9hcGkvcGxhdGZvcm0vc2VydmVyL3YxYgZwcm90bzM", [file_platform_daemon_v1_system]);
+  fileDesc("ChxwbGF0Zm9ybS9zZXJ2ZXIvdjEvd2ViLnByb3RvEhJwbGF0Zm9ybS5zZXJ2ZXIudjEiFQoTU2h1dGRvd25Ib3N0UmVxdWVzdCIWChRTaHV0ZG93bkhvc3RSZXNwb25zZSIUChJSZXN0YXJ0SG9zdFJlcXVlc3QiFQoTUmVzdGFydEhvc3RSZXNwb25zZSJiChFJbnN0YWxsQXBwUmVxdWVzdBINCgVjaGFydBgBIAEoCRIMCgRyZXBvGAIgASgJEg8KB3JlbGVhc2UYAyABKAkSDgoGdmFsdWVzGAQgASgJEg8KB3ZlcnNpb24YBSABKAkiFAoSSW5zdGFsbEFwcFJlc3BvbnNlImEKEFVwZGF0ZUFwcFJlcXVlc3QSDQoFY2hhcnQYASABKAkSDAoEcmVwbxgCIAEoCRIPCgdyZWxlYXNlGAMgASgJEg4KBnZhbHVlcxgEIAEoCRIPCgd2ZXJzaW9uGAUgASgJIhMKEVVwZGF0ZUFwcFJlc3BvbnNlIiMKEERlbGV0ZUFwcFJlcXVlc3QSDwoHcmVsZWFzZRgBIAEoCSITChFEZWxldGVBcHBSZXNwb25zZSJMCgxJbWFnZVZlcnNpb24SDQoFaW1hZ2UYASABKAkSDwoHY3VycmVudBgCIAEoCRIOCgZsYXRlc3QYAyABKAkSDAoEbmFtZRgEIAEoCSIYChZBcHBzSGVhbHRoQ2hlY2tSZXF1ZXN0IkgKF0FwcHNIZWFsdGhDaGVja1Jlc3BvbnNlEi0KBmNoZWNrcxgBIAMoCzIdLnBsYXRmb3JtLnNlcnZlci52MS5BcHBIZWFsdGgilwEKCUFwcEhlYWx0aBIMCgRuYW1lGAEgASgJEi0KBnN0YXR1cxgCIAEoDjIdLnBsYXRmb3JtLnNlcnZlci52MS5BcHBTdGF0dXMSLwoHZGlzcGxheRgDIAEoCzIeLnBsYXRmb3JtLnNlcnZlci52MS5BcHBEaXNwbGF5Eg0KBXBoYXNlGAQgASgJEg0KBWVycm9yGAUgASgJIkEKCkFwcERpc3BsYXkSDAoEbmFtZRgBIAEoCRIQCghpY29uX3VybBgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCSIXChVHZXRTeXN0ZW1TdGF0c1JlcXVlc3QiSAoWR2V0U3lzdGVtU3RhdHNSZXNwb25zZRIuCgVzdGF0cxgBIAEoCzIfLnBsYXRmb3JtLmRhZW1vbi52MS5TeXN0ZW1TdGF0cyIXChVHZXRBcHBzSW5TdG9yZVJlcXVlc3QiPwoWR2V0QXBwc0luU3RvcmVSZXNwb25zZRIlCgRhcHBzGAEgAygLMhcucGxhdGZvcm0uc2VydmVyLnYxLkFwcCIaChhHZXREZXZpY2VTZXR0aW5nc1JlcXVlc3QiUQoZR2V0RGV2aWNlU2V0dGluZ3NSZXNwb25zZRI0CghzZXR0aW5ncxgBIAEoCzIiLnBsYXRmb3JtLnNlcnZlci52MS5EZXZpY2VTZXR0aW5ncyJQChhTZXREZXZpY2VTZXR0aW5nc1JlcXVlc3QSNAoIc2V0dGluZ3MYASABKAsyIi5wbGF0Zm9ybS5zZXJ2ZXIudjEuRGV2aWNlU2V0dGluZ3MiGwoZU2V0RGV2aWNlU2V0dGluZ3NSZXNwb25zZSIZChdHZXRDQUNlcnRpZmljYXRlUmVxdWVzdCIvChhHZXRDQUNlcnRpZmljYXRlUmVzcG9uc2USEwoLY2VydGlmaWNhdGUYASABKAkiFQoTTGlzdEFjY291bnRzUmVxdWVzdCJFChRMaXN0QWNjb3VudHNSZXNwb25zZRItCghhY2NvdW50cxgBIAMoCzIbLnBsYXRmb3JtLnNlcnZlci52MS5BY2NvdW50InAKElNhdmVBY2NvdW50UmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCRINCgVlbWFpbBgCIAEoCRIQCghwYXNzd29yZBgDIAEoCRIYChBjdXJyZW50X3Bhc3N3b3JkGAQgASgJEg0KBWFkbWluGAUgASgIIhUKE1NhdmVBY2NvdW50UmVzcG9uc2UiQgoURGVsZXRlQWNjb3VudFJlcXVlc3QSEAoIdXNlcm5hbWUYASABKAkSGAoQY3VycmVudF9wYXNzd29yZBgCIAEoCSIXChVEZWxldGVBY2NvdW50UmVzcG9uc2UiFgoUR2V0QXBwU3RvcmFnZVJlcXVlc3QiRQoVR2V0QXBwU3RvcmFnZVJlc3BvbnNlEiwKBGFwcHMYASADKAsyHi5wbGF0Zm9ybS5zZXJ2ZXIudjEuQXBwU3RvcmFnZSIvCgpBcHBTdG9yYWdlEhAKCGFwcF9uYW1lGAEgASgJEg8KB3ZvbHVtZXMYAiADKAkiKQoVTGlzdEFwcEJhY2t1cHNSZXF1ZXN0EhAKCGFwcF9uYW1lGAEgASgJIkgKFkxpc3RBcHBCYWNrdXBzUmVzcG9uc2USLgoHYmFja3VwcxgBIAMoCzIdLnBsYXRmb3JtLnNlcnZlci52MS5BcHBCYWNrdXAibAoJQXBwQmFja3VwEgwKBG5hbWUYASABKAkSEAoIYXBwX25hbWUYAiABKAkSDQoFcGhhc2UYAyABKAkSDwoHY3JlYXRlZBgEIAEoCRIQCghsb2NhdGlvbhgFIAEoCRINCgVlcnJvchgGIAEoCSI1ChFSZXN0b3JlQXBwUmVxdWVzdBIQCghhcHBfbmFtZRgBIAEoCRIOCgZiYWNrdXAYAiABKAkiJQoSUmVzdG9yZUFwcFJlc3BvbnNlEg8KB3Jlc3RvcmUYASABKAkiaAoXUHJldmlld0FwcENoYW5nZVJlcXVlc3QSDQoFY2hhcnQYASABKAkSDAoEcmVwbxgCIAEoCRIPCgdyZWxlYXNlGAMgASgJEg4KBnZhbHVlcxgEIAEoCRIPCgd2ZXJzaW9uGAUgASgJIrUBChhQcmV2aWV3QXBwQ2hhbmdlUmVzcG9uc2USGQoRaW5zdGFsbGVkX3ZlcnNpb24YASABKAkSPAoQbWFuaWZlc3RfY2hhbmdlcxgCIAMoCzIiLnBsYXRmb3JtLnNlcnZlci52MS5NYW5pZmVzdENoYW5nZRJAChJkZXBlbmRlbmN5X2NoYW5nZXMYAyADKAsyJC5wbGF0Zm9ybS5zZXJ2ZXIudjEuRGVwZW5kZW5jeUNoYW5nZSJ7Cg5NYW5pZmVzdENoYW5nZRIMCgRraW5kGAEgASgJEhEKCW5hbWVzcGFjZRgCIAEoCRIMCgRuYW1lGAMgASgJEiwKBHR5cGUYBCABKA4yHi5wbGF0Zm9ybS5zZXJ2ZXIudjEuQ2hhbmdlVHlwZRIMCgRkaWZmGAUgASgJInEKEERlcGVuZGVuY3lDaGFuZ2USDAoEa2luZBgBIAEoCRIMCgRuYW1lGAIgASgJEiwKBHR5cGUYAyABKA4yHi5wbGF0Zm9ybS5zZXJ2ZXIudjEuQ2hhbmdlVHlwZRITCgtkZXNjcmlwdGlvbhgEIAEoCSIfCh1FbmFibGVTZWN1cmVUdW5uZWxsaW5nUmVxdWVzdCIgCh5FbmFibGVTZWN1cmVUdW5uZWxsaW5nUmVzcG9uc2UiIAoeRGlzYWJsZVNlY3VyZVR1bm5lbGxpbmdSZXF1ZXN0IiEKH0Rpc2FibGVTZWN1cmVUdW5uZWxsaW5nUmVzcG9uc2UiUAoYUmVnaXN0ZXJUb0xvY2F0b3JSZXF1ZXN0EhcKD2xvY2F0b3JfYWRkcmVzcxgBIAEoCRIbChN3aXJlZ3VhcmRfaW50ZXJmYWNlGAIgASgJIhsKGVJlZ2lzdGVyVG9Mb2NhdG9yUmVzcG9uc2UiVAocRGVyZWdpc3RlckZyb21Mb2NhdG9yUmVxdWVzdBIXCg9sb2NhdG9yX2FkZHJlc3MYASABKAkSGwoTd2lyZWd1YXJkX2ludGVyZmFjZRgCIAEoCSIfCh1EZXJlZ2lzdGVyRnJvbUxvY2F0b3JSZXNwb25zZSIdChtHZXRDb21wb25lbnRWZXJzaW9uc1JlcXVlc3QijAEKHEdldENvbXBvbmVudFZlcnNpb25zUmVzcG9uc2USNgoIcGxhdGZvcm0YASADKAsyJC5wbGF0Zm9ybS5kYWVtb24udjEuQ29tcG9uZW50VmVyc2lvbhI0CgZzeXN0ZW0YAiADKAsyJC5wbGF0Zm9ybS5kYWVtb24udjEuQ29tcG9uZW50VmVyc2lvbiItChRHZXRTeXN0ZW1Mb2dzUmVxdWVzdBIVCg1zaW5jZV9zZWNvbmRzGAEgASgNInQKFUdldFN5c3RlbUxvZ3NSZXNwb25zZRIlCgRsb2dzGAEgAygLMhcucGxhdGZvcm0uZGFlbW9uLnYxLkxvZxIPCgdzb3VyY2VzGAIgAygJEhIKCm5hbWVzcGFjZXMYAyADKAkSDwoHZG9tYWlucxgEIAMoCSItCgRBcHBzEiUKBGFwcHMYASADKAsyFy5wbGF0Zm9ybS5zZXJ2ZXIudjEuQXBwIocDCgNBcHASDAoEbmFtZRgBIAEoCRIPCgd2ZXJzaW9uGAIgASgJEhMKC2FwcF92ZXJzaW9uGAMgASgJEhMKC2Rlc2NyaXB0aW9uGAQgASgJEgwKBGljb24YBSABKAkSDwoHY3JlYXRlZBgGIAEoCRIOCgZkaWdlc3QYByABKAkSDAoEdHlwZRgIIAEoCRIMCgR1cmxzGAkgAygJEjcKDGRlcGVuZGVuY2llcxgKIAMoCzIhLnBsYXRmb3JtLnNlcnZlci52MS5BcHBEZXBlbmRlbmN5EgwKBGhvbWUYCyABKAkSDwoHc291cmNlcxgMIAMoCRI9Cgthbm5vdGF0aW9ucxgNIAMoCzIoLnBsYXRmb3JtLnNlcnZlci52MS5BcHAuQW5ub3RhdGlvbnNFbnRyeRIOCgZyZWFkbWUYDiABKAkSEQoJaW5zdGFsbGVkGA8gASgIGjIKEEFubm90YXRpb25zRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJCCg1BcHBEZXBlbmRlbmN5EgwKBG5hbWUYASABKAkSDwoHdmVyc2lvbhgCIAEoCRISCgpyZXBvc2l0b3J5GAMgASgJImAKEEFwcFJ1bm5pbmdTdGF0dXMSDAoEbmFtZRgBIAEoCRIPCgd2ZXJzaW9uGAIgASgJEi0KBnN0YXR1cxgDIAEoDjIdLnBsYXRmb3JtLnNlcnZlci52MS5BcHBTdGF0dXMiMAoHRW50cmllcxIlCgRhcHBzGAEgAygLMhcucGxhdGZvcm0uc2VydmVyLnYxLkFwcCLzAQoNU3lzdGVtVmVyc2lvbhIPCgd2ZXJzaW9uGAEgASgJEi8KBWlzdGlvGAIgASgLMiAucGxhdGZvcm0uc2VydmVyLnYxLklzdGlvVmVyc2lvbhI6CgtnYXRld2F5X2FwaRgDIAEoCzIlLnBsYXRmb3JtLnNlcnZlci52MS5HYXRld2F5QVBJVmVyc2lvbhIxCgZzZXJ2ZXIYBCABKAsyIS5wbGF0Zm9ybS5zZXJ2ZXIudjEuU2VydmVyVmVyc2lvbhIxCgZkYWVtb24YBSABKAsyIS5wbGF0Zm9ybS5zZXJ2ZXIudjEuRGFlbW9uVmVyc2lvbiItCgxJc3Rpb1ZlcnNpb24SDAoEcmVwbxgBIAEoCRIPCgd2ZXJzaW9uGAIgASgJIjEKEUdhdGV3YXlBUElWZXJzaW9uEgsKA3VybBgBIAEoCRIPCgd2ZXJzaW9uGAIgASgJIisKDVNlcnZlclZlcnNpb24SDQoFaW1hZ2UYASABKAkSCwoDdGFnGAIgASgJIisKDURhZW1vblZlcnNpb24SDQoFaW1hZ2UYASABKAkSCwoDdGFnGAIgASgJIt0BCg9BcHBTdG9yZUVudHJpZXMSEwoLYXBpX3ZlcnNpb24YASABKAkSEQoJZ2VuZXJhdGVkGAIgASgJEhUKDXJhd19jaGFydF91cmwYAyABKAkSQQoHZW50cmllcxgEIAMoCzIwLnBsYXRmb3JtLnNlcnZlci52MS5BcHBTdG9yZUVudHJpZXMuRW50cmllc0VudHJ5GkgKDEVudHJpZXNFbnRyeRILCgNrZXkYASABKAkSJwoFdmFsdWUYAiABKAsyGC5wbGF0Zm9ybS5zZXJ2ZXIudjEuQXBwczoCOAEiogIKDkRldmljZVNldHRpbmdzEhgKEGF1dG9fdXBkYXRlX2FwcHMYASABKAgSGgoSYXV0b191cGRhdGVfc3lzdGVtGAIgASgIEk4KGXNlY3VyZV90dW5uZWxpbmdfc2V0dGluZ3MYAyABKAsyKy5wbGF0Zm9ybS5zZXJ2ZXIudjEuU2VjdXJlVHVubmVsaW5nU2V0dGluZ3MSEAoIaG9zdG5hbWUYBCABKAkSIQoZYXV0b191cGRhdGVfYXBwc19zY2hlZHVsZRgFIAEoCRIjChthdXRvX3VwZGF0ZV9zeXN0ZW1fc2NoZWR1bGUYBiABKAkSMAoKYXBwX3N0b3JlcxgHIAMoCzIcLnBsYXRmb3JtLnNlcnZlci52MS5BcHBTdG9yZSI5CgdBY2NvdW50EhAKCHVzZXJuYW1lGAEgASgJEg0KBWVtYWlsGAIgASgJEg0KBWFkbWluGAMgASgIInAKF1NlY3VyZVR1bm5lbGluZ1NldHRpbmdzEg8KB2VuYWJsZWQYASABKAgSRAoUd2lyZWd1YXJkX2ludGVyZmFjZXMYAiADKAsyJi5wbGF0Zm9ybS5zZXJ2ZXIudjEuV2lyZWd1YXJkSW50ZXJmYWNlIn4KEldpcmVndWFyZEludGVyZmFjZRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEgwKBHBvcnQYAyABKAUSEgoKcHVibGljX2tleRgEIAEoCRITCgtzdHVuX3NlcnZlchgFIAEoCRIXCg9sb2NhdG9yX3NlcnZlcnMYBiADKAkiLgoIQXBwU3RvcmUSCwoDdXJsGAEgASgJEhUKDXJhd19jaGFydF91cmwYAiABKAkiEgoQU3Vic2NyaWJlUmVxdWVzdCL8AQoLU2VydmVyRXZlbnQSNwoJaGVhcnRiZWF0GAEgASgLMiIucGxhdGZvcm0uc2VydmVyLnYxLkhlYXJ0YmVhdEV2ZW50SAASLwoFZXJyb3IYAiABKAsyHi5wbGF0Zm9ybS5zZXJ2ZXIudjEuRXJyb3JFdmVudEgAEj4KDWFwcF9pbnN0YWxsZWQYAyABKAsyJS5wbGF0Zm9ybS5zZXJ2ZXIudjEuQXBwSW5zdGFsbGVkRXZlbnRIABI6CgthcHBfcmVzdG9yZRgEIAEoCzIjLnBsYXRmb3JtLnNlcnZlci52MS5BcHBSZXN0b3JlRXZlbnRIAEIHCgVldmVudCIQCg5IZWFydGJlYXRFdmVudCIbCgpFcnJvckV2ZW50Eg0KBWVycm9yGAEgASgJIiEKEUFwcEluc3RhbGxlZEV2ZW50EgwKBG5hbWUYASABKAkiUgoPQXBwUmVzdG9yZUV2ZW50EhAKCGFwcF9uYW1lGAEgASgJEg8KB3Jlc3RvcmUYAiABKAkSDQoFcGhhc2UYAyABKAkSDQoFZXJyb3IYBCABKAkiFQoTUmVnaXN0ZXJQZWVyUmVxdWVzdCK6AQoUUmVnaXN0ZXJQZWVyUmVzcG9uc2USCgoCaWQYASABKAkSEwoLcHJpdmF0ZV9rZXkYAiABKAkSEgoKcHVibGljX2tleRgDIAEoCRIRCglhZGRyZXNzZXMYBCADKAkSEwoLZG5zX3NlcnZlcnMYBSADKAkSGQoRc2VydmVyX3B1YmxpY19rZXkYBiABKAkSEQoJc2VydmVyX2lkGAcgASgJEhcKD2xvY2F0b3Jfc2VydmVycxgIIAMoCSIjChVEZXJlZ2lzdGVyUGVlclJlcXVlc3QSCgoCaWQYASABKAkiGAoWRGVyZWdpc3RlclBlZXJSZXNwb25zZSpZCglBcHBTdGF0dXMSGgoWQVBQX1NUQVRVU19VTlNQRUNJRklFRBAAEhYKEkFQUF9TVEFUVVNfSEVBTFRIWRABEhgKFEFQUF9TVEFUVVNfVU5IRUFMVEhZEAIqcwoKQ2hhbmdlVHlwZRIbChdDSEFOR0VfVFlQRV9VTlNQRUNJRklFRBAAEhUKEUNIQU5HRV9UWVBFX0FEREVEEAESFwoTQ0hBTkdFX1RZUEVfUkVNT1ZFRBACEhgKFENIQU5HRV9UWVBFX01PRElGSUVEEAMy5RYKCldlYlNlcnZpY2USVgoJU3Vic2NyaWJlEiQucGxhdGZvcm0uc2VydmVyLnYxLlN1YnNjcmliZVJlcXVlc3QaHy5wbGF0Zm9ybS5zZXJ2ZXIudjEuU2VydmVyRXZlbnQiADABEl0KCkluc3RhbGxBcHASJS5wbGF0Zm9ybS5zZXJ2ZXIudjEuSW5zdGFsbEFwcFJlcXVlc3QaJi5wbGF0Zm9ybS5zZXJ2ZXIudjEuSW5zdGFsbEFwcFJlc3BvbnNlIgASWgoJVXBkYXRlQXBwEiQucGxhdGZvcm0uc2VydmVyLnYxLlVwZGF0ZUFwcFJlcXVlc3QaJS5wbGF0Zm9ybS5zZXJ2ZXIudjEuVXBkYXRlQXBwUmVzcG9uc2UiABJaCglEZWxldGVBcHASJC5wbGF0Zm9ybS5zZXJ2ZXIudjEuRGVsZXRlQXBwUmVxdWVzdBolLnBsYXRmb3JtLnNlcnZlci52MS5EZWxldGVBcHBSZXNwb25zZSIAEmwKD0FwcHNIZWFsdGhDaGVjaxIqLnBsYXRmb3JtLnNlcnZlci52MS5BcHBzSGVhbHRoQ2hlY2tSZXF1ZXN0GisucGxhdGZvcm0uc2VydmVyLnYxLkFwcHNIZWFsdGhDaGVja1Jlc3BvbnNlIgASaQoOR2V0QXBwc0luU3RvcmUSKS5wbGF0Zm9ybS5zZXJ2ZXIudjEuR2V0QXBwc0luU3RvcmVSZXF1ZXN0GioucGxhdGZvcm0uc2VydmVyLnYxLkdldEFwcHNJblN0b3JlUmVzcG9uc2UiABJmCg1HZXRBcHBTdG9yYWdlEigucGxhdGZvcm0uc2VydmVyLnYxLkdldEFwcFN0b3JhZ2VSZXF1ZXN0GikucGxhdGZvcm0uc2VydmVyLnYxLkdldEFwcFN0b3JhZ2VSZXNwb25zZSIAEmkKDkxpc3RBcHBCYWNrdXBzEikucGxhdGZvcm0uc2VydmVyLnYxLkxpc3RBcHBCYWNrdXBzUmVxdWVzdBoqLnBsYXRmb3JtLnNlcnZlci52MS5MaXN0QXBwQmFja3Vwc1Jlc3BvbnNlIgASXQoKUmVzdG9yZUFwcBIlLnBsYXRmb3JtLnNlcnZlci52MS5SZXN0b3JlQXBwUmVxdWVzdBomLnBsYXRmb3JtLnNlcnZlci52MS5SZXN0b3JlQXBwUmVzcG9uc2UiABJvChBQcmV2aWV3QXBwQ2hhbmdlEisucGxhdGZvcm0uc2VydmVyLnYxLlByZXZpZXdBcHBDaGFuZ2VSZXF1ZXN0GiwucGxhdGZvcm0uc2VydmVyLnYxLlByZXZpZXdBcHBDaGFuZ2VSZXNwb25zZSIAEmMKDFNodXRkb3duSG9zdBInLnBsYXRmb3JtLnNlcnZlci52MS5TaHV0ZG93bkhvc3RSZXF1ZXN0GigucGxhdGZvcm0uc2VydmVyLnYxLlNodXRkb3duSG9zdFJlc3BvbnNlIgASYAoLUmVzdGFydEhvc3QSJi5wbGF0Zm9ybS5zZXJ2ZXIudjEuUmVzdGFydEhvc3RSZXF1ZXN0GicucGxhdGZvcm0uc2VydmVyLnYxLlJlc3RhcnRIb3N0UmVzcG9uc2UiABJpCg5HZXRTeXN0ZW1TdGF0cxIpLnBsYXRmb3JtLnNlcnZlci52MS5HZXRTeXN0ZW1TdGF0c1JlcXVlc3QaKi5wbGF0Zm9ybS5zZXJ2ZXIudjEuR2V0U3lzdGVtU3RhdHNSZXNwb25zZSIAEnsKFEdldENvbXBvbmVudFZlcnNpb25zEi8ucGxhdGZvcm0uc2VydmVyLnYxLkdldENvbXBvbmVudFZlcnNpb25zUmVxdWVzdBowLnBsYXRmb3JtLnNlcnZlci52MS5HZXRDb21wb25lbnRWZXJzaW9uc1Jlc3BvbnNlIgASZgoNR2V0U3lzdGVtTG9ncxIoLnBsYXRmb3JtLnNlcnZlci52MS5HZXRTeXN0ZW1Mb2dzUmVxdWVzdBopLnBsYXRmb3JtLnNlcnZlci52MS5HZXRTeXN0ZW1Mb2dzUmVzcG9uc2UiABJyChFHZXREZXZpY2VTZXR0aW5ncxIsLnBsYXRmb3JtLnNlcnZlci52MS5HZXREZXZpY2VTZXR0aW5nc1JlcXVlc3QaLS5wbGF0Zm9ybS5zZXJ2ZXIudjEuR2V0RGV2aWNlU2V0dGluZ3NSZXNwb25zZSIAEnIKEVNldERldmljZVNldHRpbmdzEiwucGxhdGZvcm0uc2VydmVyLnYxLlNldERldmljZVNldHRpbmdzUmVxdWVzdBotLnBsYXRmb3JtLnNlcnZlci52MS5TZXREZXZpY2VTZXR0aW5nc1Jlc3BvbnNlIgASbwoQR2V0Q0FDZXJ0aWZpY2F0ZRIrLnBsYXRmb3JtLnNlcnZlci52MS5HZXRDQUNlcnRpZmljYXRlUmVxdWVzdBosLnBsYXRmb3JtLnNlcnZlci52MS5HZXRDQUNlcnRpZmljYXRlUmVzcG9uc2UiABJjCgxMaXN0QWNjb3VudHMSJy5wbGF0Zm9ybS5zZXJ2ZXIudjEuTGlzdEFjY291bnRzUmVxdWVzdBooLnBsYXRmb3JtLnNlcnZlci52MS5MaXN0QWNjb3VudHNSZXNwb25zZSIAEmAKC1NhdmVBY2NvdW50EiYucGxhdGZvcm0uc2VydmVyLnYxLlNhdmVBY2NvdW50UmVxdWVzdBonLnBsYXRmb3JtLnNlcnZlci52MS5TYXZlQWNjb3VudFJlc3BvbnNlIgASZgoNRGVsZXRlQWNjb3VudBIoLnBsYXRmb3JtLnNlcnZlci52MS5EZWxldGVBY2NvdW50UmVxdWVzdBopLnBsYXRmb3JtLnNlcnZlci52MS5EZWxldGVBY2NvdW50UmVzcG9uc2UiABKBAQoWRW5hYmxlU2VjdXJlVHVubmVsbGluZxIxLnBsYXRmb3JtLnNlcnZlci52MS5FbmFibGVTZWN1cmVUdW5uZWxsaW5nUmVxdWVzdBoyLnBsYXRmb3JtLnNlcnZlci52MS5FbmFibGVTZWN1cmVUdW5uZWxsaW5nUmVzcG9uc2UiABKEAQoXRGlzYWJsZVNlY3VyZVR1bm5lbGxpbmcSMi5wbGF0Zm9ybS5zZXJ2ZXIudjEuRGlzYWJsZVNlY3VyZVR1bm5lbGxpbmdSZXF1ZXN0GjMucGxhdGZvcm0uc2VydmVyLnYxLkRpc2FibGVTZWN1cmVUdW5uZWxsaW5nUmVzcG9uc2UiABJyChFSZWdpc3RlclRvTG9jYXRvchIsLnBsYXRmb3JtLnNlcnZlci52MS5SZWdpc3RlclRvTG9jYXRvclJlcXVlc3QaLS5wbGF0Zm9ybS5zZXJ2ZXIudjEuUmVnaXN0ZXJUb0xvY2F0b3JSZXNwb25zZSIAEn4KFURlcmVnaXN0ZXJGcm9tTG9jYXRvchIwLnBsYXRmb3JtLnNlcnZlci52MS5EZXJlZ2lzdGVyRnJvbUxvY2F0b3JSZXF1ZXN0GjEucGxhdGZvcm0uc2VydmVyLnYxLkRlcmVnaXN0ZXJGcm9tTG9jYXRvclJlc3BvbnNlIgASYwoMUmVnaXN0ZXJQZWVyEicucGxhdGZvcm0uc2VydmVyLnYxLlJlZ2lzdGVyUGVlclJlcXVlc3QaKC5wbGF0Zm9ybS5zZXJ2ZXIudjEuUmVnaXN0ZXJQZWVyUmVzcG9uc2UiABJpCg5EZXJlZ2lzdGVyUGVlchIpLnBsYXRmb3JtLnNlcnZlci52MS5EZXJlZ2lzdGVyUGVlclJlcXVlc3QaKi5wbGF0Zm9ybS5zZXJ2ZXIudjEuRGVyZWdpc3RlclBlZXJSZXNwb25zZSIAQjZaNGdpdGh1Yi5jb20vaG9tZS1jbG91ZC1pby9jb3JlL2FwaS9wbGF0Zm9ybS9zZXJ2ZXIvdjFiBnByb3RvMw", [file_platform_daemon_v1_system]);
 
 /**
  * Describes the message platform.server.v1.ShutdownHostRequest.
@@ -172,278 +172,411 @@ export const SetDeviceSettingsRequestSchema = /*@__PURE__*/
 export const SetDeviceSettingsResponseSchema = /*@__PURE__*/
   messageDesc(file_platform_server_v1_web, 22);
 
+/**
+ * Describes the message platform.server.v1.GetCACertificateRequest.
+ * Use `create(GetCACertificateRequestSchema)` to create a new message.
+ */
+export const GetCACertificateRequestSchema = /*@__PURE__*/
+  messageDesc(file_platform_server_v1_web, 23);
+
+/**
+ * Describes the message platform.server.v1.GetCACertificateResponse.
+ * Use `create(GetCACertificateResponseSchema)` to create a new message.
+ */
+export const GetCACertificateResponseSchema = /*@__PURE__*/
+  messageDesc(file_platform_server_v1_web, 24);
+
+/**
+ * Describes the message platform.server.v1.ListAccountsRequest.
+ * Use `create(ListAccountsRequestSchema)` to create a new message.
+ */
+export const ListAccountsRequestSchema = /*@__PURE__*/
+  messageDesc(file_platform_server_v1_web, 25);
+
+/**
+ * Describes the message platform.server.v1.ListAccountsResponse.
+ * Use `create(ListAccountsResponseSchema)` to create a new message.
+ */
+export const ListAccountsResponseSchema = /*@__PURE__*/
+  messageDesc(file_platform_server_v1_web, 26);
+
+/**
+ * Describes the message platform.server.v1.SaveAccountRequest.
+ * Use `create(SaveAccountRequestSchema)` to create a new message.
+ */
+export const SaveAccountRequestSchema = /*@__PURE__*/
+  messageDesc(file_platform_server_v1_web, 27);
+
+/**
+ * Describes the message platform.server.v1.SaveAccountResponse.
+ * Use `create(SaveAccountResponseSchema)` to create a new message.
+ */
+export const SaveAccountResponseSchema = /*@__PURE__*/
+  messageDesc(file_platform_server_v1_web, 28);
+
+/**
+ * Describes the message platform.server.v1.DeleteAccountRequest.
+ * Use `create(DeleteAccountRequestSchema)` to create a new message.
+ */
+export const DeleteAccountRequestSchema = /*@__PURE__*/
+  messageDesc(file_platform_server_v1_web, 29);
+
+/**
+ * Describes the message platform.server.v1.DeleteAccountResponse.
+ * Use `create(DeleteAccountResponseSchema)` to create a new message.
+ */
+export const DeleteAccountResponseSchema = /*@__PURE__*/
+  messageDesc(file_platform_server_v1_web, 30);
+
 /**
  * Describes the message platform.server.v1.GetAppStorageRequest.
  * Use `create(GetAppStorageRequestSchema)` to create a new message.
  */
 export const GetAppStorageRequestSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 23);
+  messageDesc(file_platform_server_v1_web, 31);
 
 /**
  * Describes the message platform.server.v1.GetAppStorageResponse.
  * Use `create(GetAppStorageResponseSchema)` to create a new message.
  */
 export const GetAppStorageResponseSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 24);
+  messageDesc(file_platform_server_v1_web, 32);
 
 /**
  * Describes the message platform.server.v1.AppStorage.
  * Use `create(AppStorageSchema)` to create a new message.
  */
 export const AppStorageSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 25);
+  messageDesc(file_platform_server_v1_web, 33);
+
+/**
+ * Describes the message platform.server.v1.ListAppBackupsRequest.
+ * Use `create(ListAppBackupsRequestSchema)` to create a new message.
+ */
+export const ListAppBackupsRequestSchema = /*@__PURE__*/
+  messageDesc(file_platform_server_v1_web, 34);
+
+/**
+ * Describes the message platform.server.v1.ListAppBackupsResponse.
+ * Use `create(ListAppBackupsResponseSchema)` to create a new message.
+ */
+export const ListAppBackupsResponseSchema = /*@__PURE__*/
+  messageDesc(file_platform_server_v1_web, 35);
+
+/**
+ * Describes the message platform.server.v1.AppBackup.
+ * Use `create(AppBackupSchema)` to create a new message.
+ */
+export const AppBackupSchema = /*@__PURE__*/
+  messageDesc(file_platform_server_v1_web, 36);
+
+/**
+ * Describes the message platform.server.v1.RestoreAppRequest.
+ * Use `create(RestoreAppRequestSchema)` to create a new message.
+ */
+export const RestoreAppRequestSchema = /*@__PURE__*/
+  messageDesc(file_platform_server_v1_web, 37);
+
+/**
+ * Describes the message platform.server.v1.RestoreAppResponse.
+ * Use `create(RestoreAppResponseSchema)` to create a new message.
+ */
+export const RestoreAppResponseSchema = /*@__PURE__*/
+  messageDesc(file_platform_server_v1_web, 38);
+
+/**
+ * Describes the message platform.server.v1.PreviewAppChangeRequest.
+ * Use `create(PreviewAppChangeRequestSchema)` to create a new message.
+ */
+export const PreviewAppChangeRequestSchema = /*@__PURE__*/
+  messageDesc(file_platform_server_v1_web, 39);
+
+/**
+ * Describes the message platform.server.v1.PreviewAppChangeResponse.
+ * Use `create(PreviewAppChangeResponseSchema)` to create a new message.
+ */
+export const PreviewAppChangeResponseSchema = /*@__PURE__*/
+  messageDesc(file_platform_server_v1_web, 40);
+
+/**
+ * Describes the message platform.server.v1.ManifestChange.
+ * Use `create(ManifestChangeSchema)` to create a new message.
+ */
+export const ManifestChangeSchema = /*@__PURE__*/
+  messageDesc(file_platform_server_v1_web, 41);
+
+/**
+ * Describes the message platform.server.v1.DependencyChange.
+ * Use `create(DependencyChangeSchema)` to create a new message.
+ */
+export const DependencyChangeSchema = /*@__PURE__*/
+  messageDesc(file_platform_server_v1_web, 42);
 
 /**
  * Describes the message platform.server.v1.EnableSecureTunnellingRequest.
  * Use `create(EnableSecureTunnellingRequestSchema)` to create a new message.
  */
 export const EnableSecureTunnellingRequestSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 26);
+  messageDesc(file_platform_server_v1_web, 43);
 
 /**
  * Describes the message platform.server.v1.EnableSecureTunnellingResponse.
  * Use `create(EnableSecureTunnellingResponseSchema)` to create a new message.
  */
 export const EnableSecureTunnellingResponseSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 27);
+  messageDesc(file_platform_server_v1_web, 44);
 
 /**
  * Describes the message platform.server.v1.DisableSecureTunnellingRequest.
  * Use `create(DisableSecureTunnellingRequestSchema)` to create a new message.
  */
 export const DisableSecureTunnellingRequestSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 28);
+  messageDesc(file_platform_server_v1_web, 45);
 
 /**
  * Describes the message platform.server.v1.DisableSecureTunnellingResponse.
  * Use `create(DisableSecureTunnellingResponseSchema)` to create a new message.
  */
 export const DisableSecureTunnellingResponseSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 29);
+  messageDesc(file_platform_server_v1_web, 46);
 
 /**
  * Describes the message platform.server.v1.RegisterToLocatorRequest.
  * Use `create(RegisterToLocatorRequestSchema)` to create a new message.
  */
 export const RegisterToLocatorRequestSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 30);
+  messageDesc(file_platform_server_v1_web, 47);
 
 /**
  * Describes the message platform.server.v1.RegisterToLocatorResponse.
  * Use `create(RegisterToLocatorResponseSchema)` to create a new message.
  */
 export const RegisterToLocatorResponseSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 31);
+  messageDesc(file_platform_server_v1_web, 48);
 
 /**
  * Describes the message platform.server.v1.DeregisterFromLocatorRequest.
  * Use `create(DeregisterFromLocatorRequestSchema)` to create a new message.
  */
 export const DeregisterFromLocatorRequestSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 32);
+  messageDesc(file_platform_server_v1_web, 49);
 
 /**
  * Describes the message platform.server.v1.DeregisterFromLocatorResponse.
  * Use `create(DeregisterFromLocatorResponseSchema)` to create a new message.
  */
 export const DeregisterFromLocatorResponseSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 33);
+  messageDesc(file_platform_server_v1_web, 50);
 
 /**
  * Describes the message platform.server.v1.GetComponentVersionsRequest.
  * Use `create(GetComponentVersionsRequestSchema)` to create a new message.
  */
 export const GetComponentVersionsRequestSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 34);
+  messageDesc(file_platform_server_v1_web, 51);
 
 /**
  * Describes the message platform.server.v1.GetComponentVersionsResponse.
  * Use `create(GetComponentVersionsResponseSchema)` to create a new message.
  */
 export const GetComponentVersionsResponseSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 35);
+  messageDesc(file_platform_server_v1_web, 52);
 
 /**
  * Describes the message platform.server.v1.GetSystemLogsRequest.
  * Use `create(GetSystemLogsRequestSchema)` to create a new message.
  */
 export const GetSystemLogsRequestSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 36);
+  messageDesc(file_platform_server_v1_web, 53);
 
 /**
  * Describes the message platform.server.v1.GetSystemLogsResponse.
  * Use `create(GetSystemLogsResponseSchema)` to create a new message.
  */
 export const GetSystemLogsResponseSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 37);
+  messageDesc(file_platform_server_v1_web, 54);
 
 /**
  * Describes the message platform.server.v1.Apps.
  * Use `create(AppsSchema)` to create a new message.
  */
 export const AppsSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 38);
+  messageDesc(file_platform_server_v1_web, 55);
 
 /**
  * Describes the message platform.server.v1.App.
  * Use `create(AppSchema)` to create a new message.
  */
 export const AppSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 39);
+  messageDesc(file_platform_server_v1_web, 56);
 
 /**
  * Describes the message platform.server.v1.AppDependency.
  * Use `create(AppDependencySchema)` to create a new message.
  */
 export const AppDependencySchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 40);
+  messageDesc(file_platform_server_v1_web, 57);
 
 /**
  * Describes the message platform.server.v1.AppRunningStatus.
  * Use `create(AppRunningStatusSchema)` to create a new message.
  */
 export const AppRunningStatusSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 41);
+  messageDesc(file_platform_server_v1_web, 58);
 
 /**
  * Describes the message platform.server.v1.Entries.
  * Use `create(EntriesSchema)` to create a new message.
  */
 export const EntriesSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 42);
+  messageDesc(file_platform_server_v1_web, 59);
 
 /**
  * Describes the message platform.server.v1.SystemVersion.
  * Use `create(SystemVersionSchema)` to create a new message.
  */
 export const SystemVersionSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 43);
+  messageDesc(file_platform_server_v1_web, 60);
 
 /**
  * Describes the message platform.server.v1.IstioVersion.
  * Use `create(IstioVersionSchema)` to create a new message.
  */
 export const IstioVersionSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 44);
+  messageDesc(file_platform_server_v1_web, 61);
 
 /**
  * Describes the message platform.server.v1.GatewayAPIVersion.
  * Use `create(GatewayAPIVersionSchema)` to create a new message.
  */
 export const GatewayAPIVersionSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 45);
+  messageDesc(file_platform_server_v1_web, 62);
 
 /**
  * Describes the message platform.server.v1.ServerVersion.
  * Use `create(ServerVersionSchema)` to create a new message.
  */
 export const ServerVersionSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 46);
+  messageDesc(file_platform_server_v1_web, 63);
 
 /**
  * Describes the message platform.server.v1.DaemonVersion.
  * Use `create(DaemonVersionSchema)` to create a new message.
  */
 export const DaemonVersionSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 47);
+  messageDesc(file_platform_server_v1_web, 64);
 
 /**
  * Describes the message platform.server.v1.AppStoreEntries.
  * Use `create(AppStoreEntriesSchema)` to create a new message.
  */
 export const AppStoreEntriesSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 48);
+  messageDesc(file_platform_server_v1_web, 65);
 
 /**
  * Describes the message platform.server.v1.DeviceSettings.
  * Use `create(DeviceSettingsSchema)` to create a new message.
  */
 export const DeviceSettingsSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 49);
+  messageDesc(file_platform_server_v1_web, 66);
+
+/**
+ * Describes the message platform.server.v1.Account.
+ * Use `create(AccountSchema)` to create a new message.
+ */
+export const AccountSchema = /*@__PURE__*/
+  messageDesc(file_platform_server_v1_web, 67);
 
 /**
  * Describes the message platform.server.v1.SecureTunnelingSettings.
  * Use `create(SecureTunnelingSettingsSchema)` to create a new message.
  */
 export const SecureTunnelingSettingsSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 50);
+  messageDesc(file_platform_server_v1_web, 68);
 
 /**
  * Describes the message platform.server.v1.WireguardInterface.
  * Use `create(WireguardInterfaceSchema)` to create a new message.
  */
 export const WireguardInterfaceSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 51);
+  messageDesc(file_platform_server_v1_web, 69);
 
 /**
  * Describes the message platform.server.v1.AppStore.
  * Use `create(AppStoreSchema)` to create a new message.
  */
 export const AppStoreSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 52);
+  messageDesc(file_platform_server_v1_web, 70);
 
 /**
  * Describes the message platform.server.v1.SubscribeRequest.
  * Use `create(SubscribeRequestSchema)` to create a new message.
  */
 export const SubscribeRequestSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 53);
+  messageDesc(file_platform_server_v1_web, 71);
 
 /**
  * Describes the message platform.server.v1.ServerEvent.
  * Use `create(ServerEventSchema)` to create a new message.
  */
 export const ServerEventSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 54);
+  messageDesc(file_platform_server_v1_web, 72);
 
 /**
  * Describes the message platform.server.v1.HeartbeatEvent.
  * Use `create(HeartbeatEventSchema)` to create a new message.
  */
 export const HeartbeatEventSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 55);
+  messageDesc(file_platform_server_v1_web, 73);
 
 /**
  * Describes the message platform.server.v1.ErrorEvent.
  * Use `create(ErrorEventSchema)` to create a new message.
  */
 export const ErrorEventSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 56);
+  messageDesc(file_platform_server_v1_web, 74);
 
 /**
  * Describes the message platform.server.v1.AppInstalledEvent.
  * Use `create(AppInstalledEventSchema)` to create a new message.
  */
 export const AppInstalledEventSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 57);
+  messageDesc(file_platform_server_v1_web, 75);
+
+/**
+ * Describes the message platform.server.v1.AppRestoreEvent.
+ * Use `create(AppRestoreEventSchema)` to create a new message.
+ */
+export const AppRestoreEventSchema = /*@__PURE__*/
+  messageDesc(file_platform_server_v1_web, 76);
 
 /**
  * Describes the message platform.server.v1.RegisterPeerRequest.
  * Use `create(RegisterPeerRequestSchema)` to create a new message.
  */
 export const RegisterPeerRequestSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 58);
+  messageDesc(file_platform_server_v1_web, 77);
 
 /**
  * Describes the message platform.server.v1.RegisterPeerResponse.
  * Use `create(RegisterPeerResponseSchema)` to create a new message.
  */
 export const RegisterPeerResponseSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 59);
+  messageDesc(file_platform_server_v1_web, 78);
 
 /**
  * Describes the message platform.server.v1.DeregisterPeerRequest.
  * Use `create(DeregisterPeerRequestSchema)` to create a new message.
  */
 export const DeregisterPeerRequestSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 60);
+  messageDesc(file_platform_server_v1_web, 79);
 
 /**
  * Describes the message platform.server.v1.DeregisterPeerResponse.
  * Use `create(DeregisterPeerResponseSchema)` to create a new message.
  */
 export const DeregisterPeerResponseSchema = /*@__PURE__*/
-  messageDesc(file_platform_server_v1_web, 61);
+  messageDesc(file_platform_server_v1_web, 80);
 
 /**
  * Describes the enum platform.server.v1.AppStatus.
@@ -457,6 +590,18 @@ export const AppStatusSchema = /*@__PURE__*/
 export const AppStatus = /*@__PURE__*/
   tsEnum(AppStatusSchema);
 
+/**
+ * Describes the enum platform.server.v1.ChangeType.
+ */
+export const ChangeTypeSchema = /*@__PURE__*/
+  enumDesc(file_platform_server_v1_web, 1);
+
+/**
+ * @generated from enum platform.server.v1.ChangeType
+ */
+export const ChangeType = /*@__PURE__*/
+  tsEnum(ChangeTypeSchema);
+
 /**
  * @generated from service platform.server.v1.WebService
  */
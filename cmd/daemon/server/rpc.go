@@ -20,6 +20,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/utils/ptr"
 
 	v1 "github.com/home-cloud-io/core/api/platform/daemon/v1"
 	sdConnect "github.com/home-cloud-io/core/api/platform/daemon/v1/v1connect"
@@ -207,35 +208,9 @@ func (h *rpcHandler) UpgradeKubernetes(ctx context.Context, request *connect.Req
 func (h *rpcHandler) CreateVolume(ctx context.Context, request *connect.Request[v1.CreateVolumeRequest]) (*connect.Response[v1.CreateVolumeResponse], error) {
 	h.logger.Info("creating volume")
 
-	var minSize block.ByteSize
-	err := minSize.UnmarshalText([]byte(request.Msg.MinSize))
-	if err != nil {
-		h.logger.WithError(err).Warn("invalid min_size")
-		return nil, status.Error(codes.InvalidArgument, "invalid min_size")
-	}
-
-	var maxSize block.Size
-	err = maxSize.UnmarshalText([]byte(request.Msg.MaxSize))
-	if err != nil {
-		h.logger.WithError(err).Warn("invalid max_size")
-		return nil, status.Error(codes.InvalidArgument, "invalid max_size")
-	}
-
-	uvc := block.NewUserVolumeConfigV1Alpha1()
-	uvc.MetaName = request.Msg.Name
-	uvc.ProvisioningSpec = block.ProvisioningSpec{
-		DiskSelectorSpec: block.DiskSelector{
-			// TODO: will probably want to expose this expression on the API
-			Match: cel.MustExpression(cel.ParseBooleanExpression("!system_disk", celenv.DiskLocator())),
-		},
-		ProvisioningMinSize: minSize,
-		ProvisioningMaxSize: maxSize,
-	}
-
-	_, err = uvc.Validate(talos.ValidationMode{})
+	uvc, err := h.userVolumeConfig(request.Msg.Name, request.Msg.MinSize, request.Msg.MaxSize)
 	if err != nil {
-		h.logger.WithError(err).Warn("failed UserVolumeConfig validation")
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
 	id, err := talos.CreateUserVolume(ctx, h.logger, uvc)
@@ -251,8 +226,48 @@ func (h *rpcHandler) CreateVolume(ctx context.Context, request *connect.Request[
 }
 
 func (h *rpcHandler) DeleteVolume(ctx context.Context, request *connect.Request[v1.DeleteVolumeRequest]) (*connect.Response[v1.DeleteVolumeResponse], error) {
-	h.logger.Error("unimplemented")
-	return nil, status.Error(codes.Unimplemented, "unimplemented")
+	h.logger.WithField("id", request.Msg.Id).Info("deleting volume")
+
+	if request.Msg.Id == "" {
+		h.logger.Warn("missing id")
+		return nil, status.Error(codes.InvalidArgument, "missing id")
+	}
+
+	err := talos.DeleteUserVolume(ctx, h.logger, request.Msg.Id)
+	if err != nil {
+		h.logger.WithError(err).Error("failed to delete volume")
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.DeleteVolumeResponse{}), nil
+}
+
+func (h *rpcHandler) ResizeVolume(ctx context.Context, request *connect.Request[v1.ResizeVolumeRequest]) (*connect.Response[v1.ResizeVolumeResponse], error) {
+	h.logger.WithFields(chassis.Fields{
+		"id":       request.Msg.Id,
+		"min_size": request.Msg.MinSize,
+		"max_size": request.Msg.MaxSize,
+	}).Info("resizing volume")
+
+	if request.Msg.Id == "" {
+		h.logger.Warn("missing id")
+		return nil, status.Error(codes.InvalidArgument, "missing id")
+	}
+
+	uvc, err := h.userVolumeConfig(talos.UserVolumeName(request.Msg.Id), request.Msg.MinSize, request.Msg.MaxSize)
+	if err != nil {
+		return nil, err
+	}
+	// let Talos grow the existing partition up to the new max size
+	uvc.ProvisioningSpec.ProvisioningGrow = ptr.To(true)
+
+	err = talos.UpdateUserVolume(ctx, h.logger, uvc)
+	if err != nil {
+		h.logger.WithError(err).Error("failed to resize volume")
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.ResizeVolumeResponse{}), nil
 }
 
 // helpers
@@ -299,3 +314,39 @@ func upgradeKubernetes(ctx context.Context, c *client.Client, toVersion string)
 
 	return k8s.Upgrade(ctx, &state, upgradeOptions)
 }
+
+// userVolumeConfig creates a validated UserVolumeConfig for a volume on a non-system disk.
+func (h *rpcHandler) userVolumeConfig(name string, min string, max string) (*block.UserVolumeConfigV1Alpha1, error) {
+	var minSize block.ByteSize
+	err := minSize.UnmarshalText([]byte(min))
+	if err != nil {
+		h.logger.WithError(err).Warn("invalid min_size")
+		return nil, status.Error(codes.InvalidArgument, "invalid min_size")
+	}
+
+	var maxSize block.Size
+	err = maxSize.UnmarshalText([]byte(max))
+	if err != nil {
+		h.logger.WithError(err).Warn("invalid max_size")
+		return nil, status.Error(codes.InvalidArgument, "invalid max_size")
+	}
+
+	uvc := block.NewUserVolumeConfigV1Alpha1()
+	uvc.MetaName = name
+	uvc.ProvisioningSpec = block.ProvisioningSpec{
+		DiskSelectorSpec: block.DiskSelector{
+			// TODO: will probably want to expose this expression on the API
+			Match: cel.MustExpression(cel.ParseBooleanExpression("!system_disk", celenv.DiskLocator())),
+		},
+		ProvisioningMinSize: minSize,
+		ProvisioningMaxSize: maxSize,
+	}
+
+	_, err = uvc.Validate(talos.ValidationMode{})
+	if err != nil {
+		h.logger.WithError(err).Warn("failed UserVolumeConfig validation")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return uvc, nil
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/steady-bytes/draft/pkg/chassis"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1 "github.com/home-cloud-io/core/api/platform/daemon/v1"
+)
+
+// testLogger discards the logs of the handler
+type testLogger struct {
+	chassis.Logger
+}
+
+func (l testLogger) WithError(err error) chassis.Logger         { return l }
+func (l testLogger) WithField(k string, v any) chassis.Logger   { return l }
+func (l testLogger) WithFields(f chassis.Fields) chassis.Logger { return l }
+func (l testLogger) Info(msg string)                            {}
+func (l testLogger) Warn(msg string)                            {}
+func (l testLogger) Error(msg string)                           {}
+
+func newTestHandler() *rpcHandler {
+	return &rpcHandler{logger: testLogger{}}
+}
+
+func TestDeleteVolumeMissingID(t *testing.T) {
+	_, err := newTestHandler().DeleteVolume(context.Background(), connect.NewRequest(&v1.DeleteVolumeRequest{}))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestResizeVolumeInvalidArgument(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *v1.ResizeVolumeRequest
+	}{
+		{
+			name:    "missing id",
+			request: &v1.ResizeVolumeRequest{MinSize: "10Gi", MaxSize: "10Gi"},
+		},
+		{
+			name:    "invalid min size",
+			request: &v1.ResizeVolumeRequest{Id: "u-immich-library", MinSize: "ten", MaxSize: "10Gi"},
+		},
+		{
+			name:    "invalid max size",
+			request: &v1.ResizeVolumeRequest{Id: "u-immich-library", MinSize: "10Gi", MaxSize: "ten"},
+		},
+		{
+			name:    "max smaller than min",
+			request: &v1.ResizeVolumeRequest{Id: "u-immich-library", MinSize: "20Gi", MaxSize: "10Gi"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newTestHandler().ResizeVolume(context.Background(), connect.NewRequest(tt.request))
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestUserVolumeConfig(t *testing.T) {
+	uvc, err := newTestHandler().userVolumeConfig("immich-library", "10Gi", "20Gi")
+	assert.NoError(t, err)
+	assert.Equal(t, "immich-library", uvc.MetaName)
+}
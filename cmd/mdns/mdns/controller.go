@@ -2,6 +2,8 @@ package mdns
 
 import (
 	"context"
+	"slices"
+	"strings"
 
 	v1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
@@ -13,6 +15,7 @@ import (
 )
 
 const (
+	// DNSAnnotation holds the (comma separated) hostnames to advertise for a Service
 	DNSAnnotation = "home-cloud.io/dns"
 )
 
@@ -21,7 +24,7 @@ type Reconciler struct {
 	Scheme *runtime.Scheme
 
 	Server   Server
-	services map[types.NamespacedName]string
+	services map[types.NamespacedName][]string
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -52,31 +55,59 @@ func (r *Reconciler) reconcile(ctx context.Context, req ctrl.Request, obj *v1.Se
 	}
 
 	// remove if not annotated
-	hostname, exists := obj.Annotations[DNSAnnotation]
+	annotation, exists := obj.Annotations[DNSAnnotation]
 	if !exists {
 		return r.remove(ctx, obj, req.NamespacedName)
 	}
+	hostnames := []string{}
+	for _, hostname := range strings.Split(annotation, ",") {
+		hostname = strings.TrimSpace(hostname)
+		if hostname != "" {
+			hostnames = append(hostnames, hostname)
+		}
+	}
+
+	// remove hosts no longer in the annotation
+	for _, current := range r.services[req.NamespacedName] {
+		if !slices.Contains(hostnames, current) {
+			err := r.Server.RemoveHost(ctx, current)
+			if err != nil {
+				return err
+			}
+		}
+	}
 
 	// track
-	r.services[req.NamespacedName] = hostname
+	r.services[req.NamespacedName] = hostnames
 
 	// add to mdns server
-	return r.Server.AddHost(ctx, hostname)
+	for _, hostname := range hostnames {
+		err := r.Server.AddHost(ctx, hostname)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// remove attempts to remove a host but simply skips if it's not being tracked
+// remove attempts to remove the hosts of a service but simply skips if it's not being tracked
 func (r *Reconciler) remove(ctx context.Context, obj *v1.Service, nn types.NamespacedName) error {
 	current, tracking := r.services[nn]
 	if tracking {
 		delete(r.services, nn)
-		return r.Server.RemoveHost(ctx, current)
+		for _, hostname := range current {
+			err := r.Server.RemoveHost(ctx, hostname)
+			if err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
-	r.services = map[types.NamespacedName]string{}
+	r.services = map[types.NamespacedName][]string{}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1.Service{}).
 		Complete(r)
@@ -0,0 +1,126 @@
+package apps
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+// AuthorizationPolicyGVK is the Istio AuthorizationPolicy which has the ingress gateway authenticate
+// requests for the hostnames of a route against the identity provider of the operator. The Istio types
+// aren't a dependency of the operator so policies are handled as unstructured objects.
+var AuthorizationPolicyGVK = schema.GroupVersionKind{
+	Group:   "security.istio.io",
+	Version: "v1",
+	Kind:    "AuthorizationPolicy",
+}
+
+// authPolicy returns the AuthorizationPolicy which requires authentication for the hostnames of the
+// route. Policies live with the gateway they apply to.
+func authPolicy(namespace string, route AppRoute, gateway gwv1.ParentReference, hostnames []string) *unstructured.Unstructured {
+	hosts := []any{}
+	for _, hostname := range hostnames {
+		// the host header includes the port on non-standard ports
+		hosts = append(hosts, hostname, hostname+":*")
+	}
+
+	policy := &unstructured.Unstructured{}
+	policy.SetGroupVersionKind(AuthorizationPolicyGVK)
+	policy.SetName(namespace + "-" + route.Name)
+	policy.SetNamespace(string(*gateway.Namespace))
+	policy.Object["spec"] = map[string]any{
+		"targetRefs": []any{
+			map[string]any{
+				"group": gwv1.GroupName,
+				"kind":  "Gateway",
+				"name":  string(gateway.Name),
+			},
+		},
+		"action": "CUSTOM",
+		"provider": map[string]any{
+			"name": v1.AuthProviderName,
+		},
+		"rules": []any{
+			map[string]any{
+				"to": []any{
+					map[string]any{
+						"operation": map[string]any{
+							"hosts": hosts,
+						},
+					},
+				},
+			},
+		},
+	}
+	return policy
+}
+
+// reconcileAuthPolicies creates or updates the given AuthorizationPolicies and deletes the ones created
+// for the App which are no longer wanted.
+func (r *AppReconciler) reconcileAuthPolicies(ctx context.Context, app *v1.App, policies []*unstructured.Unstructured) error {
+	existing := &unstructured.UnstructuredList{}
+	existing.SetGroupVersionKind(AuthorizationPolicyGVK.GroupVersion().WithKind(AuthorizationPolicyGVK.Kind + "List"))
+	err := r.Client.List(ctx, existing, withoutIsolation(app))
+	if err != nil {
+		// without Istio there are no policies to clean up
+		if meta.IsNoMatchError(err) && len(policies) == 0 {
+			return nil
+		}
+		return err
+	}
+
+	declared := map[client.ObjectKey]bool{}
+	for _, policy := range policies {
+		declared[client.ObjectKeyFromObject(policy)] = true
+		err := r.applyAuthPolicy(ctx, app, policy)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, policy := range existing.Items {
+		if declared[client.ObjectKeyFromObject(&policy)] {
+			continue
+		}
+		err := r.Client.Delete(ctx, &policy)
+		if client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyAuthPolicy creates the AuthorizationPolicy or updates its spec if it has changed.
+func (r *AppReconciler) applyAuthPolicy(ctx context.Context, app *v1.App, desired *unstructured.Unstructured) error {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(AuthorizationPolicyGVK)
+	err := r.Client.Get(ctx, client.ObjectKeyFromObject(desired), existing)
+	switch {
+	case errors.IsNotFound(err):
+		return r.create(ctx, app, desired)
+	case err != nil:
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(existing.Object["spec"], desired.Object["spec"]) {
+		return r.addLabels(ctx, existing, appLabels(app))
+	}
+	existing.Object["spec"] = desired.Object["spec"]
+	labels := existing.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	for k, v := range appLabels(app) {
+		labels[k] = v
+	}
+	existing.SetLabels(labels)
+	return r.Client.Update(ctx, existing)
+}
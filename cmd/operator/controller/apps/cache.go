@@ -0,0 +1,268 @@
+package apps
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/downloader"
+	"helm.sh/helm/v3/pkg/getter"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/repo"
+)
+
+// ChartCacheDirEnv overrides where downloaded charts are cached.
+const ChartCacheDirEnv = "CHART_CACHE_DIR"
+
+// chartCache is an on-disk, content addressed cache of chart archives. Archives are stored by their
+// SHA-256 digest (along with their provenance file if they have one) and references map a
+// repository, chart and version to a digest:
+//
+//	<dir>/blobs/<digest>.tgz
+//	<dir>/blobs/<digest>.tgz.prov
+//	<dir>/refs/<sha256 of repository, chart and version>
+type chartCache struct {
+	dir string
+}
+
+// charts caches the charts of all Apps.
+var charts = newChartCache()
+
+func newChartCache() *chartCache {
+	dir := os.Getenv(ChartCacheDirEnv)
+	if dir == "" {
+		dir = filepath.Join(os.TempDir(), "home-cloud", "charts")
+	}
+	return &chartCache{dir: dir}
+}
+
+// pull returns the path of the archive of the chart, downloading it unless it is already cached.
+// Charts from repositories are verified against the digest in the repository index and, if the source
+// has a keyring, all charts are verified against their provenance file.
+func (c *chartCache) pull(registryClient *registry.Client, source chartSource, version string) (string, error) {
+	// charts of the bundle were verified against its index
+	if source.Path != "" {
+		return source.Path, verifyProvenance(source.Path, source.Keyring)
+	}
+
+	if version != "" {
+		path, ok := c.lookup(source, version)
+		if ok {
+			return path, nil
+		}
+	}
+
+	// download next to the cache so that archives can be moved into it
+	err := os.MkdirAll(c.dir, 0755)
+	if err != nil {
+		return "", err
+	}
+	tmp, err := os.MkdirTemp(c.dir, "download-")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmp)
+
+	settings := cli.New()
+	ref := source.Name
+	digest := ""
+	if source.RepoURL != "" {
+		cv, err := findChartVersion(settings, tmp, source, version)
+		if err != nil {
+			return "", err
+		}
+		if version != cv.Version {
+			// the latest version was requested: it may already be cached
+			version = cv.Version
+			path, ok := c.lookup(source, version)
+			if ok {
+				return path, nil
+			}
+		}
+		if len(cv.URLs) == 0 {
+			return "", fmt.Errorf("chart %s %s has no downloadable URLs", source.Name, version)
+		}
+		ref, err = repo.ResolveReferenceURL(source.RepoURL, cv.URLs[0])
+		if err != nil {
+			return "", err
+		}
+		digest = cv.Digest
+	}
+
+	options := []getter.Option{
+		getter.WithPlainHTTP(source.PlainHTTP),
+		getter.WithRegistryClient(registryClient),
+	}
+	// the index may point at charts hosted elsewhere which mustn't be sent the credentials of the
+	// repository
+	if source.RepoURL == "" || sameOrigin(source.RepoURL, ref) {
+		options = append(options, getter.WithBasicAuth(source.Username, source.Password))
+	}
+	dl := downloader.ChartDownloader{
+		Out:              io.Discard,
+		Getters:          getter.All(settings),
+		Options:          options,
+		RegistryClient:   registryClient,
+		RepositoryConfig: settings.RepositoryConfig,
+		RepositoryCache:  settings.RepositoryCache,
+	}
+	if len(source.Keyring) > 0 {
+		dl.Verify = downloader.VerifyAlways
+		dl.Keyring = filepath.Join(tmp, "keyring.gpg")
+		err = os.WriteFile(dl.Keyring, source.Keyring, 0600)
+		if err != nil {
+			return "", err
+		}
+	}
+	archive, _, err := dl.DownloadTo(ref, version, tmp)
+	if err != nil {
+		return "", err
+	}
+
+	sum, err := fileDigest(archive)
+	if err != nil {
+		return "", err
+	}
+	if digest != "" && sum != digest {
+		return "", fmt.Errorf("digest of chart %s %s is %s but the repository index has %s", source.Name, version, sum, digest)
+	}
+	return c.store(source, version, archive, sum)
+}
+
+// lookup returns the cached archive of the chart version if it is intact (and verified when the source
+// has a keyring).
+func (c *chartCache) lookup(source chartSource, version string) (string, bool) {
+	ref, err := os.ReadFile(c.refPath(source, version))
+	if err != nil {
+		return "", false
+	}
+	path := c.blobPath(string(ref))
+	sum, err := fileDigest(path)
+	if err != nil || sum != string(ref) {
+		return "", false
+	}
+	err = verifyProvenance(path, source.Keyring)
+	if err != nil {
+		return "", false
+	}
+	return path, true
+}
+
+// verifyProvenance verifies the archive against its provenance file with the keyring, if there is one.
+func verifyProvenance(path string, keyring []byte) error {
+	if len(keyring) == 0 {
+		return nil
+	}
+	f, err := os.CreateTemp("", "keyring-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(keyring)
+	f.Close()
+	if err != nil {
+		return err
+	}
+	_, err = downloader.VerifyChart(path, f.Name())
+	return err
+}
+
+// store moves the downloaded archive (and its provenance file) into the cache and references it by the
+// chart version.
+func (c *chartCache) store(source chartSource, version string, archive string, sum string) (string, error) {
+	path := c.blobPath(sum)
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return "", err
+	}
+	err = os.Rename(archive, path)
+	if err != nil {
+		return "", err
+	}
+	err = os.Rename(archive+".prov", path+".prov")
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return "", err
+	}
+
+	// charts pulled without a version (i.e. the latest from a registry) aren't referenced
+	if version == "" {
+		return path, nil
+	}
+	ref := c.refPath(source, version)
+	err = os.MkdirAll(filepath.Dir(ref), 0755)
+	if err != nil {
+		return "", err
+	}
+	tmp := ref + ".tmp"
+	err = os.WriteFile(tmp, []byte(sum), 0644)
+	if err != nil {
+		return "", err
+	}
+	return path, os.Rename(tmp, ref)
+}
+
+func (c *chartCache) blobPath(sum string) string {
+	return filepath.Join(c.dir, "blobs", sum+".tgz")
+}
+
+func (c *chartCache) refPath(source chartSource, version string) string {
+	key := sha256.Sum256([]byte(source.RepoURL + "\n" + source.Name + "\n" + version))
+	return filepath.Join(c.dir, "refs", hex.EncodeToString(key[:]))
+}
+
+// findChartVersion downloads the index of the repository of the source and returns the chart version
+// (the latest if version is empty).
+func findChartVersion(settings *cli.EnvSettings, dir string, source chartSource, version string) (*repo.ChartVersion, error) {
+	r, err := repo.NewChartRepository(&repo.Entry{
+		Name:     "home-cloud",
+		URL:      source.RepoURL,
+		Username: source.Username,
+		Password: source.Password,
+	}, getter.All(settings))
+	if err != nil {
+		return nil, err
+	}
+	r.CachePath = dir
+	path, err := r.DownloadIndexFile()
+	if err != nil {
+		return nil, fmt.Errorf("failed to download index of %s: %w", source.RepoURL, err)
+	}
+	index, err := repo.LoadIndexFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return index.Get(source.Name, version)
+}
+
+// sameOrigin returns whether both URLs have the same scheme and host (including the port).
+func sameOrigin(a string, b string) bool {
+	u1, err := url.Parse(a)
+	if err != nil {
+		return false
+	}
+	u2, err := url.Parse(b)
+	if err != nil {
+		return false
+	}
+	return u1.Scheme == u2.Scheme && u1.Host == u2.Host
+}
+
+func fileDigest(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
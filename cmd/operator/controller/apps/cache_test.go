@@ -0,0 +1,174 @@
+package apps
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/repo"
+)
+
+// serveChartRepository serves a repository with a single chart whose digest in the index is digest
+// (or the actual one if empty).
+func serveChartRepository(t *testing.T, digest string) *httptest.Server {
+	dir := t.TempDir()
+	metadata := &chart.Metadata{APIVersion: chart.APIVersionV2, Name: "immich", Version: "1.0.0"}
+	archive, err := chartutil.Save(&chart.Chart{Metadata: metadata}, dir)
+	assert.NoError(t, err)
+	if digest == "" {
+		digest, err = fileDigest(archive)
+		assert.NoError(t, err)
+	}
+
+	index := repo.NewIndexFile()
+	err = index.MustAdd(metadata, filepath.Base(archive), "", digest)
+	assert.NoError(t, err)
+	err = index.WriteFile(filepath.Join(dir, "index.yaml"), 0644)
+	assert.NoError(t, err)
+
+	server := httptest.NewServer(http.FileServer(http.Dir(dir)))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestChartCachePull(t *testing.T) {
+	server := serveChartRepository(t, "")
+	cache := &chartCache{dir: t.TempDir()}
+	source := chartSource{Name: "immich", RepoURL: server.URL}
+
+	// the latest version is downloaded and cached by its digest
+	path, err := cache.pull(nil, source, "")
+	assert.NoError(t, err)
+	sum, err := fileDigest(path)
+	assert.NoError(t, err)
+	assert.Equal(t, cache.blobPath(sum), path)
+
+	// once cached the repository is no longer needed
+	server.Close()
+	cached, err := cache.pull(nil, source, "1.0.0")
+	assert.NoError(t, err)
+	assert.Equal(t, path, cached)
+
+	// the same chart from another repository isn't the same reference
+	_, ok := cache.lookup(chartSource{Name: "immich", RepoURL: "https://apps.home-cloud.io"}, "1.0.0")
+	assert.False(t, ok)
+
+	// a tampered archive is no longer used
+	err = os.WriteFile(path, []byte("tampered"), 0644)
+	assert.NoError(t, err)
+	_, ok = cache.lookup(source, "1.0.0")
+	assert.False(t, ok)
+}
+
+func TestChartCachePullDigestMismatch(t *testing.T) {
+	server := serveChartRepository(t, "0000000000000000000000000000000000000000000000000000000000000000")
+	cache := &chartCache{dir: t.TempDir()}
+	source := chartSource{Name: "immich", RepoURL: server.URL}
+
+	_, err := cache.pull(nil, source, "1.0.0")
+	assert.ErrorContains(t, err, "repository index")
+	_, ok := cache.lookup(source, "1.0.0")
+	assert.False(t, ok)
+}
+
+func TestChartCachePullCredentials(t *testing.T) {
+	tests := []struct {
+		name      string
+		sameHost  bool
+		wantCreds bool
+	}{
+		{
+			name:      "chart hosted by the repository",
+			sameHost:  true,
+			wantCreds: true,
+		},
+		{
+			name:      "chart hosted elsewhere",
+			sameHost:  false,
+			wantCreds: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			metadata := &chart.Metadata{APIVersion: chart.APIVersionV2, Name: "immich", Version: "1.0.0"}
+			archive, err := chartutil.Save(&chart.Chart{Metadata: metadata}, dir)
+			assert.NoError(t, err)
+			digest, err := fileDigest(archive)
+			assert.NoError(t, err)
+
+			// record the credentials sent along with the chart download
+			var gotCreds bool
+			files := http.FileServer(http.Dir(dir))
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/index.yaml" {
+					_, _, gotCreds = r.BasicAuth()
+				}
+				files.ServeHTTP(w, r)
+			})
+			repoServer := httptest.NewServer(handler)
+			t.Cleanup(repoServer.Close)
+			chartServer := repoServer
+			if !tt.sameHost {
+				chartServer = httptest.NewServer(handler)
+				t.Cleanup(chartServer.Close)
+			}
+
+			index := repo.NewIndexFile()
+			err = index.MustAdd(metadata, filepath.Base(archive), chartServer.URL, digest)
+			assert.NoError(t, err)
+			err = index.WriteFile(filepath.Join(dir, "index.yaml"), 0644)
+			assert.NoError(t, err)
+
+			cache := &chartCache{dir: t.TempDir()}
+			source := chartSource{Name: "immich", RepoURL: repoServer.URL, Username: "jane", Password: "password"}
+			_, err = cache.pull(nil, source, "1.0.0")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantCreds, gotCreds)
+		})
+	}
+}
+
+func TestSameOrigin(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want bool
+	}{
+		{
+			name: "same host",
+			a:    "https://apps.home-cloud.io",
+			b:    "https://apps.home-cloud.io/charts/immich-1.0.0.tgz",
+			want: true,
+		},
+		{
+			name: "other host",
+			a:    "https://apps.home-cloud.io",
+			b:    "https://github.com/home-cloud-io/immich-1.0.0.tgz",
+			want: false,
+		},
+		{
+			name: "other scheme",
+			a:    "https://apps.home-cloud.io",
+			b:    "http://apps.home-cloud.io/immich-1.0.0.tgz",
+			want: false,
+		},
+		{
+			name: "other port",
+			a:    "https://apps.home-cloud.io",
+			b:    "https://apps.home-cloud.io:8443/immich-1.0.0.tgz",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, sameOrigin(tt.a, tt.b))
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package apps
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/registry"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+	"github.com/home-cloud-io/core/cmd/operator/controller/shared"
+	"github.com/home-cloud-io/core/pkg/install/bundle"
+)
+
+// KeyringKey is the key of the keyring in the keyring Secret of an App.
+const KeyringKey = "keyring"
+
+// chartSource is where the chart of an App is pulled from.
+type chartSource struct {
+	// Name is the chart name when pulled from a repository or else the full oci:// reference
+	Name    string
+	RepoURL string
+
+	Username  string
+	Password  string
+	PlainHTTP bool
+	// Keyring is the GnuPG public keyring the provenance of the chart is verified with
+	Keyring []byte
+	// Path is the archive of the chart in the bundle of offline installs
+	Path string
+}
+
+// chartSourceOf returns where the chart of the App is pulled from along with the credentials of its pull
+// secret. Helm doesn't support OCI repository URLs so charts in OCI registries are referenced directly.
+// Offline installs use the chart of their bundle when it has the chart version.
+func chartSourceOf(ctx context.Context, c client.Reader, app *v1.App) (chartSource, error) {
+	source := chartSource{
+		Name:      app.Spec.Chart,
+		PlainHTTP: app.Spec.PlainHTTP,
+	}
+	switch {
+	case registry.IsOCI(app.Spec.Chart):
+	case registry.IsOCI(app.Spec.Repo):
+		source.Name = strings.TrimSuffix(app.Spec.Repo, "/") + "/" + app.Spec.Chart
+	case strings.HasPrefix(app.Spec.Repo, "http://"), strings.HasPrefix(app.Spec.Repo, "https://"):
+		source.RepoURL = app.Spec.Repo
+	default:
+		source.RepoURL = "https://" + app.Spec.Repo
+	}
+
+	install := &v1.Install{}
+	err := c.Get(ctx, types.NamespacedName{Name: "install", Namespace: "home-cloud-system"}, install)
+	if client.IgnoreNotFound(err) != nil {
+		return source, err
+	}
+	if b := bundle.Of(install); b != nil {
+		source.Path, err = b.Chart(path.Base(source.Name), app.Spec.Version)
+		if err != nil && !errors.Is(err, bundle.ErrChartNotFound) {
+			return source, err
+		}
+	}
+
+	if app.Spec.Keyring != "" {
+		secret := &corev1.Secret{}
+		err := c.Get(ctx, types.NamespacedName{Namespace: app.Namespace, Name: app.Spec.Keyring}, secret)
+		if err != nil {
+			return source, fmt.Errorf("failed to get keyring: %w", err)
+		}
+		source.Keyring = secret.Data[KeyringKey]
+		if len(source.Keyring) == 0 {
+			return source, fmt.Errorf("keyring secret %s has no %s key", secret.Name, KeyringKey)
+		}
+	}
+
+	if app.Spec.PullSecret == "" {
+		return source, nil
+	}
+	secret := &corev1.Secret{}
+	err = c.Get(ctx, types.NamespacedName{Namespace: app.Namespace, Name: app.Spec.PullSecret}, secret)
+	if err != nil {
+		return source, fmt.Errorf("failed to get pull secret: %w", err)
+	}
+	source.Username, source.Password, err = pullCredentials(secret, source.host())
+	if err != nil {
+		return source, err
+	}
+	return source, nil
+}
+
+// helmAction creates the Helm configuration of the App with a registry client that uses the credentials
+// of the source.
+func (s chartSource) helmAction(namespace string) (*action.Configuration, error) {
+	opts := []registry.ClientOption{}
+	if s.Username != "" || s.Password != "" {
+		opts = append(opts, registry.ClientOptBasicAuth(s.Username, s.Password))
+	}
+	if s.PlainHTTP {
+		opts = append(opts, registry.ClientOptPlainHTTP())
+	}
+	return shared.CreateHelmAction(namespace, opts...)
+}
+
+// host returns the host of the repository or registry of the chart.
+func (s chartSource) host() string {
+	ref := s.RepoURL
+	if ref == "" {
+		ref = s.Name
+	}
+	u, err := url.Parse(ref)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
+
+// pullCredentials returns the username and password of a pull secret for the given host.
+func pullCredentials(secret *corev1.Secret, host string) (string, string, error) {
+	if secret.Type != corev1.SecretTypeDockerConfigJson {
+		return string(secret.Data[corev1.BasicAuthUsernameKey]), string(secret.Data[corev1.BasicAuthPasswordKey]), nil
+	}
+
+	config := struct {
+		Auths map[string]struct {
+			Username string `json:"username"`
+			Password string `json:"password"`
+			Auth     string `json:"auth"`
+		} `json:"auths"`
+	}{}
+	err := json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], &config)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid pull secret %s: %w", secret.Name, err)
+	}
+	for registry, auth := range config.Auths {
+		// entries may be bare hosts or URLs
+		if u, err := url.Parse(registry); err == nil && u.Host != "" {
+			registry = u.Host
+		}
+		if registry != host {
+			continue
+		}
+		if auth.Auth == "" {
+			return auth.Username, auth.Password, nil
+		}
+		decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid pull secret %s: %w", secret.Name, err)
+		}
+		username, password, _ := strings.Cut(string(decoded), ":")
+		return username, password, nil
+	}
+	return "", "", fmt.Errorf("pull secret %s has no credentials for %s", secret.Name, host)
+}
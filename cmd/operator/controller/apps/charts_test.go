@@ -0,0 +1,155 @@
+package apps
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/repo"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+	"github.com/home-cloud-io/core/pkg/install/bundle"
+)
+
+func TestChartSourceOf(t *testing.T) {
+	basicAuth := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "basic", Namespace: "home-cloud-system"},
+		Type:       corev1.SecretTypeBasicAuth,
+		Data:       map[string][]byte{"username": []byte("user"), "password": []byte("pass")},
+	}
+	dockerConfig := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "docker", Namespace: "home-cloud-system"},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths": {
+			"https://ghcr.io": {"auth": "Z2g6dG9rZW4="},
+			"registry.local:5000": {"username": "local", "password": "secret"}
+		}}`)},
+	}
+
+	tests := []struct {
+		name    string
+		spec    v1.AppSpec
+		want    chartSource
+		wantErr bool
+	}{
+		{
+			name: "repository without scheme",
+			spec: v1.AppSpec{Chart: "immich", Repo: "apps.home-cloud.io"},
+			want: chartSource{Name: "immich", RepoURL: "https://apps.home-cloud.io"},
+		},
+		{
+			name: "repository with scheme",
+			spec: v1.AppSpec{Chart: "immich", Repo: "http://apps.local"},
+			want: chartSource{Name: "immich", RepoURL: "http://apps.local"},
+		},
+		{
+			name: "oci repository",
+			spec: v1.AppSpec{Chart: "immich", Repo: "oci://ghcr.io/home-cloud-io/charts/"},
+			want: chartSource{Name: "oci://ghcr.io/home-cloud-io/charts/immich"},
+		},
+		{
+			name: "oci chart reference",
+			spec: v1.AppSpec{Chart: "oci://ghcr.io/home-cloud-io/charts/immich"},
+			want: chartSource{Name: "oci://ghcr.io/home-cloud-io/charts/immich"},
+		},
+		{
+			name: "basic auth pull secret",
+			spec: v1.AppSpec{Chart: "immich", Repo: "apps.home-cloud.io", PullSecret: "basic"},
+			want: chartSource{Name: "immich", RepoURL: "https://apps.home-cloud.io", Username: "user", Password: "pass"},
+		},
+		{
+			name: "docker config pull secret with auth",
+			spec: v1.AppSpec{Chart: "immich", Repo: "oci://ghcr.io/home-cloud-io/charts", PullSecret: "docker"},
+			want: chartSource{Name: "oci://ghcr.io/home-cloud-io/charts/immich", Username: "gh", Password: "token"},
+		},
+		{
+			name: "docker config pull secret of a local registry",
+			spec: v1.AppSpec{Chart: "immich", Repo: "oci://registry.local:5000/charts", PullSecret: "docker", PlainHTTP: true},
+			want: chartSource{Name: "oci://registry.local:5000/charts/immich", Username: "local", Password: "secret", PlainHTTP: true},
+		},
+		{
+			name:    "docker config pull secret without the registry",
+			spec:    v1.AppSpec{Chart: "immich", Repo: "oci://quay.io/charts", PullSecret: "docker"},
+			wantErr: true,
+		},
+		{
+			name:    "missing pull secret",
+			spec:    v1.AppSpec{Chart: "immich", Repo: "apps.home-cloud.io", PullSecret: "missing"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestReconciler([]client.Object{basicAuth.DeepCopy(), dockerConfig.DeepCopy()}...)
+			app := &v1.App{
+				ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "home-cloud-system"},
+				Spec:       tt.spec,
+			}
+			got, err := chartSourceOf(context.Background(), r.Client, app)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestChartSourceOfBundle(t *testing.T) {
+	dir := t.TempDir()
+	charts := filepath.Join(dir, bundle.ChartsDir)
+	err := os.MkdirAll(charts, 0755)
+	assert.NoError(t, err)
+	_, err = chartutil.Save(&chart.Chart{Metadata: &chart.Metadata{APIVersion: chart.APIVersionV2, Name: "immich", Version: "1.0.0"}}, charts)
+	assert.NoError(t, err)
+	index, err := repo.IndexDirectory(charts, "")
+	assert.NoError(t, err)
+	err = index.WriteFile(filepath.Join(charts, "index.yaml"), 0644)
+	assert.NoError(t, err)
+
+	install := &v1.Install{
+		ObjectMeta: metav1.ObjectMeta{Name: "install", Namespace: "home-cloud-system"},
+		Spec:       v1.InstallSpec{Bundle: &v1.BundleSpec{Path: dir}},
+	}
+	r := newTestReconciler(install)
+
+	tests := []struct {
+		name string
+		spec v1.AppSpec
+		want string
+	}{
+		{
+			name: "repository chart in the bundle",
+			spec: v1.AppSpec{Chart: "immich", Repo: "apps.home-cloud.io", Version: "1.0.0"},
+			want: filepath.Join(charts, "immich-1.0.0.tgz"),
+		},
+		{
+			name: "oci chart in the bundle",
+			spec: v1.AppSpec{Chart: "oci://ghcr.io/home-cloud-io/charts/immich", Version: "1.0.0"},
+			want: filepath.Join(charts, "immich-1.0.0.tgz"),
+		},
+		{
+			name: "chart version not in the bundle",
+			spec: v1.AppSpec{Chart: "immich", Repo: "apps.home-cloud.io", Version: "2.0.0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &v1.App{
+				ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "home-cloud-system"},
+				Spec:       tt.spec,
+			}
+			got, err := chartSourceOf(context.Background(), r.Client, app)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got.Path)
+		})
+	}
+}
@@ -11,18 +11,25 @@ import (
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/chart/loader"
-	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/registry"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	v1 "github.com/home-cloud-io/core/api/crds/v1"
 	"github.com/home-cloud-io/core/cmd/operator/controller/shared"
+	"github.com/home-cloud-io/core/pkg/install/resources"
 )
 
 const AppFinalizer = "apps.home-cloud.io/finalizer"
@@ -80,114 +87,201 @@ func (r *AppReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 		return ctrl.Result{}, r.tryDeletions(ctx, app)
 	}
 
+	// leave the App alone while it is restored from a backup: its workloads are stopped on purpose and
+	// its databases and volumes are being replaced (the App is requeued once the Restore is done)
+	restoring, err := r.restoring(ctx, app)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if restoring {
+		l.Info("App is being restored. Skipping reconcile.")
+		return ctrl.Result{}, nil
+	}
+
 	// if the version isn't set in the status, installation is needed
 	if app.Status.Version == "" {
 		l.Info("Installing App")
 		return ctrl.Result{}, r.install(ctx, app)
 	}
 
+	// finish or roll back an upgrade once its workloads have rolled out or failed to (unless the spec
+	// has changed since)
+	if app.Status.UpgradeRevision != 0 && app.Generation == app.Status.ObservedGeneration {
+		return r.checkUpgrade(ctx, app)
+	}
+
 	// upgrade if conditions are met
 	if shouldUpgrade(app) {
 		l.Info("Upgrading App")
-		return ctrl.Result{}, r.upgrade(ctx, app)
+		err = r.upgrade(ctx, app)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: rolloutPollInterval}, nil
 	}
 
-	return ctrl.Result{}, nil
+	// restore resources of the App that are missing or were changed
+	err = r.repair(ctx, app)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// rotate database passwords if requested or scheduled
+	rotate, next := databasePasswordRotation(app, time.Now())
+	if rotate {
+		l.Info("Rotating App database passwords")
+		err = r.rotateDatabasePasswords(ctx, app)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		_, next = databasePasswordRotation(app, time.Now())
+	}
+
+	// rotate secret keys if requested
+	if request := app.GetAnnotations()[v1.RotateSecretKeysAnnotation]; request != app.Status.SecretKeyRotationRequest {
+		l.Info("Rotating App secret keys")
+		err = r.rotateSecretKeys(ctx, app)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// record that spec changes which don't need an upgrade have been observed
+	if app.Status.ObservedGeneration != app.Generation {
+		app.Status.ObservedGeneration = app.Generation
+		return ctrl.Result{RequeueAfter: next}, r.Status().Update(ctx, app)
+	}
+
+	return ctrl.Result{RequeueAfter: next}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *AppReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// watch the resources created for Apps so that they are restored if changed or deleted
+	dependent := handler.EnqueueRequestsFromMapFunc(r.appForDependent)
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1.App{}).
+		Watches(&corev1.Namespace{}, dependent, builder.WithPredicates(dependentPredicate)).
+		Watches(&corev1.Secret{}, dependent, builder.WithPredicates(dependentPredicate)).
+		Watches(&corev1.PersistentVolume{}, dependent, builder.WithPredicates(dependentPredicate)).
+		Watches(&corev1.PersistentVolumeClaim{}, dependent, builder.WithPredicates(dependentPredicate)).
+		Watches(&gwv1.HTTPRoute{}, dependent, builder.WithPredicates(dependentPredicate)).
+		Watches(&corev1.ResourceQuota{}, dependent, builder.WithPredicates(dependentPredicate)).
+		Watches(&corev1.LimitRange{}, dependent, builder.WithPredicates(dependentPredicate)).
+		Watches(&networkingv1.NetworkPolicy{}, dependent, builder.WithPredicates(dependentPredicate)).
+		// reconcile the App again once it has been restored
+		Watches(&v1.Restore{}, handler.EnqueueRequestsFromMapFunc(appForRestore)).
+		// update the resources of all Apps when the Install changes (e.g. the route hostnames)
+		Watches(&v1.Install{}, handler.EnqueueRequestsFromMapFunc(r.appsForInstall), builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Complete(r)
 }
 
 func (r *AppReconciler) install(ctx context.Context, app *v1.App) error {
+	err := r.setPhase(ctx, app, v1.AppPhaseInstalling)
+	if err != nil {
+		return err
+	}
 
 	// read combined app config from chart values and override values configured in the app
-	appConfig, err := config(app)
+	appConfig, err := Config(ctx, r.Client, app)
 	if err != nil {
-		return err
+		return r.fail(ctx, app, v1.AppConditionChartInstalled, "ChartDownloadFailed", err)
 	}
 
 	err = r.createDependencies(ctx, app, appConfig)
+	if err != nil {
+		return r.fail(ctx, app, v1.AppConditionDependenciesReady, dependenciesFailedReason(err), err)
+	}
+	err = r.setCondition(ctx, app, v1.AppConditionDependenciesReady, "DependenciesCreated")
 	if err != nil {
 		return err
 	}
 
 	// construct helm configuration
-	actionConfiguration, err := shared.CreateHelmAction(app.Namespace)
+	source, err := chartSourceOf(ctx, r.Client, app)
 	if err != nil {
-		return err
+		return r.fail(ctx, app, v1.AppConditionChartInstalled, "ChartDownloadFailed", err)
+	}
+	actionConfiguration, err := source.helmAction(app.Namespace)
+	if err != nil {
+		return r.fail(ctx, app, v1.AppConditionChartInstalled, "ChartInstallFailed", err)
 	}
 	act := action.NewInstall(actionConfiguration)
 	act.Version = app.Spec.Version
 	act.Namespace = app.Namespace
-	act.RepoURL = repoURL(app)
 	act.ReleaseName = app.Spec.Release
-	chart, values, err := getChartAndValues(act.ChartPathOptions, app)
+	chart, values, err := getChartAndValues(actionConfiguration.RegistryClient, source, app)
 	if err != nil {
-		return err
+		return r.fail(ctx, app, v1.AppConditionChartInstalled, "ChartDownloadFailed", err)
 	}
 
 	// finally, install helm chart
 	_, err = act.Run(chart, values)
+	if err != nil {
+		return r.fail(ctx, app, v1.AppConditionChartInstalled, "ChartInstallFailed", err)
+	}
+	err = r.setCondition(ctx, app, v1.AppConditionChartInstalled, "ChartInstalled")
 	if err != nil {
 		return err
 	}
 
 	// create routes
-	for _, route := range appConfig.Routes {
-		err = r.createRoute(ctx, appConfig.Namespace, route)
-		if err != nil {
-			return err
-		}
+	err = r.reconcileRoutes(ctx, app, appConfig.Namespace, appConfig.Routes)
+	err = r.routesReconciled(ctx, app, err)
+	if err != nil {
+		return err
 	}
 
 	return r.updateStatus(ctx, app)
 }
 
 func (r *AppReconciler) upgrade(ctx context.Context, app *v1.App) error {
+	err := r.setPhase(ctx, app, v1.AppPhaseUpgrading)
+	if err != nil {
+		return err
+	}
 
 	// read combined app config from chart values and override values configured in the app
-	appConfig, err := config(app)
+	appConfig, err := Config(ctx, r.Client, app)
 	if err != nil {
-		return err
+		return r.fail(ctx, app, v1.AppConditionChartInstalled, "ChartDownloadFailed", err)
 	}
 
 	err = r.createDependencies(ctx, app, appConfig)
+	if err != nil {
+		return r.fail(ctx, app, v1.AppConditionDependenciesReady, dependenciesFailedReason(err), err)
+	}
+	err = r.setCondition(ctx, app, v1.AppConditionDependenciesReady, "DependenciesCreated")
 	if err != nil {
 		return err
 	}
 
 	// construct helm configuration
-	actionConfiguration, err := shared.CreateHelmAction(app.Namespace)
+	source, err := chartSourceOf(ctx, r.Client, app)
 	if err != nil {
-		return err
+		return r.fail(ctx, app, v1.AppConditionChartInstalled, "ChartDownloadFailed", err)
+	}
+	actionConfiguration, err := source.helmAction(app.Namespace)
+	if err != nil {
+		return r.fail(ctx, app, v1.AppConditionChartInstalled, "ChartUpgradeFailed", err)
 	}
 	act := action.NewUpgrade(actionConfiguration)
 	act.Version = app.Spec.Version
 	act.Namespace = app.Namespace
-	act.RepoURL = repoURL(app)
-	chart, values, err := getChartAndValues(act.ChartPathOptions, app)
+	chart, values, err := getChartAndValues(actionConfiguration.RegistryClient, source, app)
 	if err != nil {
-		return err
+		return r.fail(ctx, app, v1.AppConditionChartInstalled, "ChartDownloadFailed", err)
 	}
 
-	_, err = act.Run(app.Spec.Release, chart, values)
+	rel, err := act.Run(app.Spec.Release, chart, values)
 	if err != nil {
-		return err
-	}
-
-	// update routes
-	for _, route := range appConfig.Routes {
-		err = r.createRoute(ctx, appConfig.Namespace, route)
-		if err != nil {
-			return err
-		}
+		return r.fail(ctx, app, v1.AppConditionChartInstalled, "ChartUpgradeFailed", err)
 	}
 
-	return r.updateStatus(ctx, app)
+	// the upgrade is completed (or rolled back if the workloads of the new revision don't become
+	// ready) by checkUpgrade
+	startUpgrade(app, rel.Version, time.Now())
+	return r.Status().Update(ctx, app)
 }
 
 func (r *AppReconciler) uninstall(ctx context.Context, app *v1.App) error {
@@ -205,20 +299,33 @@ func (r *AppReconciler) uninstall(ctx context.Context, app *v1.App) error {
 	}
 
 	// read combined app config from chart values and override values configured in the app
-	appConfig, err := config(app)
+	appConfig, err := Config(ctx, r.Client, app)
 	if err != nil {
 		return err
 	}
 
-	// delete all routes
+	// delete all routes (including any left over from earlier versions of the chart)
 	for _, route := range appConfig.Routes {
 		err = r.deleteRoute(ctx, appConfig.Namespace, route.Name)
 		if err != nil {
 			return err
 		}
 	}
+	err = r.reconcileRoutes(ctx, app, appConfig.Namespace, nil)
+	if err != nil {
+		return err
+	}
 
-	// TODO: option to hard-delete all add-on components (namespace, secrets, PV/PVCs, etc.)
+	// stop allowing traffic from the App into its dependencies
+	err = r.removeIsolation(ctx, app)
+	if err != nil {
+		return err
+	}
+
+	// hard-delete all add-on components (namespace, secrets, PV/PVCs, databases) if requested
+	if app.Spec.PurgePolicy == v1.PurgePolicyDelete {
+		return r.deleteDependencies(ctx, app, appConfig)
+	}
 
 	return nil
 }
@@ -229,7 +336,7 @@ func (r *AppReconciler) createDependencies(ctx context.Context, app *v1.App, app
 	)
 
 	// create namespace before installing anything else
-	err = r.Client.Create(ctx, &corev1.Namespace{
+	err = r.create(ctx, app, &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: appConfig.Namespace,
 			Labels: map[string]string{
@@ -237,25 +344,33 @@ func (r *AppReconciler) createDependencies(ctx context.Context, app *v1.App, app
 			},
 		},
 	})
-	if client.IgnoreAlreadyExists(err) != nil {
+	if err != nil {
 		return err
 	}
-	// TODO: probably a better way to do this
-	err = r.Client.Update(ctx, &corev1.Namespace{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: appConfig.Namespace,
-			Labels: map[string]string{
-				"istio.io/dataplane-mode": "ambient",
-			},
-		},
-	})
+
+	// limit the CPU and memory of the App
+	err = r.reconcileResources(ctx, app, appConfig)
+	if err != nil {
+		return err
+	}
+
+	// isolate the App namespace
+	err = r.reconcileIsolation(ctx, app, appConfig)
 	if err != nil {
 		return err
 	}
 
 	// create secrets
 	for _, s := range appConfig.Secrets {
-		err := r.createSecret(ctx, s, appConfig.Namespace)
+		err := r.createSecret(ctx, app, s, appConfig.Namespace)
+		if err != nil {
+			return err
+		}
+	}
+
+	// register the OIDC client
+	if appConfig.OIDC != nil {
+		err := r.createOIDCClient(ctx, app, appConfig)
 		if err != nil {
 			return err
 		}
@@ -271,7 +386,7 @@ func (r *AppReconciler) createDependencies(ctx context.Context, app *v1.App, app
 
 	// create database (and users/initialization scripts)
 	for _, d := range appConfig.Databases {
-		err := r.createDatabase(ctx, d, appConfig.Namespace)
+		err := r.createDatabase(ctx, app, d, appConfig.Namespace)
 		if err != nil {
 			return err
 		}
@@ -280,19 +395,48 @@ func (r *AppReconciler) createDependencies(ctx context.Context, app *v1.App, app
 	return nil
 }
 
-func (r *AppReconciler) updateStatus(ctx context.Context, app *v1.App) error {
-	app.Status.Version = app.Spec.Version
-	app.Status.Values = app.Spec.Values
-	return r.Status().Update(ctx, app)
+func (r *AppReconciler) deleteDependencies(ctx context.Context, app *v1.App, appConfig *AppConfig) error {
+	// drop databases (and users)
+	for _, d := range appConfig.Databases {
+		err := r.deleteDatabase(ctx, d)
+		if err != nil {
+			return err
+		}
+	}
+
+	// delete persistence (PV/PVCs and underlying volumes)
+	for _, p := range appConfig.Persistence {
+		err := r.deletePersistence(ctx, p, app, appConfig.Namespace)
+		if err != nil {
+			return err
+		}
+	}
+
+	// delete namespace last: this also removes the generated secrets and anything else left in it
+	err := r.Client.Delete(ctx, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: appConfig.Namespace,
+		},
+	})
+	if client.IgnoreNotFound(err) != nil {
+		return err
+	}
+
+	return nil
 }
 
 func (r *AppReconciler) tryDeletions(ctx context.Context, app *v1.App) error {
 	if controllerutil.ContainsFinalizer(app, AppFinalizer) {
-		err := r.uninstall(ctx, app)
+		err := r.setPhase(ctx, app, v1.AppPhaseUninstalling)
 		if err != nil {
 			return err
 		}
 
+		err = r.uninstall(ctx, app)
+		if err != nil {
+			return r.fail(ctx, app, v1.AppConditionReady, "UninstallFailed", err)
+		}
+
 		controllerutil.RemoveFinalizer(app, AppFinalizer)
 		err = r.Update(ctx, app)
 		if err != nil {
@@ -304,11 +448,58 @@ func (r *AppReconciler) tryDeletions(ctx context.Context, app *v1.App) error {
 
 // HELPERS
 
-// getChartAndValues returns the chart and values for a given app by downloading the chart from the registry and converting the values
-// from the string in the CRD to a map.
-func getChartAndValues(opt action.ChartPathOptions, app *v1.App) (*chart.Chart, map[string]interface{}, error) {
+// getInstall returns the current Install with defaults applied.
+func (r *AppReconciler) getInstall(ctx context.Context) (*v1.Install, error) {
+	install := &v1.Install{}
+	err := r.Client.Get(ctx, types.NamespacedName{
+		Name:      "install",
+		Namespace: "home-cloud-system",
+	}, install)
+	if err != nil {
+		return nil, err
+	}
+
+	// set defaults: any values set on the resource will override the defaults
+	err = mergo.Merge(install, resources.DefaultInstall)
+	if err != nil {
+		return nil, err
+	}
+
+	return install, nil
+}
+
+// restoring reports whether a Restore of the App is running.
+func (r *AppReconciler) restoring(ctx context.Context, app *v1.App) (bool, error) {
+	restores := &v1.RestoreList{}
+	err := r.List(ctx, restores, client.InNamespace(app.Namespace))
+	if err != nil {
+		return false, err
+	}
+	for _, restore := range restores.Items {
+		if restore.Status.App != app.Name {
+			continue
+		}
+		if restore.Status.Phase != v1.RestorePhaseCompleted && restore.Status.Phase != v1.RestorePhaseFailed {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// appForRestore maps a Restore to the App it restores.
+func appForRestore(ctx context.Context, obj client.Object) []ctrl.Request {
+	restore, ok := obj.(*v1.Restore)
+	if !ok || restore.Status.App == "" {
+		return nil
+	}
+	return []ctrl.Request{{NamespacedName: types.NamespacedName{Namespace: restore.Namespace, Name: restore.Status.App}}}
+}
+
+// getChartAndValues returns the chart and values for a given app by downloading the chart from the registry (or the chart cache) and
+// converting the values from the string in the CRD to a map.
+func getChartAndValues(registryClient *registry.Client, source chartSource, app *v1.App) (*chart.Chart, map[string]interface{}, error) {
 	// download the chart to the file system
-	path, err := opt.LocateChart(app.Spec.Chart, cli.New())
+	path, err := charts.pull(registryClient, source, app.Spec.Version)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -340,6 +531,10 @@ func shouldUpgrade(app *v1.App) bool {
 	if requestedVersion != "" {
 		requestedVersion = "v" + requestedVersion
 	}
+	// don't retry an upgrade that was rolled back until the spec changes
+	if app.Status.RolledBackGeneration != 0 && app.Status.RolledBackGeneration == app.Generation {
+		return false
+	}
 	// UPGRADE
 	// if the requested version is greater than the installed version
 	// OR
@@ -347,26 +542,28 @@ func shouldUpgrade(app *v1.App) bool {
 	return semver.Compare(requestedVersion, installedVersion) != 0 || app.Spec.Values != app.Status.Values
 }
 
-func repoURL(app *v1.App) string {
-	return "https://" + app.Spec.Repo
-}
-
-func config(app *v1.App) (config *AppConfig, err error) {
+// Config returns the combined homeCloud config of the App from the chart values and the override
+// values configured in the App. The client is used to read the pull secret of the App.
+func Config(ctx context.Context, c client.Reader, app *v1.App) (config *AppConfig, err error) {
 	// get chart from app spec
-	actionConfiguration, err := shared.CreateHelmAction(app.Namespace)
+	source, err := chartSourceOf(ctx, c, app)
 	if err != nil {
 		return nil, err
 	}
-	act := action.NewInstall(actionConfiguration)
-	act.Version = app.Spec.Version
-	act.Namespace = app.Namespace
-	act.RepoURL = repoURL(app)
-	act.ReleaseName = app.Spec.Release
-	chart, _, err := getChartAndValues(act.ChartPathOptions, app)
+	actionConfiguration, err := source.helmAction(app.Namespace)
 	if err != nil {
 		return nil, err
 	}
+	chart, _, err := getChartAndValues(actionConfiguration.RegistryClient, source, app)
+	if err != nil {
+		return nil, err
+	}
+	return chartConfig(chart, app)
+}
 
+// chartConfig returns the combined homeCloud config of the App from the values of the chart and the
+// override values configured in the App.
+func chartConfig(chart *chart.Chart, app *v1.App) (*AppConfig, error) {
 	// convert values from chart into config
 	values, err := yaml.Marshal(chart.Values)
 	if err != nil {
@@ -391,6 +588,9 @@ func config(app *v1.App) (config *AppConfig, err error) {
 
 	// TODO: should we rethink this?
 	override.Namespace = app.Spec.Release
+	for _, dependency := range chart.Metadata.Dependencies {
+		override.Dependencies = append(override.Dependencies, dependency.Name)
+	}
 	return override, nil
 }
 
@@ -0,0 +1,76 @@
+package apps
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+func TestRestoring(t *testing.T) {
+	app := &v1.App{ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "home-cloud-system"}}
+	restore := func(name string, app string, phase v1.RestorePhase) *v1.Restore {
+		return &v1.Restore{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "home-cloud-system"},
+			Status:     v1.RestoreStatus{App: app, Phase: phase},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		restores []*v1.Restore
+		want     bool
+	}{
+		{
+			name: "no restores",
+		},
+		{
+			name:     "stopping",
+			restores: []*v1.Restore{restore("immich-restore-a", "immich", "")},
+			want:     true,
+		},
+		{
+			name:     "restoring",
+			restores: []*v1.Restore{restore("immich-restore-a", "immich", v1.RestorePhaseRestoring)},
+			want:     true,
+		},
+		{
+			name: "done",
+			restores: []*v1.Restore{
+				restore("immich-restore-a", "immich", v1.RestorePhaseCompleted),
+				restore("immich-restore-b", "immich", v1.RestorePhaseFailed),
+			},
+		},
+		{
+			name:     "other app",
+			restores: []*v1.Restore{restore("jellyfin-restore-a", "jellyfin", v1.RestorePhaseRestoring)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestReconciler()
+			for _, restore := range tt.restores {
+				err := r.Create(context.Background(), restore)
+				assert.NoError(t, err)
+			}
+			got, err := r.restoring(context.Background(), app)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAppForRestore(t *testing.T) {
+	restore := &v1.Restore{ObjectMeta: metav1.ObjectMeta{Name: "immich-restore-a", Namespace: "home-cloud-system"}}
+	assert.Empty(t, appForRestore(context.Background(), restore))
+
+	restore.Status.App = "immich"
+	assert.Equal(t, []ctrl.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "home-cloud-system", Name: "immich"}},
+	}, appForRestore(context.Background(), restore))
+}
@@ -3,67 +3,203 @@ package apps
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
+	"regexp"
+	"strings"
 
+	"github.com/go-sql-driver/mysql"
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect/pgdialect"
 	"github.com/uptrace/bun/driver/pgdriver"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
-	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
 	"github.com/home-cloud-io/core/cmd/operator/controller/secrets"
 )
 
 const (
 	PostgresHostname = "postgres.postgres"
 	// PostgresHostname = "localhost" // for local dev
+	MySQLHostname = "mysql.mysql"
+	// MySQLHostname = "localhost" // for local dev
 )
 
-func (r *AppReconciler) createDatabase(ctx context.Context, d AppDatabase, namespace string) error {
+// queryer is satisfied by both the bun (postgres) and database/sql (mysql) clients
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
 
-	secret := &corev1.Secret{}
-	err := r.Client.Get(ctx, types.NamespacedName{
-		Namespace: d.Type,
-		Name:      d.Type,
-	}, secret)
+var (
+	// databaseNamePattern restricts database and user names declared by app charts to plain
+	// lowercase identifiers. Names are still quoted when used in statements.
+	databaseNamePattern = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+	// postgresConnector opens connections to the system postgres instance (overridden in tests)
+	postgresConnector = func(secret *corev1.Secret, database string) driver.Connector {
+		return pgdriver.NewConnector(
+			pgdriver.WithAddr(PostgresHostname+":5432"),
+			pgdriver.WithUser("postgres"),
+			pgdriver.WithPassword(string(secret.Data["password"])),
+			pgdriver.WithDatabase(database),
+			pgdriver.WithInsecure(true),
+		)
+	}
+)
+
+// validateDatabase checks that the database name declared by an app is safe to use as both a user
+// and database name for the given database type.
+func validateDatabase(d AppDatabase) error {
+	var maxLength int
+	switch d.Type {
+	case "postgres":
+		maxLength = 63
+	case "mysql":
+		maxLength = 32
+	default:
+		return fmt.Errorf("unsupported database type requested: %s", d.Type)
+	}
+	if len(d.Name) > maxLength {
+		return fmt.Errorf("invalid %s database name %q: must be at most %d characters", d.Type, d.Name, maxLength)
+	}
+	if !databaseNamePattern.MatchString(d.Name) {
+		return fmt.Errorf("invalid %s database name %q: must contain only lowercase letters, digits and underscores and must not start with a digit", d.Type, d.Name)
+	}
+	return nil
+}
+
+func (r *AppReconciler) createDatabase(ctx context.Context, app *v1.App, d AppDatabase, namespace string) error {
+
+	err := validateDatabase(d)
 	if err != nil {
-		return fmt.Errorf("failed to get database secret: %s", err.Error())
+		return err
+	}
+
+	secret, err := r.databaseSecret(ctx, d)
+	if err != nil {
+		return err
 	}
 
 	switch d.Type {
 	case "postgres":
 		// create db client
-		dsn := fmt.Sprintf("postgres://postgres:%s@%s:5432/postgres?sslmode=disable", secret.Data["password"], PostgresHostname)
-		sqldb := sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN(dsn)))
-		db := bun.NewDB(sqldb, pgdialect.New())
+		db := postgresClient(secret, "postgres")
+		defer db.Close()
 
 		// check if user already exists (this happens on a reinstall without wiping old data)
-		exists, err := sysObjectExists(ctx, db, fmt.Sprintf("SELECT 1 FROM pg_roles WHERE rolname='%s'", d.Name))
+		exists, err := sysObjectExists(ctx, db, "SELECT 1 FROM pg_roles WHERE rolname = ?", d.Name)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			err = r.createPostgresUser(ctx, app, db, d, namespace)
+		} else {
+			err = r.repairDatabaseSecret(ctx, app, d, secret, namespace)
+		}
+		if err != nil {
+			return err
+		}
+
+		// check if user database already exists (this happens on a reinstall without wiping old data)
+		exists, err = sysObjectExists(ctx, db, "SELECT 1 FROM pg_database WHERE datname = ?", d.Name)
 		if err != nil {
 			return err
 		}
 		if !exists {
-			err = r.createPostgresUser(ctx, db, d, namespace)
+			err = createPostgresUserDatabase(ctx, db, d, secret)
 			if err != nil {
 				return err
 			}
 		}
+	case "mysql":
+		// create db client
+		db, err := mysqlClient(secret, "")
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		// check if user already exists (this happens on a reinstall without wiping old data)
+		exists, err := sysObjectExists(ctx, db, "SELECT 1 FROM mysql.user WHERE user = ?", d.Name)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			err = r.createMySQLUser(ctx, app, db, d, namespace)
+		} else {
+			err = r.repairDatabaseSecret(ctx, app, d, secret, namespace)
+		}
+		if err != nil {
+			return err
+		}
 
 		// check if user database already exists (this happens on a reinstall without wiping old data)
-		exists, err = sysObjectExists(ctx, db, fmt.Sprintf("SELECT 1 FROM pg_database WHERE datname = '%s'", d.Name))
+		exists, err = sysObjectExists(ctx, db, "SELECT 1 FROM information_schema.schemata WHERE schema_name = ?", d.Name)
 		if err != nil {
 			return err
 		}
 		if !exists {
-			err = createPostgresUserDatabase(ctx, db, d, secret)
+			err = createMySQLUserDatabase(ctx, db, d, secret)
 			if err != nil {
 				return err
 			}
 		}
+	default:
+		return fmt.Errorf("unsupported database type requested: %s", d.Type)
+	}
+
+	return nil
+}
+
+func (r *AppReconciler) deleteDatabase(ctx context.Context, d AppDatabase) error {
+
+	err := validateDatabase(d)
+	if err != nil {
+		return err
+	}
+
+	secret, err := r.databaseSecret(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	switch d.Type {
+	case "postgres":
+		// create db client
+		db := postgresClient(secret, "postgres")
+		defer db.Close()
+
+		// drop user database first since it is owned by the user
+		_, err = db.ExecContext(ctx, "DROP DATABASE IF EXISTS ? WITH (FORCE)", bun.Ident(d.Name))
+		if err != nil {
+			return err
+		}
+
+		_, err = db.ExecContext(ctx, "DROP USER IF EXISTS ?", bun.Ident(d.Name))
+		if err != nil {
+			return err
+		}
 	case "mysql":
-		// TODO
+		// create db client
+		db, err := mysqlClient(secret, "")
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		_, err = db.ExecContext(ctx, "DROP DATABASE IF EXISTS "+mysqlIdent(d.Name))
+		if err != nil {
+			return err
+		}
+
+		_, err = db.ExecContext(ctx, "DROP USER IF EXISTS ?@'%'", d.Name)
+		if err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("unsupported database type requested: %s", d.Type)
 	}
@@ -71,11 +207,75 @@ func (r *AppReconciler) createDatabase(ctx context.Context, d AppDatabase, names
 	return nil
 }
 
-func sysObjectExists(ctx context.Context, db *bun.DB, query string) (bool, error) {
-	rows, err := db.QueryContext(ctx, query)
+// databaseSecret returns the secret holding the system credentials for the given database type.
+func (r *AppReconciler) databaseSecret(ctx context.Context, d AppDatabase) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	err := r.Client.Get(ctx, types.NamespacedName{
+		Namespace: d.Type,
+		Name:      d.Type,
+	}, secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database secret: %s", err.Error())
+	}
+	return secret, nil
+}
+
+// postgresClient creates a client for the given database using the system credentials in the secret.
+//
+// NOTE: bun formats query arguments into the statement itself so values must be passed as arguments
+// and identifiers wrapped with bun.Ident to be quoted correctly.
+func postgresClient(secret *corev1.Secret, database string) *bun.DB {
+	sqldb := sql.OpenDB(postgresConnector(secret, database))
+	return bun.NewDB(sqldb, pgdialect.New())
+}
+
+// mysqlClient creates a client for the given database using the system credentials in the secret. An
+// empty database connects without selecting a default database.
+func mysqlClient(secret *corev1.Secret, database string) (*sql.DB, error) {
+	config := mysql.NewConfig()
+	config.User = "root"
+	config.Passwd = string(secret.Data["password"])
+	config.Net = "tcp"
+	config.Addr = MySQLHostname + ":3306"
+	config.DBName = database
+	// init scripts are commonly made up of multiple statements
+	config.MultiStatements = true
+	// user and host names can't be bound as parameters of a prepared statement so query arguments are
+	// escaped and interpolated by the driver instead
+	config.InterpolateParams = true
+	connector, err := mysql.NewConnector(config)
+	if err != nil {
+		return nil, err
+	}
+	return sql.OpenDB(connector), nil
+}
+
+// DatabaseSecretName returns the name of the secret holding the app credentials of the given database.
+func DatabaseSecretName(d AppDatabase) string {
+	return fmt.Sprintf("%s-%s", d.Type, d.Name)
+}
+
+// databaseURI returns the connection uri stored in the app secret of the given database.
+func databaseURI(d AppDatabase, password string) string {
+	switch d.Type {
+	case "mysql":
+		return fmt.Sprintf("mysql://%s:%s@%s:3306/%s", d.Name, password, MySQLHostname, d.Name)
+	default:
+		return fmt.Sprintf("postgres://%s:%s@postgres.postgres:5432/%s?sslmode=disable", d.Name, password, d.Name)
+	}
+}
+
+// mysqlIdent quotes the given name for use as an identifier in a mysql statement.
+func mysqlIdent(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+func sysObjectExists(ctx context.Context, db queryer, query string, args ...any) (bool, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return false, err
 	}
+	defer rows.Close()
 	var count int
 	if rows.Next() {
 		err = rows.Scan(&count)
@@ -89,43 +289,119 @@ func sysObjectExists(ctx context.Context, db *bun.DB, query string) (bool, error
 	return true, nil
 }
 
-func (r *AppReconciler) createPostgresUser(ctx context.Context, db *bun.DB, d AppDatabase, namespace string) error {
+func (r *AppReconciler) createPostgresUser(ctx context.Context, app *v1.App, db *bun.DB, d AppDatabase, namespace string) error {
 	// create user within database
 	pass, err := secrets.Generate(24, true)
 	if err != nil {
 		return err
 	}
-	_, err = db.ExecContext(ctx, fmt.Sprintf("CREATE USER %s WITH PASSWORD '%s'", d.Name, pass))
+	_, err = db.ExecContext(ctx, "CREATE USER ? WITH PASSWORD ?", bun.Ident(d.Name), string(pass))
 	if err != nil {
 		return err
 	}
 
 	// create kube secret with access credentials
-	err = r.Client.Create(ctx, &corev1.Secret{
+	return r.createDatabaseSecret(ctx, app, d, namespace, pass)
+}
+
+func createPostgresUserDatabase(ctx context.Context, db *bun.DB, d AppDatabase, secret *corev1.Secret) error {
+	// create database for user (using system db client)
+	_, err := db.ExecContext(ctx, "CREATE DATABASE ? OWNER ?", bun.Ident(d.Name), bun.Ident(d.Name))
+	if err != nil {
+		return err
+	}
+
+	// execute init script (if provided)
+	if len(d.Init) > 0 {
+		// create db client (for user database)
+		db := postgresClient(secret, d.Name)
+		defer db.Close()
+		_, err := db.ExecContext(ctx, d.Init)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *AppReconciler) createMySQLUser(ctx context.Context, app *v1.App, db *sql.DB, d AppDatabase, namespace string) error {
+	// create user within database
+	pass, err := secrets.Generate(24, true)
+	if err != nil {
+		return err
+	}
+	_, err = db.ExecContext(ctx, "CREATE USER ?@'%' IDENTIFIED BY ?", d.Name, string(pass))
+	if err != nil {
+		return err
+	}
+
+	// create kube secret with access credentials
+	return r.createDatabaseSecret(ctx, app, d, namespace, pass)
+}
+
+// createDatabaseSecret creates the Secret holding the access credentials of the database for the App.
+func (r *AppReconciler) createDatabaseSecret(ctx context.Context, app *v1.App, d AppDatabase, namespace string, pass []byte) error {
+	hostname, port := PostgresHostname, "5432"
+	if d.Type == "mysql" {
+		hostname, port = MySQLHostname, "3306"
+	}
+	return r.create(ctx, app, &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf("%s-%s", d.Type, d.Name),
+			Name:      DatabaseSecretName(d),
 			Namespace: namespace,
 		},
 		Type: corev1.SecretTypeOpaque,
 		Data: map[string][]byte{
-			"hostname": []byte("postgres.postgres"),
+			"hostname": []byte(hostname),
 			"database": []byte(d.Name),
 			"username": []byte(d.Name),
-			"password": []byte(pass),
-			"port":     []byte("5432"),
-			"uri":      []byte(fmt.Sprintf("postgres://%s:%s@postgres.postgres:5432/%s?sslmode=disable", d.Name, pass, d.Name)),
+			"password": pass,
+			"port":     []byte(port),
+			"uri":      []byte(databaseURI(d, string(pass))),
 		},
 	})
-	if client.IgnoreAlreadyExists(err) != nil {
+}
+
+// repairDatabaseSecret recreates the access credentials of an existing database user if its Secret
+// was deleted. The password can't be recovered so a new one is set and the workloads of the App are
+// restarted to pick it up.
+func (r *AppReconciler) repairDatabaseSecret(ctx context.Context, app *v1.App, d AppDatabase, secret *corev1.Secret, namespace string) error {
+	appSecret := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{
+		Namespace: namespace,
+		Name:      DatabaseSecretName(d),
+	}, appSecret)
+	if err == nil {
+		return r.addLabels(ctx, appSecret, appLabels(app))
+	}
+	if !errors.IsNotFound(err) {
 		return err
 	}
 
-	return nil
+	log.FromContext(ctx).Info("Database secret not found. Resetting database password.", "database", d.Name)
+	pass, err := secrets.Generate(24, true)
+	if err != nil {
+		return err
+	}
+	err = setDatabasePassword(ctx, d, secret, string(pass))
+	if err != nil {
+		return err
+	}
+	err = r.createDatabaseSecret(ctx, app, d, namespace, pass)
+	if err != nil {
+		return err
+	}
+	return r.restartWorkloads(ctx, namespace)
 }
 
-func createPostgresUserDatabase(ctx context.Context, db *bun.DB, d AppDatabase, secret *corev1.Secret) error {
-	// create database for user (using system db client)
-	_, err := db.ExecContext(ctx, fmt.Sprintf("CREATE DATABASE %s OWNER %s", d.Name, d.Name))
+func createMySQLUserDatabase(ctx context.Context, db *sql.DB, d AppDatabase, secret *corev1.Secret) error {
+	// create database and grant the user access to it (using system db client)
+	_, err := db.ExecContext(ctx, "CREATE DATABASE "+mysqlIdent(d.Name))
+	if err != nil {
+		return err
+	}
+	_, err = db.ExecContext(ctx, "GRANT ALL PRIVILEGES ON "+mysqlIdent(d.Name)+".* TO ?@'%'", d.Name)
 	if err != nil {
 		return err
 	}
@@ -133,10 +409,12 @@ func createPostgresUserDatabase(ctx context.Context, db *bun.DB, d AppDatabase,
 	// execute init script (if provided)
 	if len(d.Init) > 0 {
 		// create db client (for user database)
-		dsn := fmt.Sprintf("postgres://postgres:%s@%s:5432/%s?sslmode=disable", secret.Data["password"], PostgresHostname, d.Name)
-		sqldb := sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN(dsn)))
-		db := bun.NewDB(sqldb, pgdialect.New())
-		_, err := db.ExecContext(ctx, d.Init)
+		db, err := mysqlClient(secret, d.Name)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+		_, err = db.ExecContext(ctx, d.Init)
 		if err != nil {
 			return err
 		}
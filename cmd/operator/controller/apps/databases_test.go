@@ -0,0 +1,402 @@
+package apps
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+func TestValidateDatabase(t *testing.T) {
+	tests := []struct {
+		name    string
+		d       AppDatabase
+		wantErr bool
+	}{
+		{name: "postgres", d: AppDatabase{Name: "immich", Type: "postgres"}},
+		{name: "mysql", d: AppDatabase{Name: "nextcloud", Type: "mysql"}},
+		{name: "underscores and digits", d: AppDatabase{Name: "_app_2", Type: "postgres"}},
+		{name: "postgres max length", d: AppDatabase{Name: strings.Repeat("a", 63), Type: "postgres"}},
+		{name: "postgres too long", d: AppDatabase{Name: strings.Repeat("a", 64), Type: "postgres"}, wantErr: true},
+		{name: "mysql too long", d: AppDatabase{Name: strings.Repeat("a", 33), Type: "mysql"}, wantErr: true},
+		{name: "empty", d: AppDatabase{Name: "", Type: "postgres"}, wantErr: true},
+		{name: "leading digit", d: AppDatabase{Name: "1app", Type: "postgres"}, wantErr: true},
+		{name: "uppercase", d: AppDatabase{Name: "Immich", Type: "postgres"}, wantErr: true},
+		{name: "hyphen", d: AppDatabase{Name: "my-app", Type: "postgres"}, wantErr: true},
+		{name: "quote injection", d: AppDatabase{Name: "app'; DROP DATABASE postgres; --", Type: "postgres"}, wantErr: true},
+		{name: "identifier injection", d: AppDatabase{Name: `app" OWNER postgres`, Type: "postgres"}, wantErr: true},
+		{name: "backtick injection", d: AppDatabase{Name: "app`; DROP DATABASE mysql; --", Type: "mysql"}, wantErr: true},
+		{name: "unsupported type", d: AppDatabase{Name: "app", Type: "mongodb"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDatabase(tt.d)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCreatePostgresDatabase(t *testing.T) {
+	tests := []struct {
+		name string
+		d    AppDatabase
+		// existing objects in the stand-in keyed by the query that checks for them
+		existing map[string]bool
+		// existing database secret in the app namespace
+		secret *corev1.Secret
+		// patterns of the statements expected to be executed in order
+		want       []statement
+		wantSecret bool
+		wantErr    bool
+	}{
+		{
+			name: "new user and database",
+			d:    AppDatabase{Name: "immich", Type: "postgres"},
+			want: []statement{
+				{"postgres", `^SELECT 1 FROM pg_roles WHERE rolname = 'immich'$`},
+				{"postgres", `^CREATE USER "immich" WITH PASSWORD '[A-Za-z0-9]{24}'$`},
+				{"postgres", `^SELECT 1 FROM pg_database WHERE datname = 'immich'$`},
+				{"postgres", `^CREATE DATABASE "immich" OWNER "immich"$`},
+			},
+			wantSecret: true,
+		},
+		{
+			name: "existing user, database and secret",
+			d:    AppDatabase{Name: "immich", Type: "postgres"},
+			existing: map[string]bool{
+				"SELECT 1 FROM pg_roles WHERE rolname = 'immich'":    true,
+				"SELECT 1 FROM pg_database WHERE datname = 'immich'": true,
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "postgres-immich", Namespace: "app"},
+				Data: map[string][]byte{
+					"username": []byte("immich"),
+					"database": []byte("immich"),
+					"password": []byte("existing"),
+				},
+			},
+			want: []statement{
+				{"postgres", `^SELECT 1 FROM pg_roles WHERE rolname = 'immich'$`},
+				{"postgres", `^SELECT 1 FROM pg_database WHERE datname = 'immich'$`},
+			},
+			wantSecret: true,
+		},
+		{
+			name: "existing user without secret resets the password",
+			d:    AppDatabase{Name: "immich", Type: "postgres"},
+			existing: map[string]bool{
+				"SELECT 1 FROM pg_roles WHERE rolname = 'immich'":    true,
+				"SELECT 1 FROM pg_database WHERE datname = 'immich'": true,
+			},
+			want: []statement{
+				{"postgres", `^SELECT 1 FROM pg_roles WHERE rolname = 'immich'$`},
+				{"postgres", `^ALTER ROLE "immich" WITH PASSWORD '[A-Za-z0-9]{24}'$`},
+				{"postgres", `^SELECT 1 FROM pg_database WHERE datname = 'immich'$`},
+			},
+			wantSecret: true,
+		},
+		{
+			name: "existing user without database",
+			d:    AppDatabase{Name: "immich", Type: "postgres"},
+			existing: map[string]bool{
+				"SELECT 1 FROM pg_roles WHERE rolname = 'immich'": true,
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "postgres-immich", Namespace: "app"},
+				Data: map[string][]byte{
+					"username": []byte("immich"),
+					"database": []byte("immich"),
+					"password": []byte("existing"),
+				},
+			},
+			want: []statement{
+				{"postgres", `^SELECT 1 FROM pg_roles WHERE rolname = 'immich'$`},
+				{"postgres", `^SELECT 1 FROM pg_database WHERE datname = 'immich'$`},
+				{"postgres", `^CREATE DATABASE "immich" OWNER "immich"$`},
+			},
+			wantSecret: true,
+		},
+		{
+			name: "init script runs on the user database",
+			d:    AppDatabase{Name: "immich", Type: "postgres", Init: "CREATE EXTENSION IF NOT EXISTS vector;"},
+			want: []statement{
+				{"postgres", `^SELECT 1 FROM pg_roles WHERE rolname = 'immich'$`},
+				{"postgres", `^CREATE USER "immich" WITH PASSWORD '[A-Za-z0-9]{24}'$`},
+				{"postgres", `^SELECT 1 FROM pg_database WHERE datname = 'immich'$`},
+				{"postgres", `^CREATE DATABASE "immich" OWNER "immich"$`},
+				{"immich", `^CREATE EXTENSION IF NOT EXISTS vector;$`},
+			},
+			wantSecret: true,
+		},
+		{
+			name:    "injected name is rejected before connecting",
+			d:       AppDatabase{Name: "immich'; DROP DATABASE postgres; --", Type: "postgres"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := useStandIn(t, tt.existing)
+			var objs []client.Object
+			if tt.secret != nil {
+				objs = append(objs, tt.secret)
+			}
+			r := newTestReconciler(objs...)
+			app := &v1.App{ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "home-cloud-system"}}
+
+			err := r.createDatabase(context.Background(), app, tt.d, "app")
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			s.assertStatements(t, tt.want)
+
+			secret := &corev1.Secret{}
+			err = r.Get(context.Background(), types.NamespacedName{Namespace: "app", Name: "postgres-" + tt.d.Name}, secret)
+			if !tt.wantSecret {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.d.Name, string(secret.Data["username"]))
+			assert.Equal(t, tt.d.Name, string(secret.Data["database"]))
+			assert.Equal(t, appLabels(app), secret.Labels)
+			if tt.secret != nil {
+				// existing secrets keep their password
+				assert.Equal(t, "existing", string(secret.Data["password"]))
+				return
+			}
+			// the generated password in the secret must be the one the user was created with
+			assert.Contains(t, s.statements[1].query, "'"+string(secret.Data["password"])+"'")
+		})
+	}
+}
+
+func TestRepairDatabaseSecret(t *testing.T) {
+	existing := map[string]bool{
+		"SELECT 1 FROM pg_roles WHERE rolname = 'immich'":    true,
+		"SELECT 1 FROM pg_database WHERE datname = 'immich'": true,
+	}
+	d := AppDatabase{Name: "immich", Type: "postgres"}
+	app := &v1.App{ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "home-cloud-system"}}
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "server", Namespace: "app"}}
+
+	// the workloads are restarted to pick up the reset password
+	useStandIn(t, existing)
+	r := newTestReconciler(deployment.DeepCopy())
+	err := r.createDatabase(context.Background(), app, d, "app")
+	assert.NoError(t, err)
+	got := &appsv1.Deployment{}
+	assert.NoError(t, r.Get(context.Background(), client.ObjectKeyFromObject(deployment), got))
+	assert.Contains(t, got.Spec.Template.Annotations, RestartedAtAnnotation)
+
+	// the workloads are left alone while the secret exists
+	useStandIn(t, existing)
+	r = newTestReconciler(deployment.DeepCopy(), &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "postgres-immich", Namespace: "app"}})
+	err = r.createDatabase(context.Background(), app, d, "app")
+	assert.NoError(t, err)
+	got = &appsv1.Deployment{}
+	assert.NoError(t, r.Get(context.Background(), client.ObjectKeyFromObject(deployment), got))
+	assert.NotContains(t, got.Spec.Template.Annotations, RestartedAtAnnotation)
+}
+
+func TestDeletePostgresDatabase(t *testing.T) {
+	tests := []struct {
+		name    string
+		d       AppDatabase
+		want    []statement
+		wantErr bool
+	}{
+		{
+			name: "drops database then user",
+			d:    AppDatabase{Name: "immich", Type: "postgres"},
+			want: []statement{
+				{"postgres", `^DROP DATABASE IF EXISTS "immich" WITH \(FORCE\)$`},
+				{"postgres", `^DROP USER IF EXISTS "immich"$`},
+			},
+		},
+		{
+			name:    "injected name is rejected before connecting",
+			d:       AppDatabase{Name: `immich" WITH (FORCE); DROP DATABASE "postgres`, Type: "postgres"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := useStandIn(t, nil)
+			r := newTestReconciler()
+
+			err := r.deleteDatabase(context.Background(), tt.d)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			s.assertStatements(t, tt.want)
+		})
+	}
+}
+
+// HELPERS
+
+// newTestReconciler creates a reconciler with a fake client holding the system postgres secret and
+// the given objects.
+func newTestReconciler(objs ...client.Object) *AppReconciler {
+	objs = append(objs, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "postgres",
+			Namespace: "postgres",
+		},
+		Data: map[string][]byte{
+			"password": []byte("system-password"),
+		},
+	})
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1.AddToScheme(scheme)
+	_ = gwv1.Install(scheme)
+	return &AppReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+		Scheme: scheme,
+	}
+}
+
+type statement struct {
+	database string
+	query    string
+}
+
+// standIn is a local stand-in for the system postgres instance. It records every statement it
+// receives (after bun has formatted the query arguments into it) and answers existence checks from
+// a fixed set of objects.
+type standIn struct {
+	mu         sync.Mutex
+	existing   map[string]bool
+	statements []statement
+}
+
+// useStandIn routes postgres connections to a new stand-in for the duration of the test.
+func useStandIn(t *testing.T, existing map[string]bool) *standIn {
+	s := &standIn{existing: existing}
+	original := postgresConnector
+	postgresConnector = func(_ *corev1.Secret, database string) driver.Connector {
+		return &standInConnector{standIn: s, database: database}
+	}
+	t.Cleanup(func() {
+		postgresConnector = original
+	})
+	return s
+}
+
+func (s *standIn) record(database, query string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.statements = append(s.statements, statement{database: database, query: query})
+}
+
+func (s *standIn) assertStatements(t *testing.T, want []statement) {
+	t.Helper()
+	if !assert.Len(t, s.statements, len(want)) {
+		return
+	}
+	for i, w := range want {
+		assert.Equal(t, w.database, s.statements[i].database)
+		assert.Regexp(t, w.query, s.statements[i].query)
+	}
+}
+
+type standInConnector struct {
+	standIn  *standIn
+	database string
+}
+
+func (c *standInConnector) Connect(context.Context) (driver.Conn, error) {
+	return &standInConn{standIn: c.standIn, database: c.database}, nil
+}
+
+func (c *standInConnector) Driver() driver.Driver {
+	return standInDriver{}
+}
+
+type standInDriver struct{}
+
+func (standInDriver) Open(string) (driver.Conn, error) {
+	return nil, errors.New("stand-in connections must be opened through the connector")
+}
+
+type standInConn struct {
+	standIn  *standIn
+	database string
+}
+
+func (c *standInConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported by the stand-in")
+}
+
+func (c *standInConn) Close() error {
+	return nil
+}
+
+func (c *standInConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported by the stand-in")
+}
+
+func (c *standInConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if len(args) > 0 {
+		return nil, errors.New("arguments must be formatted into the statement")
+	}
+	c.standIn.record(c.database, query)
+	return driver.RowsAffected(0), nil
+}
+
+func (c *standInConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if len(args) > 0 {
+		return nil, errors.New("arguments must be formatted into the statement")
+	}
+	c.standIn.record(c.database, query)
+	return &standInRows{exists: c.standIn.existing[query]}, nil
+}
+
+// standInRows returns a single row containing 1 if the queried object exists and no rows otherwise.
+type standInRows struct {
+	exists bool
+	done   bool
+}
+
+func (r *standInRows) Columns() []string {
+	return []string{"?column?"}
+}
+
+func (r *standInRows) Close() error {
+	return nil
+}
+
+func (r *standInRows) Next(dest []driver.Value) error {
+	if !r.exists || r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = int64(1)
+	return nil
+}
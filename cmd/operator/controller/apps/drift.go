@@ -0,0 +1,201 @@
+package apps
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+	"github.com/home-cloud-io/core/cmd/operator/controller/shared"
+)
+
+// appLabels returns the labels set on the resources created for the App.
+func appLabels(app *v1.App) map[string]string {
+	return map[string]string{
+		v1.AppLabel:          app.Name,
+		v1.AppNamespaceLabel: app.Namespace,
+	}
+}
+
+// create creates the object with the labels of the App. If the object already exists it is left
+// as is (so that e.g. generated secrets keep their values) except that any missing labels are added.
+func (r *AppReconciler) create(ctx context.Context, app *v1.App, obj client.Object) error {
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	for k, v := range appLabels(app) {
+		labels[k] = v
+	}
+	obj.SetLabels(labels)
+
+	err := r.Client.Create(ctx, obj)
+	if !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	existing := obj.DeepCopyObject().(client.Object)
+	err = r.Client.Get(ctx, client.ObjectKeyFromObject(obj), existing)
+	if err != nil {
+		return err
+	}
+	return r.addLabels(ctx, existing, labels)
+}
+
+// addLabels patches the given labels onto the existing object if any are missing.
+func (r *AppReconciler) addLabels(ctx context.Context, existing client.Object, labels map[string]string) error {
+	patch := client.MergeFrom(existing.DeepCopyObject().(client.Object))
+	current := existing.GetLabels()
+	if current == nil {
+		current = map[string]string{}
+	}
+	changed := false
+	for k, v := range labels {
+		if current[k] != v {
+			current[k] = v
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	existing.SetLabels(current)
+	return r.Client.Patch(ctx, existing, patch)
+}
+
+// dependentPredicate passes events for resources created for an App: creates and deletes, changes
+// to their spec, and changes to their labels (e.g. the App labels being removed).
+var dependentPredicate = predicate.And[client.Object](
+	predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return hasAppLabel(e.Object)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return hasAppLabel(e.ObjectOld) || hasAppLabel(e.ObjectNew)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return hasAppLabel(e.Object)
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return hasAppLabel(e.Object)
+		},
+	},
+	predicate.Or[client.Object](predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{}),
+)
+
+func hasAppLabel(obj client.Object) bool {
+	_, ok := obj.GetLabels()[v1.AppLabel]
+	return ok
+}
+
+// appForDependent maps a resource created for an App to the App so that its resources are restored.
+func (r *AppReconciler) appForDependent(ctx context.Context, obj client.Object) []ctrl.Request {
+	labels := obj.GetLabels()
+	name, ok := labels[v1.AppLabel]
+	if !ok {
+		return nil
+	}
+	return []ctrl.Request{{NamespacedName: types.NamespacedName{
+		Name:      name,
+		Namespace: labels[v1.AppNamespaceLabel],
+	}}}
+}
+
+// appsForInstall maps the Install to all Apps so that their resources are updated with the new
+// Install settings.
+func (r *AppReconciler) appsForInstall(ctx context.Context, obj client.Object) []ctrl.Request {
+	apps := &v1.AppList{}
+	err := r.Client.List(ctx, apps)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list Apps for Install change")
+		return nil
+	}
+	requests := []ctrl.Request{}
+	for _, app := range apps.Items {
+		requests = append(requests, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&app)})
+	}
+	return requests
+}
+
+// repair restores the resources of the App that are missing or have changed (e.g. were deleted or
+// lost their labels). The resources are checked against the cluster on every reconcile rather than
+// tracked from the watch events so that no drift is missed across operator restarts. The resources
+// are those of the deployed release rather than of the spec: after a rollback the spec is still the
+// version that was rolled back.
+func (r *AppReconciler) repair(ctx context.Context, app *v1.App) error {
+	actionConfiguration, err := shared.CreateHelmAction(app.Namespace)
+	if err != nil {
+		return err
+	}
+	rel, err := action.NewGet(actionConfiguration).Run(app.Spec.Release)
+	if err != nil {
+		return err
+	}
+	appConfig, err := releaseConfig(rel, app)
+	if err != nil {
+		return err
+	}
+
+	// the chart resources went along with the namespace so the chart needs to be deployed again
+	namespace := &corev1.Namespace{}
+	err = r.Client.Get(ctx, types.NamespacedName{
+		Name: appConfig.Namespace,
+	}, namespace)
+	missing := errors.IsNotFound(err)
+	if err != nil && !missing {
+		return err
+	}
+	if namespace.GetDeletionTimestamp() != nil {
+		return fmt.Errorf("namespace %s is terminating", appConfig.Namespace)
+	}
+
+	// existing dependencies are left as is
+	err = r.createDependencies(ctx, app, appConfig)
+	if err != nil {
+		return err
+	}
+
+	if missing {
+		log.FromContext(ctx).Info("App namespace not found. Redeploying App.")
+		// nothing changes so unlike an upgrade there's no rollout to check or roll back
+		act := action.NewUpgrade(actionConfiguration)
+		act.Namespace = app.Namespace
+		_, err = act.Run(app.Spec.Release, rel.Chart, rel.Config)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = r.reconcileRoutes(ctx, app, appConfig.Namespace, appConfig.Routes)
+	if err != nil && !goerrors.Is(err, errHostnameRejected) && !goerrors.Is(err, errAuthUnsupported) {
+		return err
+	}
+	return r.routesReconciled(ctx, app, err)
+}
+
+// releaseConfig returns the combined homeCloud config of the App from the chart and values of the
+// deployed release.
+func releaseConfig(rel *release.Release, app *v1.App) (*AppConfig, error) {
+	deployed := app.DeepCopy()
+	deployed.Spec.Values = ""
+	if len(rel.Config) > 0 {
+		values, err := yaml.Marshal(rel.Config)
+		if err != nil {
+			return nil, err
+		}
+		deployed.Spec.Values = string(values)
+	}
+	return chartConfig(rel.Chart, deployed)
+}
@@ -0,0 +1,169 @@
+package apps
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+func TestCreate(t *testing.T) {
+	app := &v1.App{ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "home-cloud-system"}}
+
+	tests := []struct {
+		name     string
+		existing []client.Object
+		want     map[string][]byte
+	}{
+		{
+			name: "new object",
+			want: map[string][]byte{"key": []byte("generated")},
+		},
+		{
+			name: "existing object keeps its data",
+			existing: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "secret",
+						Namespace: "immich",
+						Labels:    map[string]string{"other": "label"},
+					},
+					Data: map[string][]byte{"key": []byte("existing")},
+				},
+			},
+			want: map[string][]byte{"key": []byte("existing")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestReconciler(tt.existing...)
+
+			err := r.create(context.Background(), app, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "secret",
+					Namespace: "immich",
+				},
+				Data: map[string][]byte{"key": []byte("generated")},
+			})
+			assert.NoError(t, err)
+
+			secret := &corev1.Secret{}
+			err = r.Get(context.Background(), types.NamespacedName{Namespace: "immich", Name: "secret"}, secret)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, secret.Data)
+			assert.Equal(t, "immich", secret.Labels[v1.AppLabel])
+			assert.Equal(t, "home-cloud-system", secret.Labels[v1.AppNamespaceLabel])
+			if len(tt.existing) > 0 {
+				assert.Equal(t, "label", secret.Labels["other"])
+			}
+		})
+	}
+}
+
+func TestAppForDependent(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   []ctrl.Request
+	}{
+		{
+			name: "app resource",
+			labels: map[string]string{
+				v1.AppLabel:          "immich",
+				v1.AppNamespaceLabel: "home-cloud-system",
+			},
+			want: []ctrl.Request{
+				{NamespacedName: types.NamespacedName{Name: "immich", Namespace: "home-cloud-system"}},
+			},
+		},
+		{
+			name:   "unrelated resource",
+			labels: map[string]string{"other": "label"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestReconciler()
+			obj := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: "immich", Labels: tt.labels}}
+
+			assert.Equal(t, tt.want, r.appForDependent(context.Background(), obj))
+		})
+	}
+}
+
+func TestDependentPredicate(t *testing.T) {
+	labelled := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Name:       "secret",
+		Generation: 1,
+		Labels:     map[string]string{v1.AppLabel: "immich"},
+	}}
+	changed := labelled.DeepCopy()
+	changed.Generation = 2
+	unlabelled := labelled.DeepCopy()
+	unlabelled.Labels = nil
+	unrelated := unlabelled.DeepCopy()
+	unrelated.Generation = 2
+
+	assert.True(t, dependentPredicate.Delete(event.DeleteEvent{Object: labelled}))
+	assert.False(t, dependentPredicate.Delete(event.DeleteEvent{Object: unlabelled}))
+	assert.True(t, dependentPredicate.Update(event.UpdateEvent{ObjectOld: labelled, ObjectNew: changed}))
+	assert.True(t, dependentPredicate.Update(event.UpdateEvent{ObjectOld: labelled, ObjectNew: unlabelled}))
+	assert.False(t, dependentPredicate.Update(event.UpdateEvent{ObjectOld: labelled, ObjectNew: labelled.DeepCopy()}))
+	assert.False(t, dependentPredicate.Update(event.UpdateEvent{ObjectOld: unlabelled, ObjectNew: unrelated}))
+}
+
+func TestReleaseConfig(t *testing.T) {
+	// the App was upgraded to 2.0.0 which was rolled back to the deployed 1.0.0
+	app := &v1.App{
+		ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "home-cloud-system"},
+		Spec: v1.AppSpec{
+			Release: "immich",
+			Version: "2.0.0",
+			Values:  "homeCloud:\n  routes:\n    - name: photos\n",
+		},
+		Status: v1.AppStatus{Version: "1.0.0", FailedVersion: "2.0.0"},
+	}
+	rel := &release.Release{
+		Chart: &chart.Chart{
+			Metadata: &chart.Metadata{
+				Name:         "immich",
+				Version:      "1.0.0",
+				Dependencies: []*chart.Dependency{{Name: "redis"}},
+			},
+			Values: map[string]any{
+				"homeCloud": map[string]any{
+					"routes": []any{map[string]any{"name": "immich"}},
+				},
+			},
+		},
+		Config: map[string]any{
+			"homeCloud": map[string]any{
+				"databases": []any{map[string]any{"name": "immich", "type": "postgres"}},
+			},
+		},
+	}
+
+	appConfig, err := releaseConfig(rel, app)
+	assert.NoError(t, err)
+	assert.Equal(t, "immich", appConfig.Namespace)
+	assert.Equal(t, []AppRoute{{Name: "immich"}}, appConfig.Routes)
+	assert.Equal(t, []AppDatabase{{Name: "immich", Type: "postgres"}}, appConfig.Databases)
+	assert.Equal(t, []string{"redis"}, appConfig.Dependencies)
+
+	// a release without values has only those of the chart
+	rel.Config = nil
+	appConfig, err = releaseConfig(rel, app)
+	assert.NoError(t, err)
+	assert.Equal(t, []AppRoute{{Name: "immich"}}, appConfig.Routes)
+	assert.Empty(t, appConfig.Databases)
+}
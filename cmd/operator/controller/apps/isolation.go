@@ -0,0 +1,309 @@
+package apps
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+const (
+	// IsolationPolicyName is the policy which denies traffic into the namespace of an App that isn't
+	// explicitly allowed.
+	IsolationPolicyName = "home-cloud-isolation"
+	// the policies allowing an App into the namespaces of its dependencies are named with this prefix
+	// followed by the namespace of the App
+	allowPolicyPrefix = "home-cloud-allow-"
+
+	namespaceNameLabel = "kubernetes.io/metadata.name"
+)
+
+// isolationPolicy describes a policy of the isolation of App namespaces: traffic into Namespace is
+// allowed from the Sources namespaces.
+type isolationPolicy struct {
+	Name      string
+	Namespace string
+	Sources   []string
+}
+
+// isolationPolicies returns the policies isolating the App namespace and allowing the App into the
+// namespaces of its dependencies.
+func isolationPolicies(install *v1.Install, appConfig *AppConfig) []isolationPolicy {
+	if install.Spec.Isolation == nil || !install.Spec.Isolation.Enable {
+		return nil
+	}
+
+	sources := []string{appConfig.Namespace, install.Namespace}
+	if len(appConfig.Routes) > 0 {
+		sources = append(sources, string(*gatewayRef(install).Namespace))
+	}
+	sources = append(sources, install.Spec.Isolation.AllowNamespaces...)
+	policies := []isolationPolicy{{
+		Name:      IsolationPolicyName,
+		Namespace: appConfig.Namespace,
+		Sources:   uniqueNamespaces(sources),
+	}}
+
+	databases := databaseNamespaces(appConfig)
+	for _, namespace := range dependencyNamespaces(appConfig) {
+		sources := []string{appConfig.Namespace}
+		// the operator creates the databases of the App (and the policy exists before it does so)
+		if slices.Contains(databases, namespace) {
+			sources = append(sources, install.Namespace)
+		}
+		policies = append(policies, isolationPolicy{
+			Name:      allowPolicyPrefix + appConfig.Namespace,
+			Namespace: namespace,
+			Sources:   uniqueNamespaces(sources),
+		})
+	}
+	return policies
+}
+
+// dependencyNamespaces returns the namespaces the App needs to reach: those of the Apps it depends on
+// (which are installed with the chart name as the release) and of the databases it uses.
+func dependencyNamespaces(appConfig *AppConfig) []string {
+	namespaces := slices.Clone(appConfig.Dependencies)
+	namespaces = append(namespaces, databaseNamespaces(appConfig)...)
+	namespaces = uniqueNamespaces(namespaces)
+	return slices.DeleteFunc(namespaces, func(namespace string) bool {
+		return namespace == appConfig.Namespace
+	})
+}
+
+// databaseNamespaces returns the namespaces of the databases the App uses.
+func databaseNamespaces(appConfig *AppConfig) []string {
+	namespaces := []string{}
+	for _, d := range appConfig.Databases {
+		switch d.Type {
+		case "postgres":
+			namespaces = append(namespaces, hostnameNamespace(PostgresHostname))
+		case "mysql":
+			namespaces = append(namespaces, hostnameNamespace(MySQLHostname))
+		}
+	}
+	return namespaces
+}
+
+// reconcileIsolation creates or updates the policies isolating the App namespace and deletes the ones
+// of the App which are no longer wanted. Istio AuthorizationPolicies are used unless Istio is disabled
+// in which case NetworkPolicies are used instead.
+func (r *AppReconciler) reconcileIsolation(ctx context.Context, app *v1.App, appConfig *AppConfig) error {
+	install, err := r.getInstall(ctx)
+	if err != nil {
+		return err
+	}
+	policies := isolationPolicies(install, appConfig)
+
+	if install.Spec.Istio.Disable {
+		err = r.reconcileIsolationAuthPolicies(ctx, app, nil)
+		if err != nil {
+			return err
+		}
+		return r.reconcileNetworkPolicies(ctx, app, policies)
+	}
+	err = r.reconcileNetworkPolicies(ctx, app, nil)
+	if err != nil {
+		return err
+	}
+	return r.reconcileIsolationAuthPolicies(ctx, app, policies)
+}
+
+// removeIsolation deletes all the policies isolating the App namespace and allowing the App into the
+// namespaces of its dependencies.
+func (r *AppReconciler) removeIsolation(ctx context.Context, app *v1.App) error {
+	err := r.reconcileNetworkPolicies(ctx, app, nil)
+	if err != nil {
+		return err
+	}
+	return r.reconcileIsolationAuthPolicies(ctx, app, nil)
+}
+
+func (r *AppReconciler) reconcileIsolationAuthPolicies(ctx context.Context, app *v1.App, policies []isolationPolicy) error {
+	existing := &unstructured.UnstructuredList{}
+	existing.SetGroupVersionKind(AuthorizationPolicyGVK.GroupVersion().WithKind(AuthorizationPolicyGVK.Kind + "List"))
+	err := r.Client.List(ctx, existing, client.MatchingLabels(appLabels(app)), client.HasLabels{v1.IsolationLabel})
+	if err != nil {
+		// without Istio there are no policies to clean up
+		if meta.IsNoMatchError(err) && len(policies) == 0 {
+			return nil
+		}
+		return err
+	}
+
+	declared := map[client.ObjectKey]bool{}
+	for _, p := range policies {
+		desired := isolationAuthPolicy(app, p)
+		declared[client.ObjectKeyFromObject(desired)] = true
+		err := r.applyAuthPolicy(ctx, app, desired)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, policy := range existing.Items {
+		if declared[client.ObjectKeyFromObject(&policy)] {
+			continue
+		}
+		err := r.Client.Delete(ctx, &policy)
+		if client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *AppReconciler) reconcileNetworkPolicies(ctx context.Context, app *v1.App, policies []isolationPolicy) error {
+	existing := &networkingv1.NetworkPolicyList{}
+	err := r.Client.List(ctx, existing, client.MatchingLabels(appLabels(app)), client.HasLabels{v1.IsolationLabel})
+	if err != nil {
+		return err
+	}
+
+	declared := map[client.ObjectKey]bool{}
+	for _, p := range policies {
+		desired := isolationNetworkPolicy(app, p)
+		declared[client.ObjectKeyFromObject(desired)] = true
+		err := r.applyNetworkPolicy(ctx, app, desired)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, policy := range existing.Items {
+		if declared[client.ObjectKeyFromObject(&policy)] {
+			continue
+		}
+		err := r.Client.Delete(ctx, &policy)
+		if client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyNetworkPolicy creates the NetworkPolicy or updates its spec if it has changed.
+func (r *AppReconciler) applyNetworkPolicy(ctx context.Context, app *v1.App, desired *networkingv1.NetworkPolicy) error {
+	existing := &networkingv1.NetworkPolicy{}
+	err := r.Client.Get(ctx, client.ObjectKeyFromObject(desired), existing)
+	switch {
+	case errors.IsNotFound(err):
+		return r.create(ctx, app, desired)
+	case err != nil:
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(existing.Spec, desired.Spec) {
+		return r.addLabels(ctx, existing, desired.Labels)
+	}
+	existing.Spec = desired.Spec
+	if existing.Labels == nil {
+		existing.Labels = map[string]string{}
+	}
+	for k, v := range desired.Labels {
+		existing.Labels[k] = v
+	}
+	for k, v := range appLabels(app) {
+		existing.Labels[k] = v
+	}
+	return r.Client.Update(ctx, existing)
+}
+
+// isolationAuthPolicy returns the Istio AuthorizationPolicy of the isolation policy. Once a namespace
+// has an ALLOW policy, traffic which isn't allowed by any policy of the namespace is denied.
+func isolationAuthPolicy(app *v1.App, p isolationPolicy) *unstructured.Unstructured {
+	namespaces := []any{}
+	for _, namespace := range p.Sources {
+		namespaces = append(namespaces, namespace)
+	}
+
+	policy := &unstructured.Unstructured{}
+	policy.SetGroupVersionKind(AuthorizationPolicyGVK)
+	policy.SetName(p.Name)
+	policy.SetNamespace(p.Namespace)
+	policy.SetLabels(isolationLabels(app))
+	policy.Object["spec"] = map[string]any{
+		"action": "ALLOW",
+		"rules": []any{
+			map[string]any{
+				"from": []any{
+					map[string]any{
+						"source": map[string]any{
+							"namespaces": namespaces,
+						},
+					},
+				},
+			},
+		},
+	}
+	return policy
+}
+
+// isolationNetworkPolicy returns the NetworkPolicy of the isolation policy. Once a pod is selected by an
+// ingress policy, traffic which isn't allowed by any policy of the namespace is denied.
+func isolationNetworkPolicy(app *v1.App, p isolationPolicy) *networkingv1.NetworkPolicy {
+	peers := []networkingv1.NetworkPolicyPeer{}
+	for _, namespace := range p.Sources {
+		peers = append(peers, networkingv1.NetworkPolicyPeer{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{namespaceNameLabel: namespace},
+			},
+		})
+	}
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      p.Name,
+			Namespace: p.Namespace,
+			Labels:    isolationLabels(app),
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{
+				{From: peers},
+			},
+		},
+	}
+}
+
+// isolationLabels returns the labels of the isolation policies of the App.
+func isolationLabels(app *v1.App) map[string]string {
+	labels := appLabels(app)
+	labels[v1.IsolationLabel] = "true"
+	return labels
+}
+
+// withoutIsolation selects the objects of the App which aren't isolation policies.
+func withoutIsolation(app *v1.App) client.MatchingLabelsSelector {
+	selector := labels.SelectorFromSet(appLabels(app))
+	requirement, _ := labels.NewRequirement(v1.IsolationLabel, selection.DoesNotExist, nil)
+	return client.MatchingLabelsSelector{Selector: selector.Add(*requirement)}
+}
+
+// hostnameNamespace returns the namespace of a <service>.<namespace> hostname.
+func hostnameNamespace(hostname string) string {
+	_, namespace, _ := strings.Cut(hostname, ".")
+	namespace, _, _ = strings.Cut(namespace, ".")
+	return namespace
+}
+
+func uniqueNamespaces(namespaces []string) []string {
+	unique := []string{}
+	for _, namespace := range namespaces {
+		if namespace != "" && !slices.Contains(unique, namespace) {
+			unique = append(unique, namespace)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,153 @@
+package apps
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+	"github.com/home-cloud-io/core/pkg/install/resources"
+)
+
+func TestIsolationPolicies(t *testing.T) {
+	tests := []struct {
+		name      string
+		isolation *v1.IsolationSpec
+		appConfig *AppConfig
+		want      []isolationPolicy
+	}{
+		{
+			name:      "disabled",
+			appConfig: &AppConfig{Namespace: "immich"},
+		},
+		{
+			name:      "without routes or dependencies",
+			isolation: &v1.IsolationSpec{Enable: true},
+			appConfig: &AppConfig{Namespace: "immich"},
+			want: []isolationPolicy{
+				{Name: IsolationPolicyName, Namespace: "immich", Sources: []string{"immich", "home-cloud-system"}},
+			},
+		},
+		{
+			name:      "routes, dependencies and databases",
+			isolation: &v1.IsolationSpec{Enable: true, AllowNamespaces: []string{"monitoring"}},
+			appConfig: &AppConfig{
+				Namespace:    "immich",
+				Routes:       []AppRoute{{Name: "immich"}},
+				Dependencies: []string{"redis", "postgres"},
+				Databases:    []AppDatabase{{Name: "immich", Type: "postgres"}},
+			},
+			want: []isolationPolicy{
+				{Name: IsolationPolicyName, Namespace: "immich", Sources: []string{"immich", "home-cloud-system", "istio-system", "monitoring"}},
+				{Name: "home-cloud-allow-immich", Namespace: "redis", Sources: []string{"immich"}},
+				{Name: "home-cloud-allow-immich", Namespace: "postgres", Sources: []string{"immich", "home-cloud-system"}},
+			},
+		},
+		{
+			name:      "mysql database",
+			isolation: &v1.IsolationSpec{Enable: true},
+			appConfig: &AppConfig{
+				Namespace: "nextcloud",
+				Databases: []AppDatabase{{Name: "nextcloud", Type: "mysql"}},
+			},
+			want: []isolationPolicy{
+				{Name: IsolationPolicyName, Namespace: "nextcloud", Sources: []string{"nextcloud", "home-cloud-system"}},
+				{Name: "home-cloud-allow-nextcloud", Namespace: "mysql", Sources: []string{"nextcloud", "home-cloud-system"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			install := resources.DefaultInstall.DeepCopy()
+			install.Spec.Isolation = tt.isolation
+			assert.Equal(t, tt.want, isolationPolicies(install, tt.appConfig))
+		})
+	}
+}
+
+func TestReconcileIsolation(t *testing.T) {
+	app := &v1.App{ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "home-cloud-system"}}
+	install := &v1.Install{
+		ObjectMeta: metav1.ObjectMeta{Name: "install", Namespace: "home-cloud-system"},
+		Spec:       v1.InstallSpec{Isolation: &v1.IsolationSpec{Enable: true}},
+	}
+	appConfig := &AppConfig{
+		Namespace: "immich",
+		Databases: []AppDatabase{{Name: "immich", Type: "postgres"}},
+	}
+	r := newTestReconciler(install)
+	ctx := context.Background()
+
+	authPolicies := func() map[string][]any {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(AuthorizationPolicyGVK.GroupVersion().WithKind(AuthorizationPolicyGVK.Kind + "List"))
+		err := r.List(ctx, list)
+		assert.NoError(t, err)
+		got := map[string][]any{}
+		for _, policy := range list.Items {
+			rules, _, _ := unstructured.NestedSlice(policy.Object, "spec", "rules")
+			got[policy.GetNamespace()+"/"+policy.GetName()] = rules
+		}
+		return got
+	}
+	networkPolicies := func() []string {
+		list := &networkingv1.NetworkPolicyList{}
+		err := r.List(ctx, list)
+		assert.NoError(t, err)
+		got := []string{}
+		for _, policy := range list.Items {
+			got = append(got, policy.Namespace+"/"+policy.Name)
+		}
+		return got
+	}
+
+	// istio
+	err := r.reconcileIsolation(ctx, app, appConfig)
+	assert.NoError(t, err)
+	policies := authPolicies()
+	assert.Len(t, policies, 2)
+	assert.Contains(t, policies, "immich/home-cloud-isolation")
+	assert.Contains(t, policies, "postgres/home-cloud-allow-immich")
+
+	// the operator can reach postgres to create the database of the App
+	assert.Equal(t, []any{
+		map[string]any{
+			"from": []any{
+				map[string]any{
+					"source": map[string]any{
+						"namespaces": []any{"immich", "home-cloud-system"},
+					},
+				},
+			},
+		},
+	}, policies["postgres/home-cloud-allow-immich"])
+
+	// policies authenticating routes don't remove the isolation policies
+	err = r.reconcileAuthPolicies(ctx, app, nil)
+	assert.NoError(t, err)
+	assert.Len(t, authPolicies(), 2)
+
+	// without istio network policies are used instead
+	install.Spec.Istio = &v1.IstioSpec{Disable: true}
+	err = r.Update(ctx, install)
+	assert.NoError(t, err)
+	err = r.reconcileIsolation(ctx, app, appConfig)
+	assert.NoError(t, err)
+	assert.Empty(t, authPolicies())
+	assert.ElementsMatch(t, []string{"immich/home-cloud-isolation", "postgres/home-cloud-allow-immich"}, networkPolicies())
+
+	// a dependency which is no longer used is no longer allowed
+	appConfig.Databases = nil
+	err = r.reconcileIsolation(ctx, app, appConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"immich/home-cloud-isolation"}, networkPolicies())
+
+	// uninstalling removes all policies
+	err = r.removeIsolation(ctx, app)
+	assert.NoError(t, err)
+	assert.Empty(t, networkPolicies())
+}
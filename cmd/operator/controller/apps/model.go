@@ -1,5 +1,9 @@
 package apps
 
+import (
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
 type (
 	AppValues struct {
 		Config AppConfig `yaml:"homeCloud"`
@@ -10,10 +14,31 @@ type (
 		Databases   []AppDatabase
 		Persistence []AppPersistence
 		Secrets     []AppSecret
+		OIDC        *AppOIDC `yaml:"oidc"`
+		// Resources is the CPU and memory budget the chart needs (see v1.ResourcesSpec)
+		Resources *v1.ResourcesSpec
+		// Dependencies are the names of the charts the chart depends on (i.e. the Apps installed
+		// alongside it), taken from the chart metadata
+		Dependencies []string `yaml:"-"`
 	}
 	AppRoute struct {
 		Name    string
 		Service AppService
+		// Hostnames are additional hostnames of the route (supports the same placeholders as the
+		// route hostnames of the Install). They must be subdomains of the Home Cloud hostname or match
+		// a route hostname template of the Install and not be routed by another App.
+		Hostnames []string
+		// Rules route requests to services by path prefix. Requests are routed to Service if no rules
+		// are set.
+		Rules []AppRouteRule
+		// Auth requires users to sign in with their Home Cloud account before requests reach the App.
+		// The username and email are passed to the App in the X-Forwarded-User and X-Forwarded-Email
+		// headers.
+		Auth bool
+	}
+	AppRouteRule struct {
+		Path    string
+		Service AppService
 	}
 	AppService struct {
 		Name string
@@ -32,9 +57,59 @@ type (
 		Name string
 		Keys []SecretKey
 	}
+	// SecretKey is a generated value of a Secret. Most types write more than one key to the Secret
+	// (see SecretKeyType).
 	SecretKey struct {
-		Name                string
+		Name string
+		// Type of the generated value (default: password)
+		Type SecretKeyType
+		// Length of a password (default: 24) or the number of random bytes (default: 32)
 		Length              int
 		NoSpecialCharacters bool `yaml:"noSpecialCharacters"`
+		// Bits is the size of an RSA key (default: 2048)
+		Bits int
+		// Username of an htpasswd entry (default: admin)
+		Username string
+		// Hosts are the hostnames and IP addresses of a TLS certificate (default: Name)
+		Hosts []string
+		// ValidDays is how long a TLS certificate is valid for (default: 3650)
+		ValidDays int `yaml:"validDays"`
+	}
+	// SecretKeyType is a kind of generated value. Each type writes the keys listed next to it where
+	// <name> is the name of the SecretKey.
+	SecretKeyType string
+	// AppOIDC registers the App as a client of the Home Cloud identity provider. The client ID, secret
+	// and issuer URL are written to a Secret in the App namespace.
+	AppOIDC struct {
+		// Secret is the name of the Secret the client is written to (default: <app>-oidc)
+		Secret string
+		// Route is the route which users are sent back to after signing in: RedirectPaths are
+		// registered on each of its hostnames.
+		Route         string
+		RedirectPaths []string `yaml:"redirectPaths"`
+		// RedirectURIs are registered as is (e.g. for mobile apps)
+		RedirectURIs []string `yaml:"redirectURIs"`
 	}
 )
+
+const (
+	// <name>: a random password
+	SecretKeyTypePassword SecretKeyType = "password"
+	// <name>: random bytes, hex encoded
+	SecretKeyTypeHex SecretKeyType = "hex"
+	// <name>: random bytes, base64 encoded
+	SecretKeyTypeBase64 SecretKeyType = "base64"
+	// <name>: a random UUID
+	SecretKeyTypeUUID SecretKeyType = "uuid"
+	// <name>, <name>.pub: a PEM encoded RSA private key (PKCS #8) and its public key
+	SecretKeyTypeRSA SecretKeyType = "rsa"
+	// <name>, <name>.pub: a PEM encoded Ed25519 private key (PKCS #8) and its public key
+	SecretKeyTypeEd25519 SecretKeyType = "ed25519"
+	// <name>, <name>.bcrypt: a random password and its bcrypt hash
+	SecretKeyTypeBcrypt SecretKeyType = "bcrypt"
+	// <name>, <name>.htpasswd: a random password and an htpasswd entry of Username with it
+	SecretKeyTypeHtpasswd SecretKeyType = "htpasswd"
+	// <name>.crt, <name>.key: a PEM encoded self-signed certificate and its private key (a SecretKey
+	// named "tls" gives the keys of a kubernetes.io/tls Secret)
+	SecretKeyTypeTLS SecretKeyType = "tls"
+)
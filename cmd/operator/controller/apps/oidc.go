@@ -0,0 +1,139 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+	"github.com/home-cloud-io/core/cmd/operator/controller/secrets"
+	"github.com/home-cloud-io/core/pkg/install/resources"
+)
+
+// OIDCClientID returns the ID of the OIDC client of the App. It includes the namespace of the App so
+// that it is unique across all Apps.
+func OIDCClientID(app *v1.App) string {
+	return app.Name + "." + app.Namespace
+}
+
+// OIDCSecretName returns the name of the Secret the OIDC client of the App is written to.
+func OIDCSecretName(app *v1.App, o AppOIDC) string {
+	if o.Secret != "" {
+		return o.Secret
+	}
+	return app.Name + "-oidc"
+}
+
+// createOIDCClient registers the App as a client of the identity provider of the operator by writing
+// the client to a labelled Secret in the App namespace. The client secret is generated once and kept,
+// the redirect URIs and issuer URL follow the current routes and Install.
+func (r *AppReconciler) createOIDCClient(ctx context.Context, app *v1.App, appConfig *AppConfig) error {
+	o := *appConfig.OIDC
+	install, err := r.getInstall(ctx)
+	if err != nil {
+		return err
+	}
+	redirectURIs, err := oidcRedirectURIs(install, appConfig)
+	if err != nil {
+		return err
+	}
+
+	desired := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      OIDCSecretName(app, o),
+			Namespace: appConfig.Namespace,
+			Labels: map[string]string{
+				v1.OIDCClientLabel: "true",
+			},
+			Annotations: map[string]string{
+				v1.OIDCRedirectURIsAnnotation: strings.Join(redirectURIs, ","),
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			v1.OIDCClientIDKey:    []byte(OIDCClientID(app)),
+			v1.OIDCIssuerURLKey:   []byte(resources.IssuerURL(install)),
+			v1.OIDCInternalURLKey: []byte(resources.InternalIssuerURL(install)),
+		},
+	}
+
+	existing := &corev1.Secret{}
+	err = r.Client.Get(ctx, types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}, existing)
+	if errors.IsNotFound(err) {
+		secret, err := secrets.Generate(32, true)
+		if err != nil {
+			return err
+		}
+		desired.Data[v1.OIDCClientSecretKey] = secret
+		return r.create(ctx, app, desired)
+	}
+	if err != nil {
+		return err
+	}
+
+	// keep the client secret (the App may already be using it) and bring everything else up to date
+	if len(existing.Data[v1.OIDCClientSecretKey]) == 0 {
+		secret, err := secrets.Generate(32, true)
+		if err != nil {
+			return err
+		}
+		desired.Data[v1.OIDCClientSecretKey] = secret
+	} else {
+		desired.Data[v1.OIDCClientSecretKey] = existing.Data[v1.OIDCClientSecretKey]
+	}
+	if existing.Labels == nil {
+		existing.Labels = map[string]string{}
+	}
+	if existing.Annotations == nil {
+		existing.Annotations = map[string]string{}
+	}
+	if existing.Data == nil {
+		existing.Data = map[string][]byte{}
+	}
+	maps.Copy(existing.Labels, desired.Labels)
+	maps.Copy(existing.Labels, appLabels(app))
+	maps.Copy(existing.Annotations, desired.Annotations)
+	maps.Copy(existing.Data, desired.Data)
+	return r.Client.Update(ctx, existing)
+}
+
+// oidcRedirectURIs returns the redirect URIs of the OIDC client of the App: the redirect paths on every
+// hostname of the route (over both HTTP and HTTPS) followed by the URIs given as is.
+func oidcRedirectURIs(install *v1.Install, appConfig *AppConfig) ([]string, error) {
+	o := appConfig.OIDC
+	uris := []string{}
+	if o.Route != "" {
+		var route *AppRoute
+		for i := range appConfig.Routes {
+			if appConfig.Routes[i].Name == o.Route {
+				route = &appConfig.Routes[i]
+			}
+		}
+		if route == nil {
+			return nil, fmt.Errorf("oidc route %s is not a route of the app", o.Route)
+		}
+		// rejected hostnames aren't routed so they aren't redirect URIs either
+		hostnames, _ := routeHostnames(install, *route)
+		for _, hostname := range hostnames {
+			for _, scheme := range []string{"https", "http"} {
+				for _, path := range o.RedirectPaths {
+					if !strings.HasPrefix(path, "/") {
+						path = "/" + path
+					}
+					uris = append(uris, scheme+"://"+hostname+path)
+				}
+			}
+		}
+	}
+	uris = append(uris, o.RedirectURIs...)
+	if len(uris) == 0 {
+		return nil, fmt.Errorf("oidc client has no redirect URIs")
+	}
+	return uris, nil
+}
@@ -0,0 +1,64 @@
+package apps
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+func TestCreateOIDCClient(t *testing.T) {
+	app := &v1.App{ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "home-cloud-system"}}
+	install := &v1.Install{ObjectMeta: metav1.ObjectMeta{Name: "install", Namespace: "home-cloud-system"}}
+	appConfig := &AppConfig{
+		Namespace: "immich",
+		Routes:    []AppRoute{{Name: "immich", Service: AppService{Name: "server", Port: 80}}},
+		OIDC: &AppOIDC{
+			Route:         "immich",
+			RedirectPaths: []string{"/auth/callback"},
+			RedirectURIs:  []string{"app.immich:///oauth-callback"},
+		},
+	}
+	r := newTestReconciler(install)
+	ctx := context.Background()
+
+	err := r.createOIDCClient(ctx, app, appConfig)
+	assert.NoError(t, err)
+
+	secret := &corev1.Secret{}
+	err = r.Get(ctx, types.NamespacedName{Namespace: "immich", Name: "immich-oidc"}, secret)
+	assert.NoError(t, err)
+	assert.Equal(t, "true", secret.Labels[v1.OIDCClientLabel])
+	assert.Equal(t, "immich", secret.Labels[v1.AppLabel])
+	assert.Equal(t, "immich.home-cloud-system", string(secret.Data[v1.OIDCClientIDKey]))
+	assert.Len(t, secret.Data[v1.OIDCClientSecretKey], 32)
+	assert.Contains(t, string(secret.Data[v1.OIDCIssuerURLKey]), "://home-cloud.local/oidc")
+	assert.Equal(t, "http://operator.home-cloud-system.svc.cluster.local/oidc", string(secret.Data[v1.OIDCInternalURLKey]))
+	assert.Equal(t,
+		"https://immich.local/auth/callback,http://immich.local/auth/callback,app.immich:///oauth-callback",
+		secret.Annotations[v1.OIDCRedirectURIsAnnotation])
+	clientSecret := secret.Data[v1.OIDCClientSecretKey]
+
+	// new hostnames are registered but the client secret is kept
+	install.Spec.Settings = &v1.SettingsSpec{RouteHostnames: []string{"{route}.example.com"}}
+	err = r.Update(ctx, install)
+	assert.NoError(t, err)
+	err = r.createOIDCClient(ctx, app, appConfig)
+	assert.NoError(t, err)
+	err = r.Get(ctx, types.NamespacedName{Namespace: "immich", Name: "immich-oidc"}, secret)
+	assert.NoError(t, err)
+	assert.Equal(t, clientSecret, secret.Data[v1.OIDCClientSecretKey])
+	assert.Equal(t,
+		"https://immich.example.com/auth/callback,http://immich.example.com/auth/callback,app.immich:///oauth-callback",
+		secret.Annotations[v1.OIDCRedirectURIsAnnotation])
+
+	// the route must be one of the App
+	appConfig.OIDC.Route = "photos"
+	err = r.createOIDCClient(ctx, app, appConfig)
+	assert.Error(t, err)
+}
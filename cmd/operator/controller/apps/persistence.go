@@ -2,84 +2,338 @@ package apps
 
 import (
 	"context"
+	goerrors "errors"
 	"fmt"
+	"path"
 
 	"connectrpc.com/connect"
-	"dario.cat/mergo"
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	v1 "github.com/home-cloud-io/core/api/crds/v1"
 	dv1 "github.com/home-cloud-io/core/api/platform/daemon/v1"
 	"github.com/home-cloud-io/core/cmd/operator/controller/daemon"
-	"github.com/home-cloud-io/core/pkg/install/resources"
 )
 
-// TODO: think about making this pluggable for different types of PV sources (ie. not just host path)
-
 const (
 	// if daemon is disabled, the user is responsible for creating this hostPath so that
 	// Home Cloud can provision PersistentVolumes against it
 	DefaultHostPath = "/mnt/home-cloud"
+
+	// NFSImage is used to create the volume directories on NFS exports
+	NFSImage = "alpine:3.22"
+
+	// PersistenceBackendAnnotation records the backend a PVC was provisioned with so that it is
+	// cleaned up the same way even if the configured backend changes.
+	PersistenceBackendAnnotation = "apps.home-cloud.io/persistence-backend"
+
+	// storage class of the statically provisioned (hostPath and NFS) volumes
+	manualStorageClassName = "manual"
 )
 
+// errVolumeResizeFailed is returned when the volume of a PVC couldn't be expanded.
+var errVolumeResizeFailed = goerrors.New("volume resize failed")
+
+// dependenciesFailedReason returns the reason of the DependenciesReady condition for an error
+// creating the dependencies of an App so that failed volume resizes stand out.
+func dependenciesFailedReason(err error) string {
+	if goerrors.Is(err, errVolumeResizeFailed) {
+		return "VolumeResizeFailed"
+	}
+	return "DependenciesFailed"
+}
+
+// persistenceSpec returns the persistence configuration for the App: the App override if set and
+// otherwise the configuration of the Install.
+func persistenceSpec(install *v1.Install, app *v1.App) v1.PersistenceSpec {
+	spec := v1.PersistenceSpec{}
+	switch {
+	case app.Spec.Persistence != nil:
+		spec = *app.Spec.Persistence
+	case install.Spec.Persistence != nil:
+		spec = *install.Spec.Persistence
+	}
+	if spec.Backend == "" {
+		spec.Backend = v1.PersistenceBackendHostPath
+	}
+	return spec
+}
+
 func (r *AppReconciler) createPersistence(ctx context.Context, p AppPersistence, app *v1.App, namespace string) error {
-	var (
-		objName          = fmt.Sprintf("%s-%s", app.Spec.Release, p.Name)
-		storageClassName = "manual"
-	)
+	objName := VolumeName(app, p)
+
+	quantity, err := resource.ParseQuantity(p.Size)
+	if err != nil {
+		return err
+	}
 
 	// get current install config
-	install := &v1.Install{}
-	err := r.Client.Get(ctx, types.NamespacedName{
-		Name:      "install",
-		Namespace: "home-cloud-system",
-	}, install)
+	install, err := r.getInstall(ctx)
 	if err != nil {
 		return err
 	}
+	spec := persistenceSpec(install, app)
+
+	// volumes that already exist are expanded instead if the requested size has grown
+	pvc := &corev1.PersistentVolumeClaim{}
+	err = r.Client.Get(ctx, types.NamespacedName{
+		Name:      objName,
+		Namespace: namespace,
+	}, pvc)
+	if err == nil {
+		err = r.addLabels(ctx, pvc, appLabels(app))
+		if err != nil {
+			return err
+		}
+		return r.expandPersistence(ctx, install, pvc, p, quantity)
+	}
+	if !errors.IsNotFound(err) {
+		return err
+	}
+
+	var (
+		storageClassName *string
+		accessMode       = corev1.ReadWriteMany
+	)
+	switch spec.Backend {
+	case v1.PersistenceBackendHostPath:
+		hostPath, err := r.createHostPathVolume(ctx, install, spec, objName, p)
+		if err != nil {
+			return err
+		}
+		err = r.createPersistentVolume(ctx, app, objName, namespace, "local", quantity, corev1.PersistentVolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{
+				Path: hostPath,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		storageClassName = ptr.To(manualStorageClassName)
+	case v1.PersistenceBackendNFS:
+		if spec.NFS == nil {
+			return fmt.Errorf("nfs persistence backend requires nfs server and path")
+		}
+		err = r.createNFSDirectory(ctx, spec.NFS, objName, namespace)
+		if err != nil {
+			return err
+		}
+		err = r.createPersistentVolume(ctx, app, objName, namespace, "nfs", quantity, corev1.PersistentVolumeSource{
+			NFS: &corev1.NFSVolumeSource{
+				Server: spec.NFS.Server,
+				Path:   path.Join(spec.NFS.Path, objName),
+			},
+		})
+		if err != nil {
+			return err
+		}
+		storageClassName = ptr.To(manualStorageClassName)
+	case v1.PersistenceBackendStorageClass:
+		// leave provisioning to the storage class (or the default storage class if none is set)
+		accessMode = corev1.ReadWriteOnce
+		if spec.StorageClass != nil {
+			if spec.StorageClass.Name != "" {
+				storageClassName = ptr.To(spec.StorageClass.Name)
+			}
+			if spec.StorageClass.AccessMode != "" {
+				accessMode = corev1.PersistentVolumeAccessMode(spec.StorageClass.AccessMode)
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported persistence backend: %s", spec.Backend)
+	}
 
-	// set defaults: any values set on the resource will override the defaults
-	err = mergo.Merge(install, resources.DefaultInstall)
+	// create PVC
+	return r.create(ctx, app, &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      objName,
+			Namespace: namespace,
+			Annotations: map[string]string{
+				PersistenceBackendAnnotation: string(spec.Backend),
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			StorageClassName: storageClassName,
+			AccessModes: []corev1.PersistentVolumeAccessMode{
+				accessMode,
+			},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: quantity,
+				},
+			},
+		},
+	})
+}
+
+// expandPersistence grows the volume of the given PVC if the requested size is larger than its current
+// size. Dynamically provisioned volumes are expanded by their storage class once the PVC request is
+// raised and their resize errors are returned. Statically provisioned volumes are grown through the
+// daemon (hostPath) and then the PV capacity is raised to match: the PVC status is left to Kubernetes.
+func (r *AppReconciler) expandPersistence(ctx context.Context, install *v1.Install, pvc *corev1.PersistentVolumeClaim, p AppPersistence, quantity resource.Quantity) error {
+	l := log.FromContext(ctx).WithValues("volume", pvc.Name)
+
+	if provisionedBackend(pvc) == v1.PersistenceBackendStorageClass {
+		err := resizeError(pvc)
+		if err != nil {
+			return err
+		}
+		current := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		if quantity.Cmp(current) <= 0 {
+			warnShrink(l, current, quantity)
+			return nil
+		}
+		l.Info("Expanding volume", "from", current.String(), "to", quantity.String())
+		patch := client.MergeFrom(pvc.DeepCopy())
+		pvc.Spec.Resources.Requests[corev1.ResourceStorage] = quantity
+		return r.Client.Patch(ctx, pvc, patch)
+	}
+
+	pv := &corev1.PersistentVolume{}
+	err := r.Client.Get(ctx, types.NamespacedName{
+		Name: pvc.Name,
+	}, pv)
 	if err != nil {
 		return err
 	}
+	current := pv.Spec.Capacity[corev1.ResourceStorage]
+	if quantity.Cmp(current) <= 0 {
+		warnShrink(l, current, quantity)
+		return nil
+	}
+	l.Info("Expanding volume", "from", current.String(), "to", quantity.String())
+
+	// grow the underlying volume before advertising the new capacity
+	if pv.Spec.HostPath != nil && !install.Spec.Daemon.Disable {
+		_, err := daemon.DaemonClient(install.Spec.Daemon.Address).ResizeVolume(ctx, connect.NewRequest(&dv1.ResizeVolumeRequest{
+			Id:      volumeID(pvc.Name),
+			MinSize: p.Size,
+			// TODO: update App spec to have min/max
+			MaxSize: p.Size,
+		}))
+		if err != nil {
+			return fmt.Errorf("%w: %s", errVolumeResizeFailed, err.Error())
+		}
+	}
+
+	patch := client.MergeFrom(pv.DeepCopy())
+	pv.Spec.Capacity[corev1.ResourceStorage] = quantity
+	return r.Client.Patch(ctx, pv, patch)
+}
+
+// resizeError returns the error Kubernetes reported while expanding the volume of the PVC (if any).
+func resizeError(pvc *corev1.PersistentVolumeClaim) error {
+	for _, condition := range pvc.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		if condition.Type == corev1.PersistentVolumeClaimControllerResizeError || condition.Type == corev1.PersistentVolumeClaimNodeResizeError {
+			return fmt.Errorf("%w: %s", errVolumeResizeFailed, condition.Message)
+		}
+	}
+	switch status := pvc.Status.AllocatedResourceStatuses[corev1.ResourceStorage]; status {
+	case corev1.PersistentVolumeClaimControllerResizeInfeasible, corev1.PersistentVolumeClaimNodeResizeInfeasible:
+		return fmt.Errorf("%w: %s", errVolumeResizeFailed, status)
+	}
+	return nil
+}
 
-	hostPath := fmt.Sprintf("%s/%s", DefaultHostPath, objName)
+// warnShrink logs when the requested size of a volume is smaller than the current size since volumes
+// can't be shrunk.
+func warnShrink(l logr.Logger, current resource.Quantity, requested resource.Quantity) {
+	if requested.Cmp(current) < 0 {
+		l.Info("Ignoring requested volume size smaller than the current size", "current", current.String(), "requested", requested.String())
+	}
+}
 
+// createHostPathVolume returns the host path of the volume, creating it through the daemon if the
+// daemon is enabled.
+func (r *AppReconciler) createHostPathVolume(ctx context.Context, install *v1.Install, spec v1.PersistenceSpec, name string, p AppPersistence) (string, error) {
 	// if daemon is enabled, create volume before creating PV/PVC and use the returned path
 	if !install.Spec.Daemon.Disable {
 		resp, err := daemon.DaemonClient(install.Spec.Daemon.Address).CreateVolume(ctx, connect.NewRequest(&dv1.CreateVolumeRequest{
-			Name:    objName,
+			Name:    name,
 			MinSize: p.Size,
 			// TODO: update App spec to have min/max
 			MaxSize: p.Size,
 		}))
 		if err != nil {
-			return err
+			return "", err
 		}
-
-		hostPath = resp.Msg.Path
+		return resp.Msg.Path, nil
 	}
 
-	quantity, err := resource.ParseQuantity(p.Size)
-	if err != nil {
-		return err
+	base := DefaultHostPath
+	if spec.HostPath != nil && spec.HostPath.Path != "" {
+		base = spec.HostPath.Path
 	}
+	return path.Join(base, name), nil
+}
 
-	// create PV
-	err = r.Client.Create(ctx, &corev1.PersistentVolume{
+// createNFSDirectory runs a Job which creates the directory of the volume on the NFS export since
+// the volume can't be mounted until it exists. Pods using the volume retry mounting until the Job
+// is done.
+func (r *AppReconciler) createNFSDirectory(ctx context.Context, nfs *v1.NFSPersistenceSpec, name string, namespace string) error {
+	err := r.Client.Create(ctx, &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: objName,
+			Name:      fmt.Sprintf("nfs-%s", name),
+			Namespace: namespace,
+		},
+		Spec: batchv1.JobSpec{
+			TTLSecondsAfterFinished: ptr.To(int32(60 * 60)),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyOnFailure,
+					Containers: []corev1.Container{
+						{
+							Name:    "mkdir",
+							Image:   NFSImage,
+							Command: []string{"mkdir", "-p", path.Join("/nfs", name)},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "nfs",
+									MountPath: "/nfs",
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "nfs",
+							VolumeSource: corev1.VolumeSource{
+								NFS: &corev1.NFSVolumeSource{
+									Server: nfs.Server,
+									Path:   nfs.Path,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	return client.IgnoreAlreadyExists(err)
+}
+
+// createPersistentVolume creates a statically provisioned PV bound to the PVC with the same name.
+func (r *AppReconciler) createPersistentVolume(ctx context.Context, app *v1.App, name string, namespace string, volumeType string, quantity resource.Quantity, source corev1.PersistentVolumeSource) error {
+	return r.create(ctx, app, &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
 			Labels: map[string]string{
-				"type": "local",
+				"type": volumeType,
 			},
 		},
 		Spec: corev1.PersistentVolumeSpec{
-			StorageClassName: storageClassName,
+			StorageClassName: manualStorageClassName,
 			Capacity: corev1.ResourceList{
 				corev1.ResourceStorage: quantity,
 			},
@@ -87,43 +341,96 @@ func (r *AppReconciler) createPersistence(ctx context.Context, p AppPersistence,
 				corev1.ReadWriteMany,
 			},
 			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain,
-			PersistentVolumeSource: corev1.PersistentVolumeSource{
-				HostPath: &corev1.HostPathVolumeSource{
-					Path: hostPath,
-				},
-			},
+			PersistentVolumeSource:        source,
 			ClaimRef: &corev1.ObjectReference{
 				Namespace: namespace,
-				Name:      objName,
+				Name:      name,
 			},
 			// TODO: NodeAffinity
 		},
 	})
-	if client.IgnoreAlreadyExists(err) != nil {
+}
+
+func (r *AppReconciler) deletePersistence(ctx context.Context, p AppPersistence, app *v1.App, namespace string) error {
+	objName := VolumeName(app, p)
+
+	// clean up with the backend the PVC was provisioned with
+	pvc := &corev1.PersistentVolumeClaim{}
+	err := r.Client.Get(ctx, types.NamespacedName{
+		Name:      objName,
+		Namespace: namespace,
+	}, pvc)
+	if client.IgnoreNotFound(err) != nil {
 		return err
 	}
+	backend := provisionedBackend(pvc)
 
-	// create PVC
-	err = r.Client.Create(ctx, &corev1.PersistentVolumeClaim{
+	// delete PVC
+	err = r.Client.Delete(ctx, &corev1.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      objName,
 			Namespace: namespace,
 		},
-		Spec: corev1.PersistentVolumeClaimSpec{
-			StorageClassName: &storageClassName,
-			AccessModes: []corev1.PersistentVolumeAccessMode{
-				corev1.ReadWriteMany,
-			},
-			Resources: corev1.VolumeResourceRequirements{
-				Requests: corev1.ResourceList{
-					corev1.ResourceStorage: quantity,
-				},
-			},
+	})
+	if client.IgnoreNotFound(err) != nil {
+		return err
+	}
+
+	// dynamically provisioned volumes are removed according to the reclaim policy of their storage class
+	if backend == v1.PersistenceBackendStorageClass {
+		return nil
+	}
+
+	// delete PV (the reclaim policy is Retain so this does not remove the data)
+	err = r.Client.Delete(ctx, &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: objName,
 		},
 	})
-	if client.IgnoreAlreadyExists(err) != nil {
+	if client.IgnoreNotFound(err) != nil {
 		return err
 	}
 
+	// data on NFS exports is left in place
+	if backend == v1.PersistenceBackendNFS {
+		return nil
+	}
+
+	// get current install config
+	install, err := r.getInstall(ctx)
+	if err != nil {
+		return err
+	}
+
+	// if daemon is enabled, delete the volume backing the PV
+	if !install.Spec.Daemon.Disable {
+		_, err := daemon.DaemonClient(install.Spec.Daemon.Address).DeleteVolume(ctx, connect.NewRequest(&dv1.DeleteVolumeRequest{
+			Id: volumeID(objName),
+		}))
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// provisionedBackend returns the backend the PVC was provisioned with. PVCs created before backends
+// were configurable are all hostPath.
+func provisionedBackend(pvc *corev1.PersistentVolumeClaim) v1.PersistenceBackend {
+	if b, ok := pvc.Annotations[PersistenceBackendAnnotation]; ok {
+		return v1.PersistenceBackend(b)
+	}
+	return v1.PersistenceBackendHostPath
+}
+
+// VolumeName returns the name of the PV and PVC created for the given persistence of the App.
+func VolumeName(app *v1.App, p AppPersistence) string {
+	return fmt.Sprintf("%s-%s", app.Spec.Release, p.Name)
+}
+
+// volumeID returns the daemon volume identifier for the given volume name. This matches the
+// id returned by the daemon from CreateVolume.
+func volumeID(name string) string {
+	return fmt.Sprintf("u-%s", name)
+}
@@ -0,0 +1,233 @@
+package apps
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+func TestPersistenceSpec(t *testing.T) {
+	storageClass := &v1.PersistenceSpec{
+		Backend:      v1.PersistenceBackendStorageClass,
+		StorageClass: &v1.StorageClassPersistenceSpec{Name: "longhorn"},
+	}
+	nfs := &v1.PersistenceSpec{
+		Backend: v1.PersistenceBackendNFS,
+		NFS:     &v1.NFSPersistenceSpec{Server: "nas.local", Path: "/export/home-cloud"},
+	}
+	tests := []struct {
+		name    string
+		install *v1.PersistenceSpec
+		app     *v1.PersistenceSpec
+		want    v1.PersistenceSpec
+	}{
+		{name: "default", want: v1.PersistenceSpec{Backend: v1.PersistenceBackendHostPath}},
+		{name: "install", install: storageClass, want: *storageClass},
+		{name: "app override", install: storageClass, app: nfs, want: *nfs},
+		{
+			name:    "empty backend",
+			install: &v1.PersistenceSpec{HostPath: &v1.HostPathPersistenceSpec{Path: "/data"}},
+			want:    v1.PersistenceSpec{Backend: v1.PersistenceBackendHostPath, HostPath: &v1.HostPathPersistenceSpec{Path: "/data"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			install := &v1.Install{Spec: v1.InstallSpec{Persistence: tt.install}}
+			app := &v1.App{Spec: v1.AppSpec{Persistence: tt.app}}
+			assert.Equal(t, tt.want, persistenceSpec(install, app))
+		})
+	}
+}
+
+func TestCreatePersistence(t *testing.T) {
+	tests := []struct {
+		name             string
+		persistence      *v1.PersistenceSpec
+		wantStorageClass *string
+		wantAccessMode   corev1.PersistentVolumeAccessMode
+		wantSource       *corev1.PersistentVolumeSource
+		wantNFSJob       bool
+	}{
+		{
+			name:             "host path",
+			persistence:      &v1.PersistenceSpec{HostPath: &v1.HostPathPersistenceSpec{Path: "/data"}},
+			wantStorageClass: ptr.To("manual"),
+			wantAccessMode:   corev1.ReadWriteMany,
+			wantSource:       &corev1.PersistentVolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/data/immich-library"}},
+		},
+		{
+			name: "storage class",
+			persistence: &v1.PersistenceSpec{
+				Backend:      v1.PersistenceBackendStorageClass,
+				StorageClass: &v1.StorageClassPersistenceSpec{Name: "longhorn"},
+			},
+			wantStorageClass: ptr.To("longhorn"),
+			wantAccessMode:   corev1.ReadWriteOnce,
+		},
+		{
+			name:           "default storage class",
+			persistence:    &v1.PersistenceSpec{Backend: v1.PersistenceBackendStorageClass},
+			wantAccessMode: corev1.ReadWriteOnce,
+		},
+		{
+			name: "nfs",
+			persistence: &v1.PersistenceSpec{
+				Backend: v1.PersistenceBackendNFS,
+				NFS:     &v1.NFSPersistenceSpec{Server: "nas.local", Path: "/export/home-cloud"},
+			},
+			wantStorageClass: ptr.To("manual"),
+			wantAccessMode:   corev1.ReadWriteMany,
+			wantSource:       &corev1.PersistentVolumeSource{NFS: &corev1.NFSVolumeSource{Server: "nas.local", Path: "/export/home-cloud/immich-library"}},
+			wantNFSJob:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := newTestReconciler(&v1.Install{
+				ObjectMeta: metav1.ObjectMeta{Name: "install", Namespace: "home-cloud-system"},
+				Spec: v1.InstallSpec{
+					Daemon:      &v1.DaemonSpec{Disable: true},
+					Persistence: tt.persistence,
+				},
+			})
+			app := &v1.App{Spec: v1.AppSpec{Release: "immich"}}
+			p := AppPersistence{Name: "library", Size: "10Gi"}
+
+			err := r.createPersistence(ctx, p, app, "immich")
+			assert.NoError(t, err)
+
+			pvc := &corev1.PersistentVolumeClaim{}
+			err = r.Get(ctx, types.NamespacedName{Namespace: "immich", Name: "immich-library"}, pvc)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStorageClass, pvc.Spec.StorageClassName)
+			assert.Equal(t, []corev1.PersistentVolumeAccessMode{tt.wantAccessMode}, pvc.Spec.AccessModes)
+
+			pv := &corev1.PersistentVolume{}
+			err = r.Get(ctx, types.NamespacedName{Name: "immich-library"}, pv)
+			if tt.wantSource == nil {
+				assert.True(t, errors.IsNotFound(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, *tt.wantSource, pv.Spec.PersistentVolumeSource)
+			}
+
+			err = r.Get(ctx, types.NamespacedName{Namespace: "immich", Name: "nfs-immich-library"}, &batchv1.Job{})
+			assert.Equal(t, tt.wantNFSJob, err == nil)
+
+			// clean up with the backend recorded on the claim even if the configuration changes
+			install := &v1.Install{}
+			assert.NoError(t, r.Get(ctx, types.NamespacedName{Namespace: "home-cloud-system", Name: "install"}, install))
+			install.Spec.Persistence = nil
+			assert.NoError(t, r.Update(ctx, install))
+			assert.NoError(t, r.deletePersistence(ctx, p, app, "immich"))
+			err = r.Get(ctx, types.NamespacedName{Namespace: "immich", Name: "immich-library"}, pvc)
+			assert.True(t, errors.IsNotFound(err))
+		})
+	}
+}
+
+func TestExpandPersistence(t *testing.T) {
+	tests := []struct {
+		name        string
+		persistence *v1.PersistenceSpec
+		size        string
+		wantSize    string
+	}{
+		{name: "host path grows", size: "20Gi", wantSize: "20Gi"},
+		{name: "host path doesn't shrink", size: "5Gi", wantSize: "10Gi"},
+		{name: "storage class grows", persistence: &v1.PersistenceSpec{Backend: v1.PersistenceBackendStorageClass}, size: "20Gi", wantSize: "20Gi"},
+		{name: "storage class doesn't shrink", persistence: &v1.PersistenceSpec{Backend: v1.PersistenceBackendStorageClass}, size: "5Gi", wantSize: "10Gi"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := newTestReconciler(&v1.Install{
+				ObjectMeta: metav1.ObjectMeta{Name: "install", Namespace: "home-cloud-system"},
+				Spec: v1.InstallSpec{
+					Daemon:      &v1.DaemonSpec{Disable: true},
+					Persistence: tt.persistence,
+				},
+			})
+			app := &v1.App{Spec: v1.AppSpec{Release: "immich"}}
+
+			err := r.createPersistence(ctx, AppPersistence{Name: "library", Size: "10Gi"}, app, "immich")
+			assert.NoError(t, err)
+			err = r.createPersistence(ctx, AppPersistence{Name: "library", Size: tt.size}, app, "immich")
+			assert.NoError(t, err)
+
+			want := resource.MustParse(tt.wantSize)
+			pvc := &corev1.PersistentVolumeClaim{}
+			assert.NoError(t, r.Get(ctx, types.NamespacedName{Namespace: "immich", Name: "immich-library"}, pvc))
+			if tt.persistence != nil {
+				// the storage class expands the volume once the request is raised
+				assert.True(t, want.Equal(pvc.Spec.Resources.Requests[corev1.ResourceStorage]))
+				return
+			}
+
+			pv := &corev1.PersistentVolume{}
+			assert.NoError(t, r.Get(ctx, types.NamespacedName{Name: "immich-library"}, pv))
+			assert.True(t, want.Equal(pv.Spec.Capacity[corev1.ResourceStorage]))
+			// the PVC status is left to Kubernetes
+			assert.Empty(t, pvc.Status.Capacity)
+		})
+	}
+}
+
+func TestExpandPersistenceResizeError(t *testing.T) {
+	tests := []struct {
+		name   string
+		status corev1.PersistentVolumeClaimStatus
+	}{
+		{
+			name: "controller resize error",
+			status: corev1.PersistentVolumeClaimStatus{
+				Conditions: []corev1.PersistentVolumeClaimCondition{
+					{Type: corev1.PersistentVolumeClaimControllerResizeError, Status: corev1.ConditionTrue, Message: "quota exceeded"},
+				},
+			},
+		},
+		{
+			name: "node resize infeasible",
+			status: corev1.PersistentVolumeClaimStatus{
+				AllocatedResourceStatuses: map[corev1.ResourceName]corev1.ClaimResourceStatus{
+					corev1.ResourceStorage: corev1.PersistentVolumeClaimNodeResizeInfeasible,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := newTestReconciler(&v1.Install{
+				ObjectMeta: metav1.ObjectMeta{Name: "install", Namespace: "home-cloud-system"},
+				Spec: v1.InstallSpec{
+					Daemon:      &v1.DaemonSpec{Disable: true},
+					Persistence: &v1.PersistenceSpec{Backend: v1.PersistenceBackendStorageClass},
+				},
+			})
+			app := &v1.App{Spec: v1.AppSpec{Release: "immich"}}
+
+			err := r.createPersistence(ctx, AppPersistence{Name: "library", Size: "10Gi"}, app, "immich")
+			assert.NoError(t, err)
+			pvc := &corev1.PersistentVolumeClaim{}
+			assert.NoError(t, r.Get(ctx, types.NamespacedName{Namespace: "immich", Name: "immich-library"}, pvc))
+			pvc.Status = tt.status
+			assert.NoError(t, r.Status().Update(ctx, pvc))
+
+			err = r.createPersistence(ctx, AppPersistence{Name: "library", Size: "20Gi"}, app, "immich")
+			assert.ErrorIs(t, err, errVolumeResizeFailed)
+			assert.Equal(t, "VolumeResizeFailed", dependenciesFailedReason(err))
+		})
+	}
+}
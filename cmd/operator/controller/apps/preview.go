@@ -0,0 +1,367 @@
+package apps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"gopkg.in/yaml.v3"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+type (
+	// AppPreview is what installing or upgrading an App to its spec would change.
+	AppPreview struct {
+		// InstalledVersion is the version of the installed chart (empty if the App isn't installed)
+		InstalledVersion string
+		// Manifest are the changes of the objects rendered by the chart
+		Manifest []ManifestChange
+		// Dependencies are the changes of the objects the operator manages for the App from its
+		// homeCloud values
+		Dependencies []DependencyChange
+	}
+	ManifestChange struct {
+		Kind      string
+		Namespace string
+		Name      string
+		Type      ChangeType
+		// Diff is the unified diff of the manifest of the object. The data of Secrets is hidden.
+		Diff string
+	}
+	DependencyChange struct {
+		// Kind is the kind of dependency: e.g. secret or route
+		Kind        DependencyKind
+		Name        string
+		Type        ChangeType
+		Description string
+	}
+	ChangeType     string
+	DependencyKind string
+)
+
+const (
+	ChangeTypeAdded    ChangeType = "added"
+	ChangeTypeRemoved  ChangeType = "removed"
+	ChangeTypeModified ChangeType = "modified"
+
+	DependencyKindSecret     DependencyKind = "secret"
+	DependencyKindDatabase   DependencyKind = "database"
+	DependencyKindVolume     DependencyKind = "volume"
+	DependencyKindRoute      DependencyKind = "route"
+	DependencyKindOIDC       DependencyKind = "oidc"
+	DependencyKindResources  DependencyKind = "resources"
+	DependencyKindDependency DependencyKind = "dependency"
+)
+
+// Preview runs Helm in dry-run mode for the chart, version and values of the App and returns how the
+// installed release and the dependencies of the App would change. The App doesn't need to exist.
+func Preview(ctx context.Context, c client.Reader, app *v1.App) (*AppPreview, error) {
+	source, err := chartSourceOf(ctx, c, app)
+	if err != nil {
+		return nil, err
+	}
+	actionConfiguration, err := source.helmAction(app.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	chart, values, err := getChartAndValues(actionConfiguration.RegistryClient, source, app)
+	if err != nil {
+		return nil, err
+	}
+	config, err := chartConfig(chart, app)
+	if err != nil {
+		return nil, err
+	}
+
+	installed, err := action.NewGet(actionConfiguration).Run(app.Spec.Release)
+	if err != nil && !errors.Is(err, driver.ErrReleaseNotFound) {
+		return nil, err
+	}
+
+	// render the release like the operator would install or upgrade it
+	var rendered *release.Release
+	if installed == nil {
+		act := action.NewInstall(actionConfiguration)
+		act.Version = app.Spec.Version
+		act.Namespace = app.Namespace
+		act.ReleaseName = app.Spec.Release
+		act.DryRun = true
+		rendered, err = act.RunWithContext(ctx, chart, values)
+	} else {
+		act := action.NewUpgrade(actionConfiguration)
+		act.Version = app.Spec.Version
+		act.Namespace = app.Namespace
+		act.DryRun = true
+		rendered, err = act.RunWithContext(ctx, app.Spec.Release, chart, values)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to render chart: %w", err)
+	}
+
+	preview := &AppPreview{}
+	current := &AppConfig{}
+	installedManifest := ""
+	if installed != nil {
+		preview.InstalledVersion = installed.Chart.Metadata.Version
+		installedManifest = hideSecrets(installed.Manifest)
+
+		// the config of the installed release comes from its chart and the installed App
+		existing := &v1.App{}
+		err = c.Get(ctx, client.ObjectKeyFromObject(app), existing)
+		if client.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+		if kerrors.IsNotFound(err) {
+			existing = app
+		}
+		current, err = chartConfig(installed.Chart, existing)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	preview.Manifest, err = diffManifests(installedManifest, hideSecrets(rendered.Manifest))
+	if err != nil {
+		return nil, err
+	}
+	preview.Dependencies = diffConfigs(app, current, config)
+	return preview, nil
+}
+
+// diffManifests returns the changes of the objects between two release manifests.
+func diffManifests(from string, to string) ([]ManifestChange, error) {
+	fromObjects, err := manifestObjects(from)
+	if err != nil {
+		return nil, err
+	}
+	toObjects, err := manifestObjects(to)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []ManifestChange{}
+	keys := slices.SortedFunc(maps.Keys(fromObjects), objectKey.Compare)
+	for _, key := range slices.SortedFunc(maps.Keys(toObjects), objectKey.Compare) {
+		if _, ok := fromObjects[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	for _, key := range keys {
+		a, b := fromObjects[key], toObjects[key]
+		if a == b {
+			continue
+		}
+		change := ManifestChange{
+			Kind:      key.kind,
+			Namespace: key.namespace,
+			Name:      key.name,
+			Type:      ChangeTypeModified,
+		}
+		switch {
+		case a == "":
+			change.Type = ChangeTypeAdded
+		case b == "":
+			change.Type = ChangeTypeRemoved
+		}
+		change.Diff, err = difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(a),
+			B:        difflib.SplitLines(b),
+			FromFile: "installed",
+			ToFile:   "preview",
+			Context:  3,
+		})
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+type objectKey struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+func (k objectKey) Compare(o objectKey) int {
+	return strings.Compare(k.kind+"/"+k.namespace+"/"+k.name, o.kind+"/"+o.namespace+"/"+o.name)
+}
+
+// manifestObjects splits a release manifest into the manifests of its objects.
+func manifestObjects(manifest string) (map[objectKey]string, error) {
+	objects := map[objectKey]string{}
+	for _, doc := range releaseutil.SplitManifests(manifest) {
+		head := struct {
+			Kind     string
+			Metadata struct {
+				Name      string
+				Namespace string
+			}
+		}{}
+		err := yaml.Unmarshal([]byte(doc), &head)
+		if err != nil {
+			return nil, fmt.Errorf("invalid manifest: %w", err)
+		}
+		if head.Kind == "" {
+			continue
+		}
+		// drop the "# Source:" comment of the template so that moved templates aren't changes
+		lines := strings.Split(strings.TrimSpace(doc), "\n")
+		if strings.HasPrefix(lines[0], "# Source:") {
+			lines = lines[1:]
+		}
+		key := objectKey{kind: head.Kind, namespace: head.Metadata.Namespace, name: head.Metadata.Name}
+		objects[key] = strings.Join(lines, "\n") + "\n"
+	}
+	return objects, nil
+}
+
+// hideSecrets removes the data of the Secrets of a release manifest so that their values aren't shown
+// (and random values generated by templates aren't changes). Unlike the HideSecret option of Helm, the
+// Secrets are kept so that they can be compared.
+func hideSecrets(manifest string) string {
+	docs := []string{}
+	for _, doc := range releaseutil.SplitManifests(manifest) {
+		head := struct{ Kind string }{}
+		err := yaml.Unmarshal([]byte(doc), &head)
+		if err != nil || head.Kind != "Secret" {
+			docs = append(docs, doc)
+			continue
+		}
+		obj := map[string]any{}
+		err = yaml.Unmarshal([]byte(doc), &obj)
+		if err != nil {
+			continue
+		}
+		delete(obj, "data")
+		delete(obj, "stringData")
+		out, err := yaml.Marshal(obj)
+		if err != nil {
+			continue
+		}
+		docs = append(docs, string(out))
+	}
+	return strings.Join(docs, "\n---\n")
+}
+
+// diffConfigs returns the changes of the dependencies of the App between two homeCloud configs.
+func diffConfigs(app *v1.App, from *AppConfig, to *AppConfig) []DependencyChange {
+	changes := []DependencyChange{}
+
+	secrets := func(c *AppConfig) map[string][]string {
+		m := map[string][]string{}
+		for _, s := range c.Secrets {
+			for _, k := range s.Keys {
+				m[s.Name] = append(m[s.Name], keyNames(k)...)
+			}
+		}
+		return m
+	}
+	changes = append(changes, diffDependencies(DependencyKindSecret, secrets(from), secrets(to), func(a, b []string) string {
+		added := slices.DeleteFunc(slices.Clone(b), func(key string) bool { return slices.Contains(a, key) })
+		if len(added) == 0 {
+			return ""
+		}
+		return "generates keys " + strings.Join(added, ", ")
+	})...)
+
+	databases := func(c *AppConfig) map[string]string {
+		m := map[string]string{}
+		for _, d := range c.Databases {
+			m[d.Type+"/"+d.Name] = d.Type
+		}
+		return m
+	}
+	changes = append(changes, diffDependencies(DependencyKindDatabase, databases(from), databases(to), nil)...)
+
+	volumes := func(c *AppConfig) map[string]string {
+		m := map[string]string{}
+		for _, p := range c.Persistence {
+			m[p.Name] = p.Size
+		}
+		return m
+	}
+	changes = append(changes, diffDependencies(DependencyKindVolume, volumes(from), volumes(to), func(a, b string) string {
+		return fmt.Sprintf("size changes from %s to %s", a, b)
+	})...)
+
+	routes := func(c *AppConfig) map[string]AppRoute {
+		m := map[string]AppRoute{}
+		for _, r := range c.Routes {
+			m[r.Name] = r
+		}
+		return m
+	}
+	changes = append(changes, diffDependencies(DependencyKindRoute, routes(from), routes(to), func(a, b AppRoute) string {
+		return "service, hostnames, rules or authentication change"
+	})...)
+
+	oidc := func(c *AppConfig) map[string]AppOIDC {
+		if c.OIDC == nil {
+			return nil
+		}
+		return map[string]AppOIDC{OIDCSecretName(app, *c.OIDC): *c.OIDC}
+	}
+	changes = append(changes, diffDependencies(DependencyKindOIDC, oidc(from), oidc(to), func(a, b AppOIDC) string {
+		return "redirect URIs change"
+	})...)
+
+	resources := func(c *AppConfig) map[string]v1.ResourcesSpec {
+		if c.Resources == nil {
+			return nil
+		}
+		return map[string]v1.ResourcesSpec{ResourcesName: *c.Resources}
+	}
+	changes = append(changes, diffDependencies(DependencyKindResources, resources(from), resources(to), func(a, b v1.ResourcesSpec) string {
+		return "CPU or memory budget changes"
+	})...)
+
+	dependencies := func(c *AppConfig) map[string]bool {
+		m := map[string]bool{}
+		for _, d := range c.Dependencies {
+			m[d] = true
+		}
+		return m
+	}
+	changes = append(changes, diffDependencies(DependencyKindDependency, dependencies(from), dependencies(to), nil)...)
+
+	return changes
+}
+
+// diffDependencies returns the dependencies added, removed and modified between two sets of
+// dependencies by name. Modified dependencies are described by describe (nil if they can't be
+// modified) and those it returns an empty description for are left out.
+func diffDependencies[T any](kind DependencyKind, from map[string]T, to map[string]T, describe func(a, b T) string) []DependencyChange {
+	changes := []DependencyChange{}
+	for _, name := range slices.Sorted(maps.Keys(to)) {
+		a, ok := from[name]
+		switch {
+		case !ok:
+			changes = append(changes, DependencyChange{Kind: kind, Name: name, Type: ChangeTypeAdded})
+		case describe != nil && !reflect.DeepEqual(a, to[name]):
+			description := describe(a, to[name])
+			if description != "" {
+				changes = append(changes, DependencyChange{Kind: kind, Name: name, Type: ChangeTypeModified, Description: description})
+			}
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(from)) {
+		if _, ok := to[name]; !ok {
+			changes = append(changes, DependencyChange{Kind: kind, Name: name, Type: ChangeTypeRemoved})
+		}
+	}
+	return changes
+}
@@ -0,0 +1,130 @@
+package apps
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+const previewConfigMap = `---
+# Source: immich/templates/config.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: immich
+  namespace: immich
+data:
+  level: info
+`
+
+func TestDiffManifests(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want []ManifestChange
+	}{
+		{
+			name: "unchanged",
+			from: previewConfigMap,
+			to:   previewConfigMap,
+			want: []ManifestChange{},
+		},
+		{
+			name: "moved template",
+			from: previewConfigMap,
+			to:   strings.Replace(previewConfigMap, "config.yaml", "configmap.yaml", 1),
+			want: []ManifestChange{},
+		},
+		{
+			name: "added",
+			to:   previewConfigMap,
+			want: []ManifestChange{{Kind: "ConfigMap", Namespace: "immich", Name: "immich", Type: ChangeTypeAdded}},
+		},
+		{
+			name: "removed",
+			from: previewConfigMap,
+			want: []ManifestChange{{Kind: "ConfigMap", Namespace: "immich", Name: "immich", Type: ChangeTypeRemoved}},
+		},
+		{
+			name: "modified",
+			from: previewConfigMap,
+			to:   strings.Replace(previewConfigMap, "info", "debug", 1),
+			want: []ManifestChange{{Kind: "ConfigMap", Namespace: "immich", Name: "immich", Type: ChangeTypeModified}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := diffManifests(tt.from, tt.to)
+			assert.NoError(t, err)
+			assert.Len(t, got, len(tt.want))
+			for i := range got {
+				assert.NotEmpty(t, got[i].Diff)
+				got[i].Diff = ""
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDiffManifestsDiff(t *testing.T) {
+	got, err := diffManifests(previewConfigMap, strings.Replace(previewConfigMap, "info", "debug", 1))
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Contains(t, got[0].Diff, "-  level: info\n")
+	assert.Contains(t, got[0].Diff, "+  level: debug\n")
+}
+
+func TestHideSecrets(t *testing.T) {
+	manifest := `apiVersion: v1
+kind: Secret
+metadata:
+  name: immich
+data:
+  password: aHVudGVyMg==
+stringData:
+  username: immich
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: immich
+data:
+  level: info
+`
+	got := hideSecrets(manifest)
+	assert.NotContains(t, got, "aHVudGVyMg==")
+	assert.NotContains(t, got, "username")
+	assert.Contains(t, got, "kind: Secret")
+	assert.Contains(t, got, "level: info")
+}
+
+func TestDiffConfigs(t *testing.T) {
+	app := &v1.App{ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "home-cloud-system"}}
+	from := &AppConfig{
+		Secrets:     []AppSecret{{Name: "immich", Keys: []SecretKey{{Name: "password"}}}},
+		Databases:   []AppDatabase{{Name: "immich", Type: "postgres"}},
+		Persistence: []AppPersistence{{Name: "library", Size: "10Gi"}},
+		Routes:      []AppRoute{{Name: "immich", Service: AppService{Name: "server", Port: 80}}},
+	}
+	to := &AppConfig{
+		Secrets:     []AppSecret{{Name: "immich", Keys: []SecretKey{{Name: "password"}, {Name: "jwt", Type: SecretKeyTypeRSA}}}},
+		Persistence: []AppPersistence{{Name: "library", Size: "20Gi"}, {Name: "cache", Size: "1Gi"}},
+		Routes:      []AppRoute{{Name: "immich", Service: AppService{Name: "server", Port: 80}}},
+		OIDC:        &AppOIDC{Route: "immich"},
+	}
+
+	assert.Equal(t, []DependencyChange{
+		{Kind: DependencyKindSecret, Name: "immich", Type: ChangeTypeModified, Description: "generates keys jwt, jwt.pub"},
+		{Kind: DependencyKindDatabase, Name: "postgres/immich", Type: ChangeTypeRemoved},
+		{Kind: DependencyKindVolume, Name: "cache", Type: ChangeTypeAdded},
+		{Kind: DependencyKindVolume, Name: "library", Type: ChangeTypeModified, Description: "size changes from 10Gi to 20Gi"},
+		{Kind: DependencyKindOIDC, Name: "immich-oidc", Type: ChangeTypeAdded},
+	}, diffConfigs(app, from, to))
+
+	assert.Empty(t, diffConfigs(app, to, to))
+}
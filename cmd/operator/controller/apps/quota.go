@@ -0,0 +1,172 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+
+	"dario.cat/mergo"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+// ResourcesName is the name of the ResourceQuota and LimitRange created in the namespace of an App.
+const ResourcesName = "home-cloud"
+
+// resourcesSpec returns the CPU and memory budget of the App: each value is taken from the App, the
+// chart or the Install, in that order.
+func resourcesSpec(install *v1.Install, app *v1.App, appConfig *AppConfig) (v1.ResourcesSpec, error) {
+	spec := v1.ResourcesSpec{}
+	for _, s := range []*v1.ResourcesSpec{app.Spec.Resources, appConfig.Resources, install.Spec.Resources} {
+		if s == nil {
+			continue
+		}
+		err := mergo.Merge(&spec, *s)
+		if err != nil {
+			return spec, err
+		}
+	}
+
+	// containers which don't declare resources must still fit the quota
+	if spec.Requests.CPU != "" && spec.DefaultRequests.CPU == "" {
+		spec.DefaultRequests.CPU = "0"
+	}
+	if spec.Requests.Memory != "" && spec.DefaultRequests.Memory == "" {
+		spec.DefaultRequests.Memory = "0"
+	}
+	// there's no limit which containers that don't declare one can share without overcommitting the
+	// quota (e.g. the total would let two such containers ask for twice the quota) so DefaultLimits
+	// are only set when configured
+	return spec, nil
+}
+
+// reconcileResources creates, updates or deletes the ResourceQuota and LimitRange of the App namespace
+// to match the budget of the App.
+func (r *AppReconciler) reconcileResources(ctx context.Context, app *v1.App, appConfig *AppConfig) error {
+	install, err := r.getInstall(ctx)
+	if err != nil {
+		return err
+	}
+	spec, err := resourcesSpec(install, app, appConfig)
+	if err != nil {
+		return err
+	}
+
+	hard := corev1.ResourceList{}
+	err = addAmounts(hard, spec.Requests, corev1.ResourceRequestsCPU, corev1.ResourceRequestsMemory)
+	if err != nil {
+		return err
+	}
+	err = addAmounts(hard, spec.Limits, corev1.ResourceLimitsCPU, corev1.ResourceLimitsMemory)
+	if err != nil {
+		return err
+	}
+	quota := &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ResourcesName,
+			Namespace: appConfig.Namespace,
+		},
+		Spec: corev1.ResourceQuotaSpec{
+			Hard: hard,
+		},
+	}
+	err = r.applyResourcesObject(ctx, app, quota, len(hard) == 0, func(existing client.Object) bool {
+		e := existing.(*corev1.ResourceQuota)
+		if equality.Semantic.DeepEqual(e.Spec, quota.Spec) {
+			return false
+		}
+		e.Spec = quota.Spec
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	defaultRequests := corev1.ResourceList{}
+	err = addAmounts(defaultRequests, spec.DefaultRequests, corev1.ResourceCPU, corev1.ResourceMemory)
+	if err != nil {
+		return err
+	}
+	defaultLimits := corev1.ResourceList{}
+	err = addAmounts(defaultLimits, spec.DefaultLimits, corev1.ResourceCPU, corev1.ResourceMemory)
+	if err != nil {
+		return err
+	}
+	limitRange := &corev1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ResourcesName,
+			Namespace: appConfig.Namespace,
+		},
+		Spec: corev1.LimitRangeSpec{
+			Limits: []corev1.LimitRangeItem{
+				{
+					Type:           corev1.LimitTypeContainer,
+					DefaultRequest: defaultRequests,
+					Default:        defaultLimits,
+				},
+			},
+		},
+	}
+	return r.applyResourcesObject(ctx, app, limitRange, len(defaultRequests) == 0 && len(defaultLimits) == 0, func(existing client.Object) bool {
+		e := existing.(*corev1.LimitRange)
+		if equality.Semantic.DeepEqual(e.Spec, limitRange.Spec) {
+			return false
+		}
+		e.Spec = limitRange.Spec
+		return true
+	})
+}
+
+// applyResourcesObject creates the object, updates the spec of the existing object with update (which
+// reports whether anything changed) or deletes the object if it isn't wanted.
+func (r *AppReconciler) applyResourcesObject(ctx context.Context, app *v1.App, desired client.Object, empty bool, update func(existing client.Object) bool) error {
+	existing := desired.DeepCopyObject().(client.Object)
+	err := r.Client.Get(ctx, client.ObjectKeyFromObject(desired), existing)
+	switch {
+	case errors.IsNotFound(err):
+		if empty {
+			return nil
+		}
+		return r.create(ctx, app, desired)
+	case err != nil:
+		return err
+	}
+
+	// the budget was removed
+	if empty {
+		return client.IgnoreNotFound(r.Client.Delete(ctx, existing))
+	}
+
+	if !update(existing) {
+		return r.addLabels(ctx, existing, appLabels(app))
+	}
+	labels := existing.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	for k, v := range appLabels(app) {
+		labels[k] = v
+	}
+	existing.SetLabels(labels)
+	return r.Client.Update(ctx, existing)
+}
+
+// addAmounts adds the CPU and memory amounts to the list under the given names.
+func addAmounts(list corev1.ResourceList, amounts v1.ResourceAmounts, cpu corev1.ResourceName, memory corev1.ResourceName) error {
+	for name, amount := range map[corev1.ResourceName]string{cpu: amounts.CPU, memory: amounts.Memory} {
+		if amount == "" {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(amount)
+		if err != nil {
+			return fmt.Errorf("invalid %s amount %q: %w", name, amount, err)
+		}
+		list[name] = quantity
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package apps
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+func TestResourcesSpec(t *testing.T) {
+	tests := []struct {
+		name    string
+		install *v1.ResourcesSpec
+		chart   *v1.ResourcesSpec
+		app     *v1.ResourcesSpec
+		want    v1.ResourcesSpec
+	}{
+		{
+			name: "none",
+		},
+		{
+			name:    "install defaults",
+			install: &v1.ResourcesSpec{DefaultRequests: v1.ResourceAmounts{CPU: "50m", Memory: "64Mi"}},
+			want:    v1.ResourcesSpec{DefaultRequests: v1.ResourceAmounts{CPU: "50m", Memory: "64Mi"}},
+		},
+		{
+			name:    "chart and app override single values",
+			install: &v1.ResourcesSpec{Limits: v1.ResourceAmounts{CPU: "1", Memory: "1Gi"}},
+			chart:   &v1.ResourcesSpec{Limits: v1.ResourceAmounts{Memory: "4Gi"}},
+			app:     &v1.ResourcesSpec{Limits: v1.ResourceAmounts{CPU: "2"}},
+			want: v1.ResourcesSpec{
+				Limits: v1.ResourceAmounts{CPU: "2", Memory: "4Gi"},
+			},
+		},
+		{
+			name:  "quota without defaults",
+			chart: &v1.ResourcesSpec{Requests: v1.ResourceAmounts{CPU: "1", Memory: "1Gi"}},
+			want: v1.ResourcesSpec{
+				Requests:        v1.ResourceAmounts{CPU: "1", Memory: "1Gi"},
+				DefaultRequests: v1.ResourceAmounts{CPU: "0", Memory: "0"},
+			},
+		},
+		{
+			name: "explicit defaults are kept",
+			app: &v1.ResourcesSpec{
+				Limits:        v1.ResourceAmounts{Memory: "2Gi"},
+				DefaultLimits: v1.ResourceAmounts{Memory: "512Mi"},
+			},
+			want: v1.ResourcesSpec{
+				Limits:        v1.ResourceAmounts{Memory: "2Gi"},
+				DefaultLimits: v1.ResourceAmounts{Memory: "512Mi"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			install := &v1.Install{Spec: v1.InstallSpec{Resources: tt.install}}
+			app := &v1.App{Spec: v1.AppSpec{Resources: tt.app}}
+			got, err := resourcesSpec(install, app, &AppConfig{Resources: tt.chart})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReconcileResources(t *testing.T) {
+	app := &v1.App{ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "home-cloud-system"}}
+	install := &v1.Install{ObjectMeta: metav1.ObjectMeta{Name: "install", Namespace: "home-cloud-system"}}
+	appConfig := &AppConfig{
+		Namespace: "immich",
+		Resources: &v1.ResourcesSpec{
+			Requests: v1.ResourceAmounts{CPU: "1"},
+			Limits:   v1.ResourceAmounts{Memory: "2Gi"},
+		},
+	}
+	r := newTestReconciler(install)
+	ctx := context.Background()
+	key := types.NamespacedName{Namespace: "immich", Name: ResourcesName}
+
+	err := r.reconcileResources(ctx, app, appConfig)
+	assert.NoError(t, err)
+	quota := &corev1.ResourceQuota{}
+	err = r.Get(ctx, key, quota)
+	assert.NoError(t, err)
+	assert.Equal(t, "immich", quota.Labels[v1.AppLabel])
+	assert.Equal(t, corev1.ResourceList{
+		corev1.ResourceRequestsCPU:  resource.MustParse("1"),
+		corev1.ResourceLimitsMemory: resource.MustParse("2Gi"),
+	}, quota.Spec.Hard)
+	limitRange := &corev1.LimitRange{}
+	err = r.Get(ctx, key, limitRange)
+	assert.NoError(t, err)
+	assert.Equal(t, corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("0")}, limitRange.Spec.Limits[0].DefaultRequest)
+	assert.Empty(t, limitRange.Spec.Limits[0].Default)
+
+	// a changed budget is updated in place
+	appConfig.Resources.Limits.Memory = "4Gi"
+	err = r.reconcileResources(ctx, app, appConfig)
+	assert.NoError(t, err)
+	err = r.Get(ctx, key, quota)
+	assert.NoError(t, err)
+	assert.Equal(t, resource.MustParse("4Gi"), quota.Spec.Hard[corev1.ResourceLimitsMemory])
+
+	// a removed budget removes the quota and limit range
+	appConfig.Resources = nil
+	err = r.reconcileResources(ctx, app, appConfig)
+	assert.NoError(t, err)
+	err = r.Get(ctx, key, quota)
+	assert.True(t, errors.IsNotFound(err))
+	err = r.Get(ctx, key, limitRange)
+	assert.True(t, errors.IsNotFound(err))
+
+	// invalid amounts are rejected
+	appConfig.Resources = &v1.ResourcesSpec{Limits: v1.ResourceAmounts{CPU: "lots"}}
+	err = r.reconcileResources(ctx, app, appConfig)
+	assert.Error(t, err)
+}
+
+func TestDefaultLimitsFitQuota(t *testing.T) {
+	tests := []struct {
+		name string
+		app  *v1.ResourcesSpec
+	}{
+		{
+			name: "no default limits",
+			app:  &v1.ResourcesSpec{Limits: v1.ResourceAmounts{CPU: "1", Memory: "1Gi"}},
+		},
+		{
+			name: "explicit default limits",
+			app: &v1.ResourcesSpec{
+				Limits:        v1.ResourceAmounts{CPU: "1", Memory: "1Gi"},
+				DefaultLimits: v1.ResourceAmounts{CPU: "500m", Memory: "512Mi"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &v1.App{ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "home-cloud-system"}, Spec: v1.AppSpec{Resources: tt.app}}
+			install := &v1.Install{ObjectMeta: metav1.ObjectMeta{Name: "install", Namespace: "home-cloud-system"}}
+			r := newTestReconciler(install)
+			ctx := context.Background()
+			key := types.NamespacedName{Namespace: "immich", Name: ResourcesName}
+
+			err := r.reconcileResources(ctx, app, &AppConfig{Namespace: "immich"})
+			assert.NoError(t, err)
+			quota := &corev1.ResourceQuota{}
+			assert.NoError(t, r.Get(ctx, key, quota))
+			limitRange := &corev1.LimitRange{}
+			err = r.Get(ctx, key, limitRange)
+			if errors.IsNotFound(err) {
+				// nothing is defaulted
+				return
+			}
+			assert.NoError(t, err)
+
+			// the limits of two containers which don't declare any are defaulted by the limit range
+			// and must still fit the quota together
+			total := corev1.ResourceList{}
+			for range 2 {
+				for name, amount := range limitRange.Spec.Limits[0].Default {
+					sum := total[name]
+					sum.Add(amount)
+					total[name] = sum
+				}
+			}
+			for name, amount := range total {
+				hard := quota.Spec.Hard[corev1.ResourceName("limits."+string(name))]
+				assert.True(t, amount.Cmp(hard) <= 0, "%s: %s exceeds %s", name, amount.String(), hard.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,279 @@
+package apps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v3"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+	"github.com/home-cloud-io/core/cmd/operator/controller/shared"
+)
+
+const (
+	// DefaultUpgradeTimeout is how long the workloads of an App have to roll out after an upgrade
+	// before the upgrade is rolled back.
+	DefaultUpgradeTimeout = 5 * time.Minute
+
+	rolloutPollInterval = 5 * time.Second
+)
+
+// errRolloutFailed is returned when a workload of an upgrade won't roll out without intervention.
+var errRolloutFailed = errors.New("rollout failed")
+
+// checkUpgrade checks the rollout of the awaited upgrade of the App (see AppStatus.UpgradeRevision).
+// The upgrade is completed once the workloads of the new release revision have rolled out and rolled
+// back if they fail to or don't within the upgrade timeout. Until then the App is requeued rather
+// than waited for so that other Apps are reconciled meanwhile.
+func (r *AppReconciler) checkUpgrade(ctx context.Context, app *v1.App) (ctrl.Result, error) {
+	actionConfiguration, err := shared.CreateHelmAction(app.Namespace)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	get := action.NewGet(actionConfiguration)
+	get.Version = app.Status.UpgradeRevision
+	rel, err := get.Run(app.Spec.Release)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	done, err := r.rolledOut(ctx, rel.Namespace, rel.Manifest)
+	switch {
+	case errors.Is(err, errRolloutFailed):
+		return ctrl.Result{}, r.rollback(ctx, app, err)
+	case err != nil:
+		return ctrl.Result{}, err
+	case done:
+		return ctrl.Result{}, r.completeUpgrade(ctx, app)
+	case upgradeTimedOut(app, time.Now()):
+		return ctrl.Result{}, r.rollback(ctx, app, fmt.Errorf("workloads did not roll out within %s", upgradeTimeout(app)))
+	}
+	log.FromContext(ctx).Info("Waiting for App upgrade to roll out", "revision", app.Status.UpgradeRevision)
+	return ctrl.Result{RequeueAfter: rolloutPollInterval}, nil
+}
+
+// completeUpgrade updates the routes of the App once its upgrade has rolled out and records the
+// upgraded version and values.
+func (r *AppReconciler) completeUpgrade(ctx context.Context, app *v1.App) error {
+	err := r.setCondition(ctx, app, v1.AppConditionChartInstalled, "ChartInstalled")
+	if err != nil {
+		return err
+	}
+
+	appConfig, err := Config(ctx, r.Client, app)
+	if err != nil {
+		return r.fail(ctx, app, v1.AppConditionChartInstalled, "ChartDownloadFailed", err)
+	}
+
+	// update routes (dropping any routes no longer declared by the chart)
+	err = r.reconcileRoutes(ctx, app, appConfig.Namespace, appConfig.Routes)
+	err = r.routesReconciled(ctx, app, err)
+	if err != nil {
+		return err
+	}
+
+	return r.updateStatus(ctx, app)
+}
+
+func upgradeTimeout(app *v1.App) time.Duration {
+	if app.Spec.UpgradeTimeout != nil {
+		return app.Spec.UpgradeTimeout.Duration
+	}
+	return DefaultUpgradeTimeout
+}
+
+// upgradeTimedOut reports whether the awaited upgrade of the App has exceeded its upgrade timeout.
+func upgradeTimedOut(app *v1.App, now time.Time) bool {
+	if app.Status.UpgradeStarted == nil {
+		return true
+	}
+	return now.Sub(app.Status.UpgradeStarted.Time) > upgradeTimeout(app)
+}
+
+// rolledOut reports whether all Deployments, StatefulSets and DaemonSets of a release manifest have
+// rolled out and the pods of the release namespace are ready (see PodsReady). Objects without a
+// namespace are in the release namespace. Other kinds (e.g. Jobs) are ignored.
+func (r *AppReconciler) rolledOut(ctx context.Context, namespace string, manifest string) (bool, error) {
+	for _, doc := range releaseutil.SplitManifests(manifest) {
+		head := struct {
+			APIVersion string `yaml:"apiVersion"`
+			Kind       string
+			Metadata   struct {
+				Name      string
+				Namespace string
+			}
+		}{}
+		err := yaml.Unmarshal([]byte(doc), &head)
+		if err != nil {
+			return false, fmt.Errorf("invalid manifest: %w", err)
+		}
+		if head.APIVersion != appsv1.SchemeGroupVersion.String() {
+			continue
+		}
+		var obj client.Object
+		switch head.Kind {
+		case "Deployment":
+			obj = &appsv1.Deployment{}
+		case "StatefulSet":
+			obj = &appsv1.StatefulSet{}
+		case "DaemonSet":
+			obj = &appsv1.DaemonSet{}
+		default:
+			continue
+		}
+		if head.Metadata.Namespace == "" {
+			head.Metadata.Namespace = namespace
+		}
+
+		err = r.Get(ctx, client.ObjectKey{Namespace: head.Metadata.Namespace, Name: head.Metadata.Name}, obj)
+		if kerrors.IsNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		done, err := workloadRolledOut(obj)
+		if !done || err != nil {
+			return false, err
+		}
+	}
+
+	// the App is healthy on the dashboard only once its pods are ready so the upgrade isn't complete
+	// until then either
+	pods := &corev1.PodList{}
+	err := r.List(ctx, pods, client.InNamespace(namespace))
+	if err != nil {
+		return false, err
+	}
+	return PodsReady(pods.Items), nil
+}
+
+// PodsReady reports whether all the pods are ready: i.e. their PodReady condition is true. Pods which
+// have completed (e.g. of Jobs) or are terminating are ignored.
+func PodsReady(pods []corev1.Pod) bool {
+	for _, pod := range pods {
+		if pod.Status.Phase == corev1.PodSucceeded || pod.DeletionTimestamp != nil {
+			continue
+		}
+		ready := false
+		// look through all conditions for the PodReady condition
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == corev1.PodReady {
+				ready = condition.Status == corev1.ConditionTrue
+				break
+			}
+		}
+		if !ready {
+			return false
+		}
+	}
+	return true
+}
+
+// workloadRolledOut reports whether the workload has rolled out its current spec: i.e. the controller
+// has observed the spec and all replicas are updated and available (like `kubectl rollout status`).
+// Deployments which exceed their progress deadline return errRolloutFailed.
+func workloadRolledOut(obj client.Object) (bool, error) {
+	switch w := obj.(type) {
+	case *appsv1.Deployment:
+		if w.Spec.Paused {
+			return true, nil
+		}
+		if w.Status.ObservedGeneration < w.Generation {
+			return false, nil
+		}
+		for _, condition := range w.Status.Conditions {
+			if condition.Type == appsv1.DeploymentProgressing && condition.Reason == "ProgressDeadlineExceeded" {
+				return false, fmt.Errorf("%w: deployment %s exceeded its progress deadline", errRolloutFailed, w.Name)
+			}
+		}
+		replicas := ptr.Deref(w.Spec.Replicas, 1)
+		return w.Status.UpdatedReplicas >= replicas &&
+			// old replicas are still terminating
+			w.Status.Replicas <= w.Status.UpdatedReplicas &&
+			w.Status.AvailableReplicas >= w.Status.UpdatedReplicas, nil
+	case *appsv1.StatefulSet:
+		if w.Status.ObservedGeneration < w.Generation {
+			return false, nil
+		}
+		if w.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+			return true, nil
+		}
+		replicas := ptr.Deref(w.Spec.Replicas, 1)
+		if w.Status.ReadyReplicas < replicas {
+			return false, nil
+		}
+		if rollingUpdate := w.Spec.UpdateStrategy.RollingUpdate; rollingUpdate != nil && ptr.Deref(rollingUpdate.Partition, 0) > 0 {
+			return w.Status.UpdatedReplicas >= replicas-*rollingUpdate.Partition, nil
+		}
+		return w.Status.UpdateRevision == w.Status.CurrentRevision, nil
+	case *appsv1.DaemonSet:
+		if w.Status.ObservedGeneration < w.Generation {
+			return false, nil
+		}
+		if w.Spec.UpdateStrategy.Type == appsv1.OnDeleteDaemonSetStrategyType {
+			return true, nil
+		}
+		return w.Status.UpdatedNumberScheduled >= w.Status.DesiredNumberScheduled &&
+			w.Status.NumberAvailable >= w.Status.DesiredNumberScheduled, nil
+	}
+	return true, nil
+}
+
+// rollback rolls the Helm release of the App back to the revision before the awaited upgrade and
+// records the upgrade as rolled back so that it isn't retried.
+func (r *AppReconciler) rollback(ctx context.Context, app *v1.App, cause error) error {
+	l := log.FromContext(ctx)
+	l.Info("Rolling back App", "version", app.Spec.Version, "revision", app.Status.UpgradeRevision)
+
+	actionConfiguration, err := shared.CreateHelmAction(app.Namespace)
+	if err != nil {
+		return r.fail(ctx, app, v1.AppConditionChartInstalled, "RollbackFailed", err)
+	}
+	act := action.NewRollback(actionConfiguration)
+	act.Version = app.Status.UpgradeRevision - 1
+	err = act.Run(app.Spec.Release)
+	if err != nil {
+		return r.fail(ctx, app, v1.AppConditionChartInstalled, "RollbackFailed", err)
+	}
+
+	cause = rolledBack(app, cause)
+	// the previous version is running again so the error is recorded but not returned: returning it
+	// would retry the upgrade which has just been rolled back
+	_ = r.fail(ctx, app, v1.AppConditionChartInstalled, "UpgradeRolledBack", cause)
+	return nil
+}
+
+// rolledBack records on the status of the App that its awaited upgrade was rolled back and returns
+// the error to report.
+func rolledBack(app *v1.App, cause error) error {
+	app.Status.RolledBackGeneration = app.Generation
+	app.Status.UpgradeRevision = 0
+	app.Status.UpgradeStarted = nil
+	// an upgrade of the values only doesn't make the version a failed one
+	if app.Spec.Version == app.Status.Version {
+		return fmt.Errorf("rolled back upgrade of the values: %w", cause)
+	}
+	app.Status.FailedVersion = app.Spec.Version
+	return fmt.Errorf("rolled back upgrade to version %s: %w", app.Spec.Version, cause)
+}
+
+// startUpgrade records the release revision of an upgrade whose rollout is checked by the following
+// reconciles.
+func startUpgrade(app *v1.App, revision int, now time.Time) {
+	app.Status.UpgradeRevision = revision
+	app.Status.UpgradeStarted = &metav1.Time{Time: now}
+	app.Status.ObservedGeneration = app.Generation
+}
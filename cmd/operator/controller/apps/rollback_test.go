@@ -0,0 +1,270 @@
+package apps
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+func TestWorkloadRolledOut(t *testing.T) {
+	meta := metav1.ObjectMeta{Name: "server", Namespace: "immich", Generation: 2}
+
+	tests := []struct {
+		name   string
+		obj    client.Object
+		want   bool
+		failed bool
+	}{
+		{
+			name: "deployment rolled out",
+			obj: &appsv1.Deployment{
+				ObjectMeta: meta,
+				Spec:       appsv1.DeploymentSpec{Replicas: ptr.To[int32](2)},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2},
+			},
+			want: true,
+		},
+		{
+			// the old pods are ready but the new spec hasn't been observed yet
+			name: "deployment not observed",
+			obj: &appsv1.Deployment{
+				ObjectMeta: meta,
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 1},
+			},
+		},
+		{
+			name: "deployment with old replicas",
+			obj: &appsv1.Deployment{
+				ObjectMeta: meta,
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 1, AvailableReplicas: 1},
+			},
+		},
+		{
+			name: "deployment with unavailable replicas",
+			obj: &appsv1.Deployment{
+				ObjectMeta: meta,
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 1, UpdatedReplicas: 1},
+			},
+		},
+		{
+			name: "deployment exceeded progress deadline",
+			obj: &appsv1.Deployment{
+				ObjectMeta: meta,
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 2,
+					Conditions: []appsv1.DeploymentCondition{
+						{Type: appsv1.DeploymentProgressing, Reason: "ProgressDeadlineExceeded"},
+					},
+				},
+			},
+			failed: true,
+		},
+		{
+			name: "statefulset rolled out",
+			obj: &appsv1.StatefulSet{
+				ObjectMeta: meta,
+				Status:     appsv1.StatefulSetStatus{ObservedGeneration: 2, ReadyReplicas: 1, CurrentRevision: "b", UpdateRevision: "b"},
+			},
+			want: true,
+		},
+		{
+			name: "statefulset updating",
+			obj: &appsv1.StatefulSet{
+				ObjectMeta: meta,
+				Status:     appsv1.StatefulSetStatus{ObservedGeneration: 2, ReadyReplicas: 1, CurrentRevision: "a", UpdateRevision: "b"},
+			},
+		},
+		{
+			name: "daemonset rolled out",
+			obj: &appsv1.DaemonSet{
+				ObjectMeta: meta,
+				Status:     appsv1.DaemonSetStatus{ObservedGeneration: 2, DesiredNumberScheduled: 1, UpdatedNumberScheduled: 1, NumberAvailable: 1},
+			},
+			want: true,
+		},
+		{
+			name: "daemonset updating",
+			obj: &appsv1.DaemonSet{
+				ObjectMeta: meta,
+				Status:     appsv1.DaemonSetStatus{ObservedGeneration: 2, DesiredNumberScheduled: 1, NumberAvailable: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := workloadRolledOut(tt.obj)
+			if tt.failed {
+				assert.ErrorIs(t, err, errRolloutFailed)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRolledOut(t *testing.T) {
+	manifest := `---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: server
+  namespace: immich
+---
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+  namespace: immich
+`
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "server", Namespace: "immich"},
+		Status:     appsv1.DeploymentStatus{Replicas: 1, UpdatedReplicas: 1},
+	}
+	ctx := context.Background()
+
+	// the deployment has no available replicas yet
+	r := newTestReconciler(deployment.DeepCopy())
+	done, err := r.rolledOut(ctx, "home-cloud-system", manifest)
+	assert.NoError(t, err)
+	assert.False(t, done)
+
+	// Jobs and their completed pods don't hold back the rollout
+	deployment.Status.AvailableReplicas = 1
+	server := readyPod("server", corev1.ConditionTrue)
+	migrate := readyPod("migrate", corev1.ConditionFalse)
+	migrate.Status.Phase = corev1.PodSucceeded
+	r = newTestReconciler(deployment.DeepCopy(), server.DeepCopy(), migrate.DeepCopy())
+	done, err = r.rolledOut(ctx, "immich", manifest)
+	assert.NoError(t, err)
+	assert.True(t, done)
+
+	// the workloads have rolled out but a pod isn't ready (and the App isn't healthy)
+	server = readyPod("server", corev1.ConditionFalse)
+	r = newTestReconciler(deployment.DeepCopy(), server.DeepCopy())
+	done, err = r.rolledOut(ctx, "immich", manifest)
+	assert.NoError(t, err)
+	assert.False(t, done)
+
+	// workloads of the release that don't exist yet haven't rolled out
+	r = newTestReconciler()
+	done, err = r.rolledOut(ctx, "home-cloud-system", manifest)
+	assert.NoError(t, err)
+	assert.False(t, done)
+}
+
+func readyPod(name string, status corev1.ConditionStatus) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "immich"},
+		Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+		},
+	}
+}
+
+func TestPodsReady(t *testing.T) {
+	terminating := readyPod("old", corev1.ConditionFalse)
+	terminating.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	completed := readyPod("migrate", corev1.ConditionFalse)
+	completed.Status.Phase = corev1.PodSucceeded
+	failed := readyPod("migrate", corev1.ConditionFalse)
+	failed.Status.Phase = corev1.PodFailed
+
+	tests := []struct {
+		name string
+		pods []corev1.Pod
+		want bool
+	}{
+		{
+			name: "ready",
+			pods: []corev1.Pod{*readyPod("server", corev1.ConditionTrue)},
+			want: true,
+		},
+		{
+			name: "not ready",
+			pods: []corev1.Pod{*readyPod("server", corev1.ConditionTrue), *readyPod("ml", corev1.ConditionFalse)},
+		},
+		{
+			name: "without a PodReady condition",
+			pods: []corev1.Pod{{ObjectMeta: metav1.ObjectMeta{Name: "server"}}},
+		},
+		{
+			name: "terminating and completed pods are ignored",
+			pods: []corev1.Pod{*readyPod("server", corev1.ConditionTrue), *terminating, *completed},
+			want: true,
+		},
+		{
+			name: "failed pods aren't ready",
+			pods: []corev1.Pod{*readyPod("server", corev1.ConditionTrue), *failed},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, PodsReady(tt.pods))
+		})
+	}
+}
+
+func TestUpgradeTimedOut(t *testing.T) {
+	now := time.Now()
+	app := &v1.App{}
+	startUpgrade(app, 2, now)
+	assert.False(t, upgradeTimedOut(app, now.Add(DefaultUpgradeTimeout)))
+	assert.True(t, upgradeTimedOut(app, now.Add(DefaultUpgradeTimeout+time.Second)))
+
+	app.Spec.UpgradeTimeout = &metav1.Duration{Duration: time.Minute}
+	assert.True(t, upgradeTimedOut(app, now.Add(2*time.Minute)))
+}
+
+func TestRolledBack(t *testing.T) {
+	tests := []struct {
+		name          string
+		version       string
+		values        string
+		failedVersion string
+	}{
+		{
+			name:          "version",
+			version:       "1.1.0",
+			failedVersion: "1.1.0",
+		},
+		{
+			name:    "values",
+			version: "1.0.0",
+			values:  "replicas: 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &v1.App{
+				ObjectMeta: metav1.ObjectMeta{Generation: 3},
+				Spec:       v1.AppSpec{Version: tt.version, Values: tt.values},
+				Status:     v1.AppStatus{Version: "1.0.0", ObservedGeneration: 2},
+			}
+			assert.True(t, shouldUpgrade(app))
+			startUpgrade(app, 4, time.Now())
+
+			err := rolledBack(app, errors.New("timed out"))
+			assert.ErrorContains(t, err, "timed out")
+			assert.Equal(t, tt.failedVersion, app.Status.FailedVersion)
+			assert.Zero(t, app.Status.UpgradeRevision)
+			assert.Nil(t, app.Status.UpgradeStarted)
+
+			// the upgrade isn't retried until the spec changes
+			assert.False(t, shouldUpgrade(app))
+			app.Generation++
+			assert.True(t, shouldUpgrade(app))
+		})
+	}
+}
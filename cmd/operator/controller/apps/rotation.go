@@ -0,0 +1,239 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+	"github.com/home-cloud-io/core/cmd/operator/controller/secrets"
+)
+
+// RestartedAtAnnotation is set on the pod template of workloads to restart them (same as `kubectl rollout restart`).
+const RestartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+// databasePasswordRotation reports whether the database passwords of the App should be rotated now
+// and otherwise how long until the next scheduled rotation (zero if none is scheduled).
+func databasePasswordRotation(app *v1.App, now time.Time) (rotate bool, next time.Duration) {
+	// rotation requested through the annotation
+	request := app.GetAnnotations()[v1.RotateDatabasePasswordsAnnotation]
+	if request != "" && request != app.Status.DatabasePasswordRotationRequest {
+		return true, 0
+	}
+
+	// rotation scheduled through the interval
+	if app.Spec.DatabasePasswordRotationInterval == nil || app.Spec.DatabasePasswordRotationInterval.Duration <= 0 {
+		return false, 0
+	}
+	last := app.GetCreationTimestamp().Time
+	if app.Status.DatabasePasswordsRotated != nil {
+		last = app.Status.DatabasePasswordsRotated.Time
+	}
+	next = last.Add(app.Spec.DatabasePasswordRotationInterval.Duration).Sub(now)
+	if next <= 0 {
+		return true, 0
+	}
+	return false, next
+}
+
+// rotateDatabasePasswords sets a new password on every database user of the App, stores it in the
+// app secret and restarts the App workloads so they pick it up.
+func (r *AppReconciler) rotateDatabasePasswords(ctx context.Context, app *v1.App) error {
+
+	// read combined app config from chart values and override values configured in the app
+	appConfig, err := Config(ctx, r.Client, app)
+	if err != nil {
+		return err
+	}
+
+	err = r.rotateAll(ctx, appConfig.Databases, appConfig.Namespace)
+	if err != nil {
+		return err
+	}
+
+	if len(appConfig.Databases) > 0 {
+		err = r.restartWorkloads(ctx, appConfig.Namespace)
+		if err != nil {
+			return err
+		}
+	}
+
+	now := metav1.Now()
+	app.Status.DatabasePasswordsRotated = &now
+	app.Status.DatabasePasswordRotationRequest = app.GetAnnotations()[v1.RotateDatabasePasswordsAnnotation]
+	return r.Status().Update(ctx, app)
+}
+
+// rotateAll rotates the passwords of all the given databases or none of them: if a rotation fails the
+// passwords rotated before it are put back so that a retry starts over from the current credentials.
+func (r *AppReconciler) rotateAll(ctx context.Context, databases []AppDatabase, namespace string) error {
+	reverts := []func(ctx context.Context) error{}
+	for _, d := range databases {
+		revert, err := r.rotateDatabasePassword(ctx, d, namespace)
+		if err != nil {
+			for _, revert := range reverts {
+				revertErr := revert(ctx)
+				if revertErr != nil {
+					log.FromContext(ctx).Error(revertErr, "Failed to revert database password after failing to rotate another")
+				}
+			}
+			return err
+		}
+		if revert != nil {
+			reverts = append(reverts, revert)
+		}
+	}
+	return nil
+}
+
+// rotateDatabasePassword sets a new password on the user of the database and stores it in the app
+// secret. It returns a function putting the previous password back (nil if the database was skipped).
+func (r *AppReconciler) rotateDatabasePassword(ctx context.Context, d AppDatabase, namespace string) (revert func(ctx context.Context) error, err error) {
+	l := log.FromContext(ctx).WithValues("database", d.Name)
+
+	err = validateDatabase(d)
+	if err != nil {
+		return nil, err
+	}
+
+	// get the app secret holding the current credentials
+	appSecret := &corev1.Secret{}
+	err = r.Get(ctx, types.NamespacedName{
+		Namespace: namespace,
+		Name:      DatabaseSecretName(d),
+	}, appSecret)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			l.Info("Database secret not found. Skipping password rotation.")
+			return nil, nil
+		}
+		return nil, err
+	}
+	current := string(appSecret.Data["password"])
+	currentURI := appSecret.Data["uri"]
+
+	secret, err := r.databaseSecret(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	pass, err := secrets.Generate(24, true)
+	if err != nil {
+		return nil, err
+	}
+	err = setDatabasePassword(ctx, d, secret, string(pass))
+	if err != nil {
+		return nil, err
+	}
+
+	// update the password and uri together so that consumers never see a mix of old and new values
+	if appSecret.Data == nil {
+		appSecret.Data = map[string][]byte{}
+	}
+	appSecret.Data["password"] = pass
+	appSecret.Data["uri"] = []byte(databaseURI(d, string(pass)))
+	err = r.Update(ctx, appSecret)
+	if err != nil {
+		// put the old password back so the credentials in the secret keep working
+		revertErr := setDatabasePassword(ctx, d, secret, current)
+		if revertErr != nil {
+			l.Error(revertErr, "Failed to revert database password after failing to update secret")
+		}
+		return nil, err
+	}
+
+	l.Info("Rotated database password")
+	return func(ctx context.Context) error {
+		err := setDatabasePassword(ctx, d, secret, current)
+		if err != nil {
+			return err
+		}
+		appSecret.Data["password"] = []byte(current)
+		appSecret.Data["uri"] = currentURI
+		return r.Update(ctx, appSecret)
+	}, nil
+}
+
+// setDatabasePassword changes the password of the user of the given database using the system credentials.
+func setDatabasePassword(ctx context.Context, d AppDatabase, secret *corev1.Secret, password string) error {
+	switch d.Type {
+	case "postgres":
+		db := postgresClient(secret, "postgres")
+		defer db.Close()
+
+		_, err := db.ExecContext(ctx, "ALTER ROLE ? WITH PASSWORD ?", bun.Ident(d.Name), password)
+		return err
+	case "mysql":
+		db, err := mysqlClient(secret, "")
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		_, err = db.ExecContext(ctx, "ALTER USER ?@'%' IDENTIFIED BY ?", d.Name, password)
+		return err
+	default:
+		return fmt.Errorf("unsupported database type requested: %s", d.Type)
+	}
+}
+
+// restartWorkloads triggers a rolling restart of all deployments, statefulsets and daemonsets in the
+// given namespace.
+func (r *AppReconciler) restartWorkloads(ctx context.Context, namespace string) error {
+	restartedAt := time.Now().Format(time.RFC3339)
+	restart := func(obj client.Object, template *corev1.PodTemplateSpec) error {
+		patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+		if template.Annotations == nil {
+			template.Annotations = map[string]string{}
+		}
+		template.Annotations[RestartedAtAnnotation] = restartedAt
+		return r.Patch(ctx, obj, patch)
+	}
+
+	deployments := &appsv1.DeploymentList{}
+	err := r.List(ctx, deployments, client.InNamespace(namespace))
+	if err != nil {
+		return err
+	}
+	for i := range deployments.Items {
+		err = restart(&deployments.Items[i], &deployments.Items[i].Spec.Template)
+		if err != nil {
+			return err
+		}
+	}
+
+	statefulSets := &appsv1.StatefulSetList{}
+	err = r.List(ctx, statefulSets, client.InNamespace(namespace))
+	if err != nil {
+		return err
+	}
+	for i := range statefulSets.Items {
+		err = restart(&statefulSets.Items[i], &statefulSets.Items[i].Spec.Template)
+		if err != nil {
+			return err
+		}
+	}
+
+	daemonSets := &appsv1.DaemonSetList{}
+	err = r.List(ctx, daemonSets, client.InNamespace(namespace))
+	if err != nil {
+		return err
+	}
+	for i := range daemonSets.Items {
+		err = restart(&daemonSets.Items[i], &daemonSets.Items[i].Spec.Template)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
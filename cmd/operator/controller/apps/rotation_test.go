@@ -0,0 +1,182 @@
+package apps
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+func TestDatabasePasswordRotation(t *testing.T) {
+	now := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	created := metav1.NewTime(now.Add(-48 * time.Hour))
+	rotated := metav1.NewTime(now.Add(-12 * time.Hour))
+	day := &metav1.Duration{Duration: 24 * time.Hour}
+
+	tests := []struct {
+		name       string
+		app        v1.App
+		wantRotate bool
+		wantNext   time.Duration
+	}{
+		{
+			name: "no rotation configured",
+			app: v1.App{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: created},
+			},
+		},
+		{
+			name: "new annotation request",
+			app: v1.App{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: created,
+					Annotations:       map[string]string{v1.RotateDatabasePasswordsAnnotation: "2025-01-10"},
+				},
+				Status: v1.AppStatus{DatabasePasswordRotationRequest: "2025-01-09"},
+			},
+			wantRotate: true,
+		},
+		{
+			name: "annotation request already handled",
+			app: v1.App{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: created,
+					Annotations:       map[string]string{v1.RotateDatabasePasswordsAnnotation: "2025-01-10"},
+				},
+				Status: v1.AppStatus{DatabasePasswordRotationRequest: "2025-01-10"},
+			},
+		},
+		{
+			name: "never rotated and interval passed since creation",
+			app: v1.App{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: created},
+				Spec:       v1.AppSpec{DatabasePasswordRotationInterval: day},
+			},
+			wantRotate: true,
+		},
+		{
+			name: "rotated within interval",
+			app: v1.App{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: created},
+				Spec:       v1.AppSpec{DatabasePasswordRotationInterval: day},
+				Status:     v1.AppStatus{DatabasePasswordsRotated: &rotated},
+			},
+			wantNext: 12 * time.Hour,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rotate, next := databasePasswordRotation(&tt.app, now)
+			assert.Equal(t, tt.wantRotate, rotate)
+			assert.Equal(t, tt.wantNext, next)
+		})
+	}
+}
+
+func TestRotatePostgresPassword(t *testing.T) {
+	d := AppDatabase{Name: "immich", Type: "postgres"}
+	s := useStandIn(t, nil)
+	r := newTestReconciler(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "postgres-immich",
+			Namespace: "immich",
+		},
+		Data: map[string][]byte{
+			"username": []byte("immich"),
+			"password": []byte("old-password"),
+			"uri":      []byte(databaseURI(d, "old-password")),
+		},
+	})
+
+	_, err := r.rotateDatabasePassword(context.Background(), d, "immich")
+	assert.NoError(t, err)
+	s.assertStatements(t, []statement{
+		{"postgres", `^ALTER ROLE "immich" WITH PASSWORD '[A-Za-z0-9]{24}'$`},
+	})
+
+	secret := &corev1.Secret{}
+	err = r.Get(context.Background(), types.NamespacedName{Namespace: "immich", Name: "postgres-immich"}, secret)
+	assert.NoError(t, err)
+	pass := string(secret.Data["password"])
+	assert.NotEqual(t, "old-password", pass)
+	assert.Contains(t, s.statements[0].query, "'"+pass+"'")
+	assert.Equal(t, databaseURI(d, pass), string(secret.Data["uri"]))
+	assert.Equal(t, "immich", string(secret.Data["username"]))
+}
+
+func TestRotateDatabasePasswordWithoutSecret(t *testing.T) {
+	s := useStandIn(t, nil)
+	r := newTestReconciler()
+
+	// databases without an app secret weren't provisioned by the operator and are left alone
+	_, err := r.rotateDatabasePassword(context.Background(), AppDatabase{Name: "immich", Type: "postgres"}, "immich")
+	assert.NoError(t, err)
+	s.assertStatements(t, nil)
+}
+
+func TestRotateAllReverts(t *testing.T) {
+	d := AppDatabase{Name: "immich", Type: "postgres"}
+	failing := AppDatabase{Name: "immich", Type: "mysql"}
+	s := useStandIn(t, nil)
+	r := newTestReconciler(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "postgres-immich", Namespace: "immich"},
+			Data: map[string][]byte{
+				"password": []byte("old-password"),
+				"uri":      []byte(databaseURI(d, "old-password")),
+			},
+		},
+		// there are no system credentials of mysql so its rotation fails
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "mysql-immich", Namespace: "immich"},
+			Data:       map[string][]byte{"password": []byte("old-password")},
+		},
+	)
+
+	err := r.rotateAll(context.Background(), []AppDatabase{d, failing}, "immich")
+	assert.Error(t, err)
+
+	// the rotated postgres password is put back
+	s.assertStatements(t, []statement{
+		{"postgres", `^ALTER ROLE "immich" WITH PASSWORD '[A-Za-z0-9]{24}'$`},
+		{"postgres", `^ALTER ROLE "immich" WITH PASSWORD 'old-password'$`},
+	})
+	secret := &corev1.Secret{}
+	err = r.Get(context.Background(), types.NamespacedName{Namespace: "immich", Name: "postgres-immich"}, secret)
+	assert.NoError(t, err)
+	assert.Equal(t, "old-password", string(secret.Data["password"]))
+	assert.Equal(t, databaseURI(d, "old-password"), string(secret.Data["uri"]))
+}
+
+func TestRestartWorkloads(t *testing.T) {
+	r := newTestReconciler(
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "server", Namespace: "immich"}},
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "redis", Namespace: "immich"}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "other"}},
+	)
+
+	err := r.restartWorkloads(context.Background(), "immich")
+	assert.NoError(t, err)
+
+	deployment := &appsv1.Deployment{}
+	err = r.Get(context.Background(), types.NamespacedName{Namespace: "immich", Name: "server"}, deployment)
+	assert.NoError(t, err)
+	assert.Contains(t, deployment.Spec.Template.Annotations, RestartedAtAnnotation)
+
+	statefulSet := &appsv1.StatefulSet{}
+	err = r.Get(context.Background(), types.NamespacedName{Namespace: "immich", Name: "redis"}, statefulSet)
+	assert.NoError(t, err)
+	assert.Contains(t, statefulSet.Spec.Template.Annotations, RestartedAtAnnotation)
+
+	// workloads of other apps are not restarted
+	err = r.Get(context.Background(), types.NamespacedName{Namespace: "other", Name: "other"}, deployment)
+	assert.NoError(t, err)
+	assert.NotContains(t, deployment.Spec.Template.Annotations, RestartedAtAnnotation)
+}
@@ -2,79 +2,478 @@ package apps
 
 import (
 	"context"
+	goerrors "errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+	"github.com/home-cloud-io/core/pkg/install/resources"
+)
+
+const (
+	// DNSAnnotation is set on the Service of a route to publish its hostname over mDNS
+	DNSAnnotation = "home-cloud.io/dns"
+
+	// gateway of the routes created by the earlier naming scheme
+	legacyGatewayName      = "ingress-gateway"
+	legacyGatewayNamespace = "istio-system"
 )
 
 var (
-	// TODO: get this value from the install
-	GatewayName      = "ingress-gateway"
-	GatewayNamespace = gwv1.Namespace("istio-system")
+	// errHostnameRejected is returned when hostnames of App routes aren't served (see routeHostnames).
+	errHostnameRejected = goerrors.New("hostname rejected")
+	// errAuthUnsupported is returned when App routes requiring authentication aren't served as the
+	// gateway isn't the Istio ingress gateway (which authenticates them).
+	errAuthUnsupported = goerrors.New("authentication unsupported")
 )
 
-func (r *AppReconciler) createRoute(ctx context.Context, namespace string, route AppRoute) error {
-	hostname := fmt.Sprintf("%s.local", route.Name)
+// reconcileRoutes creates or updates the HTTPRoutes declared by the App and deletes the ones which are
+// no longer declared (e.g. routes removed or renamed by a chart upgrade). Hostnames which aren't
+// allowed or are already routed by another App are left out of the routes and errHostnameRejected is
+// returned once the other routes are reconciled. Likewise routes requiring authentication which can't
+// be enforced aren't served and errAuthUnsupported is returned.
+func (r *AppReconciler) reconcileRoutes(ctx context.Context, app *v1.App, namespace string, routes []AppRoute) error {
+	err := r.adoptRoutes(ctx, app, namespace)
+	if err != nil {
+		return err
+	}
+
+	// keep the current routes to find the services they pointed at before updating them
+	existing, err := r.appRoutes(ctx, app, namespace)
+	if err != nil {
+		return err
+	}
+
+	// names of the declared routes, mdns hostnames of each service routed to, and the policies
+	// authenticating routes
+	declared := map[string]bool{}
+	dns := map[string][]string{}
+	policies := []*unstructured.Unstructured{}
+	rejected := []string{}
+	unauthenticated := []string{}
+	if len(routes) > 0 {
+		install, err := r.getInstall(ctx)
+		if err != nil {
+			return err
+		}
+		routed, err := r.routedHostnames(ctx, app)
+		if err != nil {
+			return err
+		}
+		gateway := gatewayRef(install)
+		redirect := redirectHTTP(install)
+		for _, route := range routes {
+			// authentication is done by the Istio ingress gateway: never serve the route without it
+			if route.Auth && !istioGateway(install) {
+				unauthenticated = append(unauthenticated, route.Name)
+				continue
+			}
+
+			hostnames, invalid := routeHostnames(install, route)
+			rejected = append(rejected, invalid...)
+			hostnames = slices.DeleteFunc(hostnames, func(hostname string) bool {
+				owner, ok := routed[hostname]
+				if ok {
+					rejected = append(rejected, fmt.Sprintf("route %s: %s is routed by App %s", route.Name, hostname, owner))
+				}
+				return ok
+			})
+			// a route without hostnames would be served on every hostname
+			if len(hostnames) == 0 {
+				continue
+			}
+			if route.Auth {
+				policies = append(policies, authPolicy(namespace, route, gateway, hostnames))
+			}
+
+			// when redirecting, routes are only served on the HTTPS listeners of their hostnames and a
+			// second route redirects the HTTP listener
+			parents := []gwv1.ParentReference{gateway}
+			if redirect {
+				parents = httpsParents(gateway, hostnames)
+				err = r.applyRoute(ctx, app, redirectRoute(namespace, route, gateway, hostnames))
+				if err != nil {
+					return err
+				}
+				declared[redirectRouteName(route)] = true
+			}
+			err = r.applyRoute(ctx, app, httpRoute(namespace, route, parents, hostnames))
+			if err != nil {
+				return err
+			}
+			declared[route.Name] = true
+
+			for _, service := range routeServices(route) {
+				for _, hostname := range hostnames {
+					if strings.HasSuffix(hostname, ".local") && !slices.Contains(dns[service], hostname) {
+						dns[service] = append(dns[service], hostname)
+					}
+				}
+			}
+		}
+	}
+
+	// annotate services for dns
+	for service, hostnames := range dns {
+		err = r.annotateService(ctx, namespace, service, strings.Join(hostnames, ","))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = r.pruneRoutes(ctx, namespace, existing, declared, dns)
+	if err != nil {
+		return err
+	}
+
+	err = r.reconcileAuthPolicies(ctx, app, policies)
+	if err != nil {
+		return err
+	}
+	errs := []error{}
+	if len(unauthenticated) > 0 {
+		errs = append(errs, fmt.Errorf("%w: routes %s require authentication which is only supported on the istio ingress gateway",
+			errAuthUnsupported, strings.Join(unauthenticated, ", ")))
+	}
+	if len(rejected) > 0 {
+		errs = append(errs, fmt.Errorf("%w: %s", errHostnameRejected, strings.Join(rejected, "; ")))
+	}
+	return goerrors.Join(errs...)
+}
+
+// routedHostnames returns the hostnames of the HTTPRoutes of the other Apps along with the App which
+// routes them.
+func (r *AppReconciler) routedHostnames(ctx context.Context, app *v1.App) (map[string]string, error) {
+	routes := &gwv1.HTTPRouteList{}
+	err := r.Client.List(ctx, routes, client.HasLabels{v1.AppLabel})
+	if err != nil {
+		return nil, err
+	}
+	routed := map[string]string{}
+	for _, route := range routes.Items {
+		if route.Labels[v1.AppLabel] == app.Name && route.Labels[v1.AppNamespaceLabel] == app.Namespace {
+			continue
+		}
+		for _, hostname := range route.Spec.Hostnames {
+			routed[string(hostname)] = route.Labels[v1.AppNamespaceLabel] + "/" + route.Labels[v1.AppLabel]
+		}
+	}
+	return routed, nil
+}
+
+// appRoutes returns the HTTPRoutes created for the App.
+func (r *AppReconciler) appRoutes(ctx context.Context, app *v1.App, namespace string) ([]gwv1.HTTPRoute, error) {
+	routes := &gwv1.HTTPRouteList{}
+	err := r.Client.List(ctx, routes, client.InNamespace(namespace), client.MatchingLabels(appLabels(app)))
+	if err != nil {
+		return nil, err
+	}
+	return routes.Items, nil
+}
+
+// adoptRoutes labels the HTTPRoutes created for the App before routes were labelled with their App
+// so that they are updated and pruned like the others. Once labelled they are no longer adopted.
+func (r *AppReconciler) adoptRoutes(ctx context.Context, app *v1.App, namespace string) error {
+	routes := &gwv1.HTTPRouteList{}
+	err := r.Client.List(ctx, routes, client.InNamespace(namespace))
+	if err != nil {
+		return err
+	}
+	for i := range routes.Items {
+		route := &routes.Items[i]
+		if hasAppLabel(route) || !legacyRoute(route) {
+			continue
+		}
+		log.FromContext(ctx).Info("Adopting unlabelled HTTPRoute", "route", route.Name)
+		err = r.addLabels(ctx, route, appLabels(app))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	// create httproute
-	port := gwv1.PortNumber(int32(route.Service.Port))
-	err := r.Client.Create(ctx, &gwv1.HTTPRoute{
+// legacyRoute reports whether the HTTPRoute was created by the earlier naming scheme: named after the
+// route and serving its mDNS hostname on the Istio ingress gateway. Routes of the chart are left alone.
+func legacyRoute(route *gwv1.HTTPRoute) bool {
+	if route.Labels["app.kubernetes.io/managed-by"] == "Helm" {
+		return false
+	}
+	if len(route.Spec.Hostnames) != 1 || string(route.Spec.Hostnames[0]) != route.Name+".local" {
+		return false
+	}
+	if len(route.Spec.ParentRefs) != 1 {
+		return false
+	}
+	parent := route.Spec.ParentRefs[0]
+	return string(parent.Name) == legacyGatewayName && ptr.Deref(parent.Namespace, "") == legacyGatewayNamespace
+}
+
+// routeHostnames renders the route hostname templates of the Install and the additional hostnames
+// of the route. The hostnames which aren't allowed (see allowedHostname) are returned as rejected
+// instead.
+func routeHostnames(install *v1.Install, route AppRoute) ([]string, []string) {
+	replacer := strings.NewReplacer("{route}", route.Name, "{hostname}", install.Spec.Settings.Hostname)
+	hostnames := []string{}
+	rejected := []string{}
+	seen := map[string]bool{}
+	templates := len(install.Spec.Settings.RouteHostnames)
+	for i, template := range append(slices.Clone(install.Spec.Settings.RouteHostnames), route.Hostnames...) {
+		hostname := replacer.Replace(template)
+		if seen[hostname] {
+			continue
+		}
+		seen[hostname] = true
+		err := allowedHostname(install, hostname, i < templates)
+		if err != nil {
+			rejected = append(rejected, fmt.Sprintf("route %s: %s", route.Name, err))
+			continue
+		}
+		hostnames = append(hostnames, hostname)
+	}
+	return hostnames, rejected
+}
+
+// allowedHostname returns an error unless the hostname may be routed to an App. The Home Cloud
+// hostname (which serves the identity provider) is never allowed. Hostnames rendered from the route
+// hostname templates of the Install are allowed and otherwise hostnames must be subdomains of the Home
+// Cloud hostname or match a route hostname template with any route name.
+func allowedHostname(install *v1.Install, hostname string, templated bool) error {
+	settings := install.Spec.Settings
+	if hostname == settings.Hostname {
+		return fmt.Errorf("%s is the Home Cloud hostname", hostname)
+	}
+	if templated {
+		return nil
+	}
+	if errs := validation.IsDNS1123Subdomain(hostname); len(errs) > 0 {
+		return fmt.Errorf("%s is not a valid hostname: %s", hostname, strings.Join(errs, ", "))
+	}
+	if strings.HasSuffix(hostname, "."+settings.Hostname) {
+		return nil
+	}
+	for _, template := range settings.RouteHostnames {
+		prefix, suffix, ok := strings.Cut(strings.ReplaceAll(template, "{hostname}", settings.Hostname), "{route}")
+		if !ok || len(hostname) <= len(prefix)+len(suffix) {
+			continue
+		}
+		if strings.HasPrefix(hostname, prefix) && strings.HasSuffix(hostname, suffix) &&
+			!strings.Contains(hostname[len(prefix):len(hostname)-len(suffix)], ".") {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is not under the route hostnames of the install", hostname)
+}
+
+// gatewayRef returns the Gateway that routes are attached to: the Gateway set on the Install or else the
+// Istio ingress gateway.
+func gatewayRef(install *v1.Install) gwv1.ParentReference {
+	if install.Spec.GatewayAPI != nil && install.Spec.GatewayAPI.Gateway != nil {
+		return gwv1.ParentReference{
+			Name:      gwv1.ObjectName(install.Spec.GatewayAPI.Gateway.Name),
+			Namespace: ptr.To(gwv1.Namespace(install.Spec.GatewayAPI.Gateway.Namespace)),
+		}
+	}
+	return gwv1.ParentReference{
+		Name:      gwv1.ObjectName(install.Spec.Istio.IngressGatewayName),
+		Namespace: ptr.To(gwv1.Namespace(install.Spec.Istio.Namespace)),
+	}
+}
+
+// routeServices returns the names of the services the route sends requests to.
+func routeServices(route AppRoute) []string {
+	if len(route.Rules) == 0 {
+		return []string{route.Service.Name}
+	}
+	services := []string{}
+	for _, rule := range route.Rules {
+		services = append(services, rule.Service.Name)
+	}
+	return services
+}
+
+// istioGateway reports whether routes are attached to the Istio ingress gateway managed by the Install
+// rather than a Gateway set on the Install (which may not be Istio's).
+func istioGateway(install *v1.Install) bool {
+	return !install.Spec.Istio.Disable && (install.Spec.GatewayAPI == nil || install.Spec.GatewayAPI.Gateway == nil)
+}
+
+// redirectHTTP reports whether plain HTTP requests for routes are redirected to HTTPS. This requires the
+// certificates and HTTPS listeners managed on the Istio ingress gateway.
+func redirectHTTP(install *v1.Install) bool {
+	return install.Spec.TLS != nil && !install.Spec.TLS.Disable && install.Spec.TLS.RedirectHTTP && istioGateway(install)
+}
+
+// httpsParents returns references to the HTTPS listeners of the gateway for the hostnames.
+func httpsParents(gateway gwv1.ParentReference, hostnames []string) []gwv1.ParentReference {
+	parents := []gwv1.ParentReference{}
+	for _, hostname := range hostnames {
+		parent := gateway
+		parent.SectionName = ptr.To(resources.HTTPSListenerName(hostname))
+		parents = append(parents, parent)
+	}
+	return parents
+}
+
+// applyRoute creates the HTTPRoute or updates it in place if it has changed.
+func (r *AppReconciler) applyRoute(ctx context.Context, app *v1.App, desired *gwv1.HTTPRoute) error {
+	existing := &gwv1.HTTPRoute{}
+	err := r.Client.Get(ctx, client.ObjectKeyFromObject(desired), existing)
+	switch {
+	case errors.IsNotFound(err):
+		return r.create(ctx, app, desired)
+	case err != nil:
+		return err
+	}
+	return r.updateRoute(ctx, app, existing, desired)
+}
+
+func redirectRouteName(route AppRoute) string {
+	return route.Name + "-redirect"
+}
+
+// redirectRoute returns an HTTPRoute which redirects plain HTTP requests for the route to HTTPS.
+func redirectRoute(namespace string, route AppRoute, gateway gwv1.ParentReference, hostnames []string) *gwv1.HTTPRoute {
+	gateway.SectionName = ptr.To(gwv1.SectionName(resources.HTTPListenerName))
+	gwHostnames := []gwv1.Hostname{}
+	for _, hostname := range hostnames {
+		gwHostnames = append(gwHostnames, gwv1.Hostname(hostname))
+	}
+
+	return &gwv1.HTTPRoute{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      route.Name,
+			Name:      redirectRouteName(route),
 			Namespace: namespace,
 		},
 		Spec: gwv1.HTTPRouteSpec{
 			CommonRouteSpec: gwv1.CommonRouteSpec{
-				ParentRefs: []gwv1.ParentReference{
-					{
-						// TODO: derive these from Install CRD
-						Name:      gwv1.ObjectName(GatewayName),
-						Namespace: &GatewayNamespace,
-					},
-				},
+				ParentRefs: []gwv1.ParentReference{gateway},
 			},
-			// TODO: change this to subdomain? (*.home-cloud.local)
-			// subdomains don't work on Windows with mDNS so this would require running our
-			// own DNS server (which we want to do anyway)
-			Hostnames: []gwv1.Hostname{gwv1.Hostname(hostname)},
+			Hostnames: gwHostnames,
 			Rules: []gwv1.HTTPRouteRule{
 				{
-					BackendRefs: []gwv1.HTTPBackendRef{
+					Filters: []gwv1.HTTPRouteFilter{
 						{
-							BackendRef: gwv1.BackendRef{
-								BackendObjectReference: gwv1.BackendObjectReference{
-									Name: gwv1.ObjectName(route.Service.Name),
-									Port: &port,
-								},
+							Type: gwv1.HTTPRouteFilterRequestRedirect,
+							RequestRedirect: &gwv1.HTTPRequestRedirectFilter{
+								Scheme:     ptr.To("https"),
+								StatusCode: ptr.To(301),
 							},
 						},
 					},
 				},
 			},
 		},
-	})
-	if client.IgnoreAlreadyExists(err) != nil {
-		return err
 	}
+}
 
-	// annotate service for dns
-	service := &corev1.Service{}
-	err = r.Client.Get(ctx, types.NamespacedName{
-		Name:      route.Service.Name,
-		Namespace: namespace,
-	}, service)
-	if err != nil {
-		return err
+func httpRoute(namespace string, route AppRoute, parents []gwv1.ParentReference, hostnames []string) *gwv1.HTTPRoute {
+	rules := route.Rules
+	if len(rules) == 0 {
+		rules = []AppRouteRule{{Service: route.Service}}
 	}
-	service.Annotations["home-cloud.io/dns"] = hostname
-	err = r.Client.Update(ctx, service)
-	if err != nil {
-		return err
+	httpRules := []gwv1.HTTPRouteRule{}
+	for _, rule := range rules {
+		httpRules = append(httpRules, httpRouteRule(rule))
+	}
+
+	gwHostnames := []gwv1.Hostname{}
+	for _, hostname := range hostnames {
+		gwHostnames = append(gwHostnames, gwv1.Hostname(hostname))
+	}
+
+	return &gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      route.Name,
+			Namespace: namespace,
+		},
+		Spec: gwv1.HTTPRouteSpec{
+			CommonRouteSpec: gwv1.CommonRouteSpec{
+				ParentRefs: parents,
+			},
+			Hostnames: gwHostnames,
+			Rules:     httpRules,
+		},
+	}
+}
+
+func httpRouteRule(rule AppRouteRule) gwv1.HTTPRouteRule {
+	port := gwv1.PortNumber(int32(rule.Service.Port))
+	httpRule := gwv1.HTTPRouteRule{
+		BackendRefs: []gwv1.HTTPBackendRef{
+			{
+				BackendRef: gwv1.BackendRef{
+					BackendObjectReference: gwv1.BackendObjectReference{
+						Name: gwv1.ObjectName(rule.Service.Name),
+						Port: &port,
+					},
+				},
+			},
+		},
+	}
+	if rule.Path != "" {
+		httpRule.Matches = []gwv1.HTTPRouteMatch{
+			{
+				Path: &gwv1.HTTPPathMatch{
+					Type:  ptr.To(gwv1.PathMatchPathPrefix),
+					Value: ptr.To(rule.Path),
+				},
+			},
+		}
+	}
+	return httpRule
+}
+
+// updateRoute replaces the spec of the existing HTTPRoute with the desired spec.
+func (r *AppReconciler) updateRoute(ctx context.Context, app *v1.App, existing *gwv1.HTTPRoute, desired *gwv1.HTTPRoute) error {
+	patch := client.MergeFrom(existing.DeepCopy())
+	existing.Spec = desired.Spec
+	labels := existing.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	for k, v := range appLabels(app) {
+		labels[k] = v
+	}
+	existing.SetLabels(labels)
+	return r.Client.Patch(ctx, existing, patch)
+}
+
+// pruneRoutes deletes the existing HTTPRoutes which aren't declared and removes the dns annotation from
+// services which no longer have mdns hostnames.
+func (r *AppReconciler) pruneRoutes(ctx context.Context, namespace string, existing []gwv1.HTTPRoute, declared map[string]bool, dns map[string][]string) error {
+	for _, route := range existing {
+		if !declared[route.Name] {
+			err := r.deleteRoute(ctx, namespace, route.Name)
+			if err != nil {
+				return err
+			}
+		}
+
+		// the route may have been pointed at another service
+		for _, rule := range route.Spec.Rules {
+			for _, backend := range rule.BackendRefs {
+				if _, ok := dns[string(backend.Name)]; ok {
+					continue
+				}
+				err := r.removeServiceAnnotation(ctx, namespace, string(backend.Name), route.Spec.Hostnames)
+				if err != nil {
+					return err
+				}
+			}
+		}
 	}
 
 	return nil
@@ -95,3 +494,52 @@ func (r *AppReconciler) deleteRoute(ctx context.Context, namespace string, route
 
 	return nil
 }
+
+// annotateService sets the dns annotation of the service to the (comma separated) hostnames of its
+// routes.
+func (r *AppReconciler) annotateService(ctx context.Context, namespace string, name string, hostnames string) error {
+	service := &corev1.Service{}
+	err := r.Client.Get(ctx, types.NamespacedName{
+		Name:      name,
+		Namespace: namespace,
+	}, service)
+	if err != nil {
+		return err
+	}
+	if service.Annotations[DNSAnnotation] == hostnames {
+		return nil
+	}
+
+	patch := client.MergeFrom(service.DeepCopy())
+	if service.Annotations == nil {
+		service.Annotations = map[string]string{}
+	}
+	service.Annotations[DNSAnnotation] = hostnames
+	return r.Client.Patch(ctx, service, patch)
+}
+
+// removeServiceAnnotation removes the dns annotation of the service if it only holds the given hostnames
+// (so that hostnames set by others are kept).
+func (r *AppReconciler) removeServiceAnnotation(ctx context.Context, namespace string, name string, hostnames []gwv1.Hostname) error {
+	service := &corev1.Service{}
+	err := r.Client.Get(ctx, types.NamespacedName{
+		Name:      name,
+		Namespace: namespace,
+	}, service)
+	if err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	annotation, ok := service.Annotations[DNSAnnotation]
+	if !ok {
+		return nil
+	}
+	for _, hostname := range strings.Split(annotation, ",") {
+		if !slices.Contains(hostnames, gwv1.Hostname(strings.TrimSpace(hostname))) {
+			return nil
+		}
+	}
+
+	patch := client.MergeFrom(service.DeepCopy())
+	delete(service.Annotations, DNSAnnotation)
+	return r.Client.Patch(ctx, service, patch)
+}
@@ -0,0 +1,446 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+	"github.com/home-cloud-io/core/pkg/install/resources"
+)
+
+func TestReconcileRoutes(t *testing.T) {
+	app := &v1.App{ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "home-cloud-system"}}
+
+	// route of the previous chart version
+	previous := func(name string, service string, port uint32) *gwv1.HTTPRoute {
+		route := httpRoute("immich", AppRoute{Name: name, Service: AppService{Name: service, Port: port}}, []gwv1.ParentReference{gatewayRef(resources.DefaultInstall)}, []string{name + ".local"})
+		route.Labels = appLabels(app)
+		return route
+	}
+	// route created before routes were labelled with their App
+	legacy := func(name string, service string) *gwv1.HTTPRoute {
+		return &gwv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "immich"},
+			Spec: gwv1.HTTPRouteSpec{
+				CommonRouteSpec: gwv1.CommonRouteSpec{
+					ParentRefs: []gwv1.ParentReference{{Name: "ingress-gateway", Namespace: ptr.To(gwv1.Namespace("istio-system"))}},
+				},
+				Hostnames: []gwv1.Hostname{gwv1.Hostname(name + ".local")},
+				Rules: []gwv1.HTTPRouteRule{{BackendRefs: []gwv1.HTTPBackendRef{{BackendRef: gwv1.BackendRef{
+					BackendObjectReference: gwv1.BackendObjectReference{Name: gwv1.ObjectName(service), Port: ptr.To[gwv1.PortNumber](80)},
+				}}}}},
+			},
+		}
+	}
+	service := func(name string, hostname string) *corev1.Service {
+		s := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "immich"}}
+		if hostname != "" {
+			s.Annotations = map[string]string{DNSAnnotation: hostname}
+		}
+		return s
+	}
+
+	tests := []struct {
+		name     string
+		existing []client.Object
+		routes   []AppRoute
+		// expected port of each remaining route keyed by name
+		wantRoutes map[string]gwv1.PortNumber
+		// expected dns annotation of each service keyed by name ("" for no annotation)
+		wantDNS map[string]string
+		// hostnames are rejected
+		wantErr bool
+	}{
+		{
+			name:       "new route",
+			existing:   []client.Object{service("server", "")},
+			routes:     []AppRoute{{Name: "immich", Service: AppService{Name: "server", Port: 80}}},
+			wantRoutes: map[string]gwv1.PortNumber{"immich": 80},
+			wantDNS:    map[string]string{"server": "immich.local"},
+		},
+		{
+			name:       "changed port is updated in place",
+			existing:   []client.Object{service("server", "immich.local"), previous("immich", "server", 80)},
+			routes:     []AppRoute{{Name: "immich", Service: AppService{Name: "server", Port: 8080}}},
+			wantRoutes: map[string]gwv1.PortNumber{"immich": 8080},
+			wantDNS:    map[string]string{"server": "immich.local"},
+		},
+		{
+			name:       "renamed route",
+			existing:   []client.Object{service("server", "photos.local"), previous("photos", "server", 80)},
+			routes:     []AppRoute{{Name: "immich", Service: AppService{Name: "server", Port: 80}}},
+			wantRoutes: map[string]gwv1.PortNumber{"immich": 80},
+			wantDNS:    map[string]string{"server": "immich.local"},
+		},
+		{
+			name: "removed route",
+			existing: []client.Object{
+				service("server", "immich.local"), previous("immich", "server", 80),
+				service("admin", "admin.local"), previous("admin", "admin", 80),
+			},
+			routes:     []AppRoute{{Name: "immich", Service: AppService{Name: "server", Port: 80}}},
+			wantRoutes: map[string]gwv1.PortNumber{"immich": 80},
+			wantDNS:    map[string]string{"server": "immich.local", "admin": ""},
+		},
+		{
+			name: "route moved to another service",
+			existing: []client.Object{
+				service("web", "immich.local"), previous("immich", "web", 80),
+				service("server", ""),
+			},
+			routes:     []AppRoute{{Name: "immich", Service: AppService{Name: "server", Port: 80}}},
+			wantRoutes: map[string]gwv1.PortNumber{"immich": 80},
+			wantDNS:    map[string]string{"server": "immich.local", "web": ""},
+		},
+		{
+			name: "path rules",
+			existing: []client.Object{
+				service("web", ""), service("server", ""),
+			},
+			routes: []AppRoute{{Name: "immich", Rules: []AppRouteRule{
+				{Path: "/api", Service: AppService{Name: "server", Port: 8080}},
+				{Path: "/", Service: AppService{Name: "web", Port: 80}},
+			}}},
+			wantRoutes: map[string]gwv1.PortNumber{"immich": 8080},
+			wantDNS:    map[string]string{"server": "immich.local", "web": "immich.local"},
+		},
+		{
+			name:     "multiple hostnames",
+			existing: []client.Object{service("server", "")},
+			routes: []AppRoute{
+				{Name: "immich", Hostnames: []string{"photos.local", "photos.home-cloud.local"}, Service: AppService{Name: "server", Port: 80}},
+			},
+			wantRoutes: map[string]gwv1.PortNumber{"immich": 80},
+			wantDNS:    map[string]string{"server": "immich.local,photos.local,photos.home-cloud.local"},
+		},
+		{
+			name:     "hostnames outside the install are rejected",
+			existing: []client.Object{service("server", "")},
+			routes: []AppRoute{
+				{Name: "immich", Hostnames: []string{"photos.example.com", "home-cloud.local", "*.local"}, Service: AppService{Name: "server", Port: 80}},
+			},
+			wantRoutes: map[string]gwv1.PortNumber{"immich": 80},
+			wantDNS:    map[string]string{"server": "immich.local"},
+			wantErr:    true,
+		},
+		{
+			name: "hostnames of other apps are rejected",
+			existing: []client.Object{
+				service("server", ""),
+				&gwv1.HTTPRoute{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "photos",
+						Namespace: "immich",
+						Labels:    map[string]string{v1.AppLabel: "photos", v1.AppNamespaceLabel: "home-cloud-system"},
+					},
+					Spec: gwv1.HTTPRouteSpec{Hostnames: []gwv1.Hostname{"photos.local"}},
+				},
+			},
+			routes: []AppRoute{
+				{Name: "immich", Hostnames: []string{"photos.local"}, Service: AppService{Name: "server", Port: 80}},
+			},
+			wantRoutes: map[string]gwv1.PortNumber{"immich": 80, "photos": 0},
+			wantDNS:    map[string]string{"server": "immich.local"},
+			wantErr:    true,
+		},
+		{
+			// the route would only be served on the Home Cloud hostname
+			name:       "route without allowed hostnames isn't served",
+			existing:   []client.Object{service("server", "")},
+			routes:     []AppRoute{{Name: "home-cloud", Service: AppService{Name: "server", Port: 80}}},
+			wantRoutes: map[string]gwv1.PortNumber{},
+			wantDNS:    map[string]string{"server": ""},
+			wantErr:    true,
+		},
+		{
+			name:     "multiple routes to a service",
+			existing: []client.Object{service("server", "")},
+			routes: []AppRoute{
+				{Name: "immich", Service: AppService{Name: "server", Port: 80}},
+				{Name: "photos", Service: AppService{Name: "server", Port: 80}},
+			},
+			wantRoutes: map[string]gwv1.PortNumber{"immich": 80, "photos": 80},
+			wantDNS:    map[string]string{"server": "immich.local,photos.local"},
+		},
+		{
+			name: "unlabelled routes are adopted",
+			existing: []client.Object{
+				service("server", "immich.local"), legacy("immich", "server"),
+				service("admin", "admin.local"), legacy("admin", "admin"),
+			},
+			routes:     []AppRoute{{Name: "immich", Service: AppService{Name: "server", Port: 8080}}},
+			wantRoutes: map[string]gwv1.PortNumber{"immich": 8080},
+			wantDNS:    map[string]string{"server": "immich.local", "admin": ""},
+		},
+		{
+			name: "routes of other apps are kept",
+			existing: []client.Object{
+				service("server", ""),
+				&gwv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "immich"}},
+			},
+			routes:     []AppRoute{{Name: "immich", Service: AppService{Name: "server", Port: 80}}},
+			wantRoutes: map[string]gwv1.PortNumber{"immich": 80, "other": 0},
+			wantDNS:    map[string]string{"server": "immich.local"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			install := &v1.Install{ObjectMeta: metav1.ObjectMeta{Name: "install", Namespace: "home-cloud-system"}}
+			r := newTestReconciler(append(tt.existing, install)...)
+
+			err := r.reconcileRoutes(context.Background(), app, "immich", tt.routes)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errHostnameRejected)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			routes := &gwv1.HTTPRouteList{}
+			err = r.List(context.Background(), routes, client.InNamespace("immich"))
+			assert.NoError(t, err)
+			got := map[string]gwv1.PortNumber{}
+			for _, route := range routes.Items {
+				got[route.Name] = 0
+				if len(route.Spec.Rules) > 0 {
+					got[route.Name] = *route.Spec.Rules[0].BackendRefs[0].Port
+				}
+			}
+			assert.Equal(t, tt.wantRoutes, got)
+
+			for name, hostname := range tt.wantDNS {
+				s := &corev1.Service{}
+				err = r.Get(context.Background(), types.NamespacedName{Namespace: "immich", Name: name}, s)
+				assert.NoError(t, err)
+				assert.Equal(t, hostname, s.Annotations[DNSAnnotation], name)
+			}
+		})
+	}
+}
+
+func TestRouteHostnames(t *testing.T) {
+	tests := []struct {
+		name      string
+		templates []string
+		route     AppRoute
+		want      []string
+		// number of rejected hostnames
+		rejected int
+	}{
+		{
+			name:      "default",
+			templates: []string{"{route}.local"},
+			route:     AppRoute{Name: "immich"},
+			want:      []string{"immich.local"},
+		},
+		{
+			name:      "subdomain of the install hostname",
+			templates: []string{"{route}.{hostname}"},
+			route:     AppRoute{Name: "immich"},
+			want:      []string{"immich.home-cloud.local"},
+		},
+		{
+			name:      "custom domain and additional hostnames",
+			templates: []string{"{route}.local", "{route}.example.com"},
+			route:     AppRoute{Name: "immich", Hostnames: []string{"photos.example.com", "{route}.local"}},
+			want:      []string{"immich.local", "immich.example.com", "photos.example.com"},
+		},
+		{
+			name:      "subdomains of the install hostname are allowed",
+			templates: []string{"{route}.local"},
+			route:     AppRoute{Name: "immich", Hostnames: []string{"photos.home-cloud.local", "api.photos.home-cloud.local"}},
+			want:      []string{"immich.local", "photos.home-cloud.local", "api.photos.home-cloud.local"},
+		},
+		{
+			name:      "hostnames outside the templates are rejected",
+			templates: []string{"{route}.local", "{route}.example.com"},
+			route:     AppRoute{Name: "immich", Hostnames: []string{"photos.example.org", "api.photos.example.com", "*.example.com"}},
+			want:      []string{"immich.local", "immich.example.com"},
+			rejected:  3,
+		},
+		{
+			name:      "the home cloud hostname is rejected",
+			templates: []string{"{route}.local"},
+			route:     AppRoute{Name: "home-cloud", Hostnames: []string{"{hostname}"}},
+			want:      []string{},
+			rejected:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			install := &v1.Install{Spec: v1.InstallSpec{Settings: &v1.SettingsSpec{
+				Hostname:       "home-cloud.local",
+				RouteHostnames: tt.templates,
+			}}}
+			hostnames, rejected := routeHostnames(install, tt.route)
+			assert.Equal(t, tt.want, hostnames)
+			assert.Len(t, rejected, tt.rejected)
+		})
+	}
+}
+
+func TestGatewayRef(t *testing.T) {
+	tests := []struct {
+		name    string
+		install *v1.Install
+		want    gwv1.ParentReference
+	}{
+		{
+			name:    "istio ingress gateway",
+			install: resources.DefaultInstall,
+			want:    gwv1.ParentReference{Name: "ingress-gateway", Namespace: ptr.To(gwv1.Namespace("istio-system"))},
+		},
+		{
+			name: "custom gateway",
+			install: &v1.Install{Spec: v1.InstallSpec{
+				GatewayAPI: &v1.GatewayAPISpec{Gateway: &v1.GatewayReference{Name: "traefik", Namespace: "traefik"}},
+				Istio:      &v1.IstioSpec{Disable: true, Namespace: "istio-system", IngressGatewayName: "ingress-gateway"},
+			}},
+			want: gwv1.ParentReference{Name: "traefik", Namespace: ptr.To(gwv1.Namespace("traefik"))},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, gatewayRef(tt.install))
+		})
+	}
+}
+
+func TestReconcileRoutesRedirect(t *testing.T) {
+	app := &v1.App{ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "home-cloud-system"}}
+	install := &v1.Install{
+		ObjectMeta: metav1.ObjectMeta{Name: "install", Namespace: "home-cloud-system"},
+		Spec:       v1.InstallSpec{TLS: &v1.TLSSpec{RedirectHTTP: true}},
+	}
+	r := newTestReconciler(install, &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "server", Namespace: "immich"}})
+	routes := []AppRoute{{Name: "immich", Service: AppService{Name: "server", Port: 80}}}
+
+	err := r.reconcileRoutes(context.Background(), app, "immich", routes)
+	assert.NoError(t, err)
+
+	// the route is only served over HTTPS
+	route := &gwv1.HTTPRoute{}
+	err = r.Get(context.Background(), types.NamespacedName{Namespace: "immich", Name: "immich"}, route)
+	assert.NoError(t, err)
+	assert.Len(t, route.Spec.ParentRefs, 1)
+	assert.Equal(t, gwv1.SectionName("https-immich-local"), *route.Spec.ParentRefs[0].SectionName)
+
+	// and plain HTTP is redirected
+	redirect := &gwv1.HTTPRoute{}
+	err = r.Get(context.Background(), types.NamespacedName{Namespace: "immich", Name: "immich-redirect"}, redirect)
+	assert.NoError(t, err)
+	assert.Equal(t, gwv1.SectionName("http"), *redirect.Spec.ParentRefs[0].SectionName)
+	assert.Equal(t, "https", *redirect.Spec.Rules[0].Filters[0].RequestRedirect.Scheme)
+
+	// turning off the redirect removes the redirect route
+	install.Spec.TLS.RedirectHTTP = false
+	err = r.Update(context.Background(), install)
+	assert.NoError(t, err)
+	err = r.reconcileRoutes(context.Background(), app, "immich", routes)
+	assert.NoError(t, err)
+	err = r.Get(context.Background(), types.NamespacedName{Namespace: "immich", Name: "immich-redirect"}, redirect)
+	assert.True(t, errors.IsNotFound(err))
+	err = r.Get(context.Background(), types.NamespacedName{Namespace: "immich", Name: "immich"}, route)
+	assert.NoError(t, err)
+	assert.Nil(t, route.Spec.ParentRefs[0].SectionName)
+}
+
+func TestReconcileRoutesAuth(t *testing.T) {
+	app := &v1.App{ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "home-cloud-system"}}
+	install := &v1.Install{ObjectMeta: metav1.ObjectMeta{Name: "install", Namespace: "home-cloud-system"}}
+	r := newTestReconciler(install, &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "server", Namespace: "immich"}})
+	routes := []AppRoute{{Name: "immich", Service: AppService{Name: "server", Port: 80}, Auth: true}}
+
+	err := r.reconcileRoutes(context.Background(), app, "immich", routes)
+	assert.NoError(t, err)
+
+	// the gateway asks the identity provider to authenticate requests for the route hostnames
+	policy := &unstructured.Unstructured{}
+	policy.SetGroupVersionKind(AuthorizationPolicyGVK)
+	err = r.Get(context.Background(), types.NamespacedName{Namespace: "istio-system", Name: "immich-immich"}, policy)
+	assert.NoError(t, err)
+	assert.Equal(t, appLabels(app), policy.GetLabels())
+	provider, _, _ := unstructured.NestedString(policy.Object, "spec", "provider", "name")
+	assert.Equal(t, v1.AuthProviderName, provider)
+	gateway, _, _ := unstructured.NestedSlice(policy.Object, "spec", "targetRefs")
+	assert.Equal(t, []any{map[string]any{"group": "gateway.networking.k8s.io", "kind": "Gateway", "name": "ingress-gateway"}}, gateway)
+	rules, _, _ := unstructured.NestedSlice(policy.Object, "spec", "rules")
+	assert.Equal(t, []any{map[string]any{"to": []any{map[string]any{"operation": map[string]any{"hosts": []any{"immich.local", "immich.local:*"}}}}}}, rules)
+
+	// removing auth from the route removes the policy
+	routes[0].Auth = false
+	err = r.reconcileRoutes(context.Background(), app, "immich", routes)
+	assert.NoError(t, err)
+	err = r.Get(context.Background(), types.NamespacedName{Namespace: "istio-system", Name: "immich-immich"}, policy)
+	assert.True(t, errors.IsNotFound(err))
+	route := &gwv1.HTTPRoute{}
+	err = r.Get(context.Background(), types.NamespacedName{Namespace: "immich", Name: "immich"}, route)
+	assert.NoError(t, err)
+
+	// routes requiring auth are never served without istio or on another gateway
+	for _, spec := range []v1.InstallSpec{
+		{Istio: &v1.IstioSpec{Disable: true}},
+		{GatewayAPI: &v1.GatewayAPISpec{Gateway: &v1.GatewayReference{Name: "traefik", Namespace: "traefik"}}},
+	} {
+		err = r.Get(context.Background(), client.ObjectKeyFromObject(install), install)
+		assert.NoError(t, err)
+		install.Spec = spec
+		err = r.Update(context.Background(), install)
+		assert.NoError(t, err)
+		routes[0].Auth = true
+		err = r.reconcileRoutes(context.Background(), app, "immich", routes)
+		assert.ErrorIs(t, err, errAuthUnsupported)
+		err = r.Get(context.Background(), types.NamespacedName{Namespace: "immich", Name: "immich"}, route)
+		assert.True(t, errors.IsNotFound(err))
+	}
+}
+
+func TestRoutesReconciled(t *testing.T) {
+	app := &v1.App{
+		ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "home-cloud-system"},
+		Status:     v1.AppStatus{Phase: v1.AppPhaseInstalled},
+	}
+	r := newTestReconciler()
+	r.Client = fake.NewClientBuilder().WithScheme(r.Scheme).WithObjects(app.DeepCopy()).WithStatusSubresource(app).Build()
+	ctx := context.Background()
+	assert.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(app), app))
+
+	// rejected hostnames are reported on the routes condition without failing the App
+	err := r.routesReconciled(ctx, app, fmt.Errorf("%w: route immich: photos.local is routed by App home-cloud-system/photos", errHostnameRejected))
+	assert.NoError(t, err)
+	condition := meta.FindStatusCondition(app.Status.Conditions, v1.AppConditionRoutesReady)
+	if assert.NotNil(t, condition) {
+		assert.Equal(t, metav1.ConditionFalse, condition.Status)
+		assert.Equal(t, "HostnameRejected", condition.Reason)
+		assert.Contains(t, condition.Message, "photos.local is routed by App home-cloud-system/photos")
+	}
+	assert.Equal(t, v1.AppPhaseInstalled, app.Status.Phase)
+
+	// once the hostnames are routed the condition is cleared
+	err = r.routesReconciled(ctx, app, nil)
+	assert.NoError(t, err)
+	assert.True(t, meta.IsStatusConditionTrue(app.Status.Conditions, v1.AppConditionRoutesReady))
+
+	// routes requiring authentication which can't be enforced fail the App
+	err = r.routesReconciled(ctx, app, fmt.Errorf("%w: routes immich require authentication", errAuthUnsupported))
+	assert.ErrorIs(t, err, errAuthUnsupported)
+	assert.Equal(t, "AuthUnsupported", meta.FindStatusCondition(app.Status.Conditions, v1.AppConditionRoutesReady).Reason)
+	assert.Equal(t, v1.AppPhaseFailed, app.Status.Phase)
+
+	// other errors fail the App
+	err = r.routesReconciled(ctx, app, errors.NewNotFound(gwv1.Resource("httproutes"), "immich"))
+	assert.Error(t, err)
+	assert.Equal(t, v1.AppPhaseFailed, app.Status.Phase)
+}
@@ -2,39 +2,347 @@ package apps
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
 
-	"github.com/home-cloud-io/core/cmd/operator/controller/secrets"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"sigs.k8s.io/controller-runtime/pkg/client"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+	"github.com/home-cloud-io/core/cmd/operator/controller/secrets"
 )
 
-func (r *AppReconciler) createSecret(ctx context.Context, s AppSecret, namespace string) error {
-	// generate secret for each key
-	data := map[string][]byte{}
+// createSecret creates the Secret with generated values for its keys. Existing Secrets keep their
+// values: only keys which are missing (e.g. added by a new chart version) are generated, and values
+// missing from existing keys (e.g. after a change of the key type) are derived from them.
+func (r *AppReconciler) createSecret(ctx context.Context, app *v1.App, s AppSecret, namespace string) error {
+	existing := &corev1.Secret{}
+	err := r.Client.Get(ctx, types.NamespacedName{Name: s.Name, Namespace: namespace}, existing)
+	if errors.IsNotFound(err) {
+		// generate secret for each key
+		data := map[string][]byte{}
+		err := generateKeys(data, s, s.Keys)
+		if err != nil {
+			return err
+		}
+		return r.create(ctx, app, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      s.Name,
+				Namespace: namespace,
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: data,
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	// keys without their base value are generated, the missing values of the others are derived
+	// from the base value so the values in use are kept
+	missing := []SecretKey{}
+	derived := 0
 	for _, k := range s.Keys {
-		if k.Length == 0 {
-			k.Length = 24
+		base, ok := existing.Data[baseName(k)]
+		if !ok {
+			missing = append(missing, k)
+			continue
+		}
+		if !slices.ContainsFunc(keyNames(k), func(name string) bool { return existing.Data[name] == nil }) {
+			continue
+		}
+		values, err := deriveKey(k, base)
+		if err != nil {
+			return fmt.Errorf("failed to derive key %s of secret %s: %w", k.Name, s.Name, err)
 		}
-		p, err := secrets.Generate(k.Length, k.NoSpecialCharacters)
+		for name, value := range values {
+			if existing.Data[name] == nil {
+				existing.Data[name] = value
+				derived++
+			}
+		}
+	}
+	if len(missing) == 0 && derived == 0 {
+		return r.addLabels(ctx, existing, appLabels(app))
+	}
+
+	log.FromContext(ctx).Info("Adding missing keys to App secret", "secret", s.Name, "keys", len(missing), "derived", derived)
+	return r.updateSecretKeys(ctx, app, existing, s, missing)
+}
+
+// rotateSecretKeys generates new values for the keys of the App secrets named in the
+// RotateSecretKeysAnnotation and restarts the App workloads so they pick them up.
+func (r *AppReconciler) rotateSecretKeys(ctx context.Context, app *v1.App) error {
+	// read combined app config from chart values and override values configured in the app
+	appConfig, err := Config(ctx, r.Client, app)
+	if err != nil {
+		return err
+	}
+
+	request := app.GetAnnotations()[v1.RotateSecretKeysAnnotation]
+	rotated, err := r.rotateKeys(ctx, app, appConfig, rotationRequest(request))
+	if err != nil {
+		return err
+	}
+	if rotated {
+		err = r.restartWorkloads(ctx, appConfig.Namespace)
 		if err != nil {
 			return err
 		}
-		data[k.Name] = p
 	}
 
-	// create secret on cluster
-	err := r.Client.Create(ctx, &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      s.Name,
-			Namespace: namespace,
-		},
-		Type: corev1.SecretTypeOpaque,
-		Data: data,
-	})
-	if client.IgnoreAlreadyExists(err) != nil {
+	app.Status.SecretKeyRotationRequest = request
+	return r.Status().Update(ctx, app)
+}
+
+// rotateKeys generates new values for the requested <secret>/<key> entries and reports whether any
+// keys were rotated.
+func (r *AppReconciler) rotateKeys(ctx context.Context, app *v1.App, appConfig *AppConfig, requested map[string]bool) (bool, error) {
+	l := log.FromContext(ctx)
+	rotated := false
+	for _, s := range appConfig.Secrets {
+		keys := []SecretKey{}
+		for _, k := range s.Keys {
+			if requested[s.Name+"/"+k.Name] {
+				keys = append(keys, k)
+				delete(requested, s.Name+"/"+k.Name)
+			}
+		}
+		if len(keys) == 0 {
+			continue
+		}
+
+		existing := &corev1.Secret{}
+		err := r.Client.Get(ctx, types.NamespacedName{Name: s.Name, Namespace: appConfig.Namespace}, existing)
+		if err != nil {
+			return rotated, err
+		}
+		err = r.updateSecretKeys(ctx, app, existing, s, keys)
+		if err != nil {
+			return rotated, err
+		}
+		l.Info("Rotated App secret keys", "secret", s.Name, "keys", len(keys))
+		rotated = true
+	}
+	for entry := range requested {
+		l.Info("Ignoring rotation of a key which isn't declared by the App", "key", entry)
+	}
+	return rotated, nil
+}
+
+// updateSecretKeys writes newly generated values of the given keys to the existing Secret.
+func (r *AppReconciler) updateSecretKeys(ctx context.Context, app *v1.App, existing *corev1.Secret, s AppSecret, keys []SecretKey) error {
+	if existing.Data == nil {
+		existing.Data = map[string][]byte{}
+	}
+	err := generateKeys(existing.Data, s, keys)
+	if err != nil {
 		return err
 	}
+	labels := existing.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	maps.Copy(labels, appLabels(app))
+	existing.SetLabels(labels)
+	return r.Client.Update(ctx, existing)
+}
 
+// rotationRequest returns the <secret>/<key> entries of the RotateSecretKeysAnnotation value.
+func rotationRequest(value string) map[string]bool {
+	entries := map[string]bool{}
+	for _, entry := range strings.Split(value, ",") {
+		entry, _, _ = strings.Cut(entry, "@")
+		entry = strings.TrimSpace(entry)
+		if entry != "" {
+			entries[entry] = true
+		}
+	}
+	return entries
+}
+
+// generateKeys generates the values of the keys of the Secret into data.
+func generateKeys(data map[string][]byte, s AppSecret, keys []SecretKey) error {
+	for _, k := range keys {
+		values, err := generateKey(k)
+		if err != nil {
+			return fmt.Errorf("failed to generate key %s of secret %s: %w", k.Name, s.Name, err)
+		}
+		maps.Copy(data, values)
+	}
 	return nil
 }
+
+// keyNames returns the names of the values generated for the key (see SecretKeyType).
+func keyNames(k SecretKey) []string {
+	switch k.Type {
+	case SecretKeyTypeRSA, SecretKeyTypeEd25519:
+		return []string{k.Name, k.Name + ".pub"}
+	case SecretKeyTypeBcrypt:
+		return []string{k.Name, k.Name + ".bcrypt"}
+	case SecretKeyTypeHtpasswd:
+		return []string{k.Name, k.Name + ".htpasswd"}
+	case SecretKeyTypeTLS:
+		return []string{k.Name + ".crt", k.Name + ".key"}
+	default:
+		return []string{k.Name}
+	}
+}
+
+// baseName returns the name of the value the other values of the key are derived from.
+func baseName(k SecretKey) string {
+	if k.Type == SecretKeyTypeTLS {
+		return k.Name + ".key"
+	}
+	return k.Name
+}
+
+// deriveKey returns the values of the key which are derived from its base value (see baseName).
+func deriveKey(k SecretKey, base []byte) (map[string][]byte, error) {
+	switch k.Type {
+	case SecretKeyTypeRSA, SecretKeyTypeEd25519:
+		public, err := secrets.PublicKey(base)
+		if err != nil {
+			return nil, err
+		}
+		return map[string][]byte{k.Name + ".pub": public}, nil
+
+	case SecretKeyTypeBcrypt:
+		hash, err := secrets.Bcrypt(base)
+		if err != nil {
+			return nil, err
+		}
+		return map[string][]byte{k.Name + ".bcrypt": hash}, nil
+
+	case SecretKeyTypeHtpasswd:
+		entry, err := secrets.Htpasswd(htpasswdUsername(k), base)
+		if err != nil {
+			return nil, err
+		}
+		return map[string][]byte{k.Name + ".htpasswd": entry}, nil
+
+	case SecretKeyTypeTLS:
+		hosts, validity := certificateOptions(k)
+		cert, err := secrets.Certificate(base, hosts, validity)
+		if err != nil {
+			return nil, err
+		}
+		return map[string][]byte{k.Name + ".crt": cert}, nil
+
+	default:
+		return nil, nil
+	}
+}
+
+// generateKey returns the values generated for the key by the name they are written to the Secret as.
+func generateKey(k SecretKey) (map[string][]byte, error) {
+	switch k.Type {
+	case "", SecretKeyTypePassword:
+		p, err := generatePassword(k)
+		if err != nil {
+			return nil, err
+		}
+		return map[string][]byte{k.Name: p}, nil
+
+	case SecretKeyTypeHex, SecretKeyTypeBase64:
+		if k.Length == 0 {
+			k.Length = 32
+		}
+		b, err := secrets.RandomBytes(k.Length)
+		if err != nil {
+			return nil, err
+		}
+		if k.Type == SecretKeyTypeHex {
+			return map[string][]byte{k.Name: []byte(hex.EncodeToString(b))}, nil
+		}
+		return map[string][]byte{k.Name: []byte(base64.StdEncoding.EncodeToString(b))}, nil
+
+	case SecretKeyTypeUUID:
+		return map[string][]byte{k.Name: secrets.UUID()}, nil
+
+	case SecretKeyTypeRSA, SecretKeyTypeEd25519:
+		var (
+			private, public []byte
+			err             error
+		)
+		if k.Type == SecretKeyTypeRSA {
+			if k.Bits == 0 {
+				k.Bits = 2048
+			}
+			private, public, err = secrets.RSAKeyPair(k.Bits)
+		} else {
+			private, public, err = secrets.Ed25519KeyPair()
+		}
+		if err != nil {
+			return nil, err
+		}
+		return map[string][]byte{k.Name: private, k.Name + ".pub": public}, nil
+
+	case SecretKeyTypeBcrypt:
+		p, err := generatePassword(k)
+		if err != nil {
+			return nil, err
+		}
+		hash, err := secrets.Bcrypt(p)
+		if err != nil {
+			return nil, err
+		}
+		return map[string][]byte{k.Name: p, k.Name + ".bcrypt": hash}, nil
+
+	case SecretKeyTypeHtpasswd:
+		p, err := generatePassword(k)
+		if err != nil {
+			return nil, err
+		}
+		entry, err := secrets.Htpasswd(htpasswdUsername(k), p)
+		if err != nil {
+			return nil, err
+		}
+		return map[string][]byte{k.Name: p, k.Name + ".htpasswd": entry}, nil
+
+	case SecretKeyTypeTLS:
+		hosts, validity := certificateOptions(k)
+		cert, key, err := secrets.SelfSignedCertificate(hosts, validity)
+		if err != nil {
+			return nil, err
+		}
+		return map[string][]byte{k.Name + ".crt": cert, k.Name + ".key": key}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown secret key type: %s", k.Type)
+	}
+}
+
+func generatePassword(k SecretKey) ([]byte, error) {
+	if k.Length == 0 {
+		k.Length = 24
+	}
+	return secrets.Generate(k.Length, k.NoSpecialCharacters)
+}
+
+func htpasswdUsername(k SecretKey) string {
+	if k.Username == "" {
+		return "admin"
+	}
+	return k.Username
+}
+
+func certificateOptions(k SecretKey) ([]string, time.Duration) {
+	hosts := k.Hosts
+	if len(hosts) == 0 {
+		hosts = []string{k.Name}
+	}
+	validDays := k.ValidDays
+	if validDays == 0 {
+		validDays = 3650
+	}
+	return hosts, time.Duration(validDays) * 24 * time.Hour
+}
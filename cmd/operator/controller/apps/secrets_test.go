@@ -0,0 +1,237 @@
+package apps
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+	"github.com/home-cloud-io/core/cmd/operator/controller/secrets"
+)
+
+func TestGenerateKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      SecretKey
+		wantKeys []string
+		wantErr  bool
+	}{
+		{
+			name:     "password",
+			key:      SecretKey{Name: "password"},
+			wantKeys: []string{"password"},
+		},
+		{
+			name:     "hex",
+			key:      SecretKey{Name: "key", Type: SecretKeyTypeHex},
+			wantKeys: []string{"key"},
+		},
+		{
+			name:     "base64",
+			key:      SecretKey{Name: "key", Type: SecretKeyTypeBase64, Length: 64},
+			wantKeys: []string{"key"},
+		},
+		{
+			name:     "uuid",
+			key:      SecretKey{Name: "id", Type: SecretKeyTypeUUID},
+			wantKeys: []string{"id"},
+		},
+		{
+			name:     "rsa",
+			key:      SecretKey{Name: "jwt", Type: SecretKeyTypeRSA},
+			wantKeys: []string{"jwt", "jwt.pub"},
+		},
+		{
+			name:     "ed25519",
+			key:      SecretKey{Name: "ssh", Type: SecretKeyTypeEd25519},
+			wantKeys: []string{"ssh", "ssh.pub"},
+		},
+		{
+			name:     "bcrypt",
+			key:      SecretKey{Name: "admin", Type: SecretKeyTypeBcrypt},
+			wantKeys: []string{"admin", "admin.bcrypt"},
+		},
+		{
+			name:     "htpasswd",
+			key:      SecretKey{Name: "auth", Type: SecretKeyTypeHtpasswd, Username: "user"},
+			wantKeys: []string{"auth", "auth.htpasswd"},
+		},
+		{
+			name:     "tls",
+			key:      SecretKey{Name: "tls", Type: SecretKeyTypeTLS, Hosts: []string{"server.immich.svc"}},
+			wantKeys: []string{"tls.crt", "tls.key"},
+		},
+		{
+			name:    "unknown type",
+			key:     SecretKey{Name: "key", Type: "dsa"},
+			wantErr: true,
+		},
+		{
+			name:    "too short",
+			key:     SecretKey{Name: "key", Type: SecretKeyTypeHex, Length: 4},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := generateKey(tt.key)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			keys := []string{}
+			for k, v := range got {
+				keys = append(keys, k)
+				assert.NotEmpty(t, v, k)
+			}
+			slices.Sort(keys)
+			assert.Equal(t, tt.wantKeys, keys)
+		})
+	}
+}
+
+func TestGenerateKeyEncoding(t *testing.T) {
+	got, err := generateKey(SecretKey{Name: "key", Type: SecretKeyTypeHex, Length: 16})
+	assert.NoError(t, err)
+	b, err := hex.DecodeString(string(got["key"]))
+	assert.NoError(t, err)
+	assert.Len(t, b, 16)
+
+	got, err = generateKey(SecretKey{Name: "key", Type: SecretKeyTypeBase64})
+	assert.NoError(t, err)
+	b, err = base64.StdEncoding.DecodeString(string(got["key"]))
+	assert.NoError(t, err)
+	assert.Len(t, b, 32)
+
+	got, err = generateKey(SecretKey{Name: "admin", Type: SecretKeyTypeBcrypt})
+	assert.NoError(t, err)
+	assert.NoError(t, bcrypt.CompareHashAndPassword(got["admin.bcrypt"], got["admin"]))
+}
+
+func TestCreateSecret(t *testing.T) {
+	app := &v1.App{ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "home-cloud-system"}}
+	s := AppSecret{Name: "immich", Keys: []SecretKey{
+		{Name: "password"},
+		{Name: "jwt", Type: SecretKeyTypeEd25519},
+		{Name: "admin", Type: SecretKeyTypeBcrypt},
+	}}
+	private, public, err := secrets.Ed25519KeyPair()
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		existing []client.Object
+		// expected values of the keys which must be kept
+		wantKept map[string][]byte
+	}{
+		{
+			name: "new secret",
+		},
+		{
+			name: "all keys exist",
+			existing: []client.Object{&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "immich"},
+				Data: map[string][]byte{
+					"password": []byte("existing"), "jwt": private, "jwt.pub": public,
+					"admin": []byte("admin"), "admin.bcrypt": []byte("hash"),
+				},
+			}},
+			wantKept: map[string][]byte{
+				"password": []byte("existing"), "jwt": private, "jwt.pub": public,
+				"admin": []byte("admin"), "admin.bcrypt": []byte("hash"),
+			},
+		},
+		{
+			name: "key added by a new chart version",
+			existing: []client.Object{&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "immich"},
+				Data:       map[string][]byte{"password": []byte("existing")},
+			}},
+			wantKept: map[string][]byte{"password": []byte("existing")},
+		},
+		{
+			name: "missing values are derived from the existing keys",
+			existing: []client.Object{&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "immich"},
+				// e.g. admin was a password before
+				Data: map[string][]byte{"password": []byte("existing"), "jwt": private, "admin": []byte("admin")},
+			}},
+			wantKept: map[string][]byte{
+				"password": []byte("existing"), "jwt": private, "jwt.pub": public, "admin": []byte("admin"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestReconciler(tt.existing...)
+
+			err := r.createSecret(context.Background(), app, s, "immich")
+			assert.NoError(t, err)
+
+			secret := &corev1.Secret{}
+			err = r.Get(context.Background(), types.NamespacedName{Namespace: "immich", Name: "immich"}, secret)
+			assert.NoError(t, err)
+			assert.Equal(t, "immich", secret.Labels[v1.AppLabel])
+			for _, k := range s.Keys {
+				for _, name := range keyNames(k) {
+					assert.NotEmpty(t, secret.Data[name], name)
+				}
+			}
+			for name, value := range tt.wantKept {
+				assert.Equal(t, value, secret.Data[name], name)
+			}
+			if tt.wantKept["admin.bcrypt"] == nil {
+				assert.NoError(t, bcrypt.CompareHashAndPassword(secret.Data["admin.bcrypt"], secret.Data["admin"]))
+			}
+		})
+	}
+
+	// values which can't be derived from the existing key fail instead of replacing it
+	r := newTestReconciler(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "immich"},
+		Data:       map[string][]byte{"password": []byte("existing"), "jwt": []byte("private")},
+	})
+	err = r.createSecret(context.Background(), app, s, "immich")
+	assert.Error(t, err)
+}
+
+func TestRotateKeys(t *testing.T) {
+	app := &v1.App{ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "home-cloud-system"}}
+	appConfig := &AppConfig{
+		Namespace: "immich",
+		Secrets: []AppSecret{{Name: "immich", Keys: []SecretKey{
+			{Name: "password"},
+			{Name: "session", Type: SecretKeyTypeHex},
+		}}},
+	}
+	r := newTestReconciler(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "immich"},
+		Data:       map[string][]byte{"password": []byte("password"), "session": []byte("session")},
+	})
+
+	rotated, err := r.rotateKeys(context.Background(), app, appConfig, rotationRequest("immich/session@2025-01-10, other/key"))
+	assert.NoError(t, err)
+	assert.True(t, rotated)
+
+	secret := &corev1.Secret{}
+	err = r.Get(context.Background(), types.NamespacedName{Namespace: "immich", Name: "immich"}, secret)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("password"), secret.Data["password"])
+	assert.NotEqual(t, []byte("session"), secret.Data["session"])
+	assert.Len(t, secret.Data["session"], 64)
+
+	// keys which aren't declared are ignored
+	rotated, err = r.rotateKeys(context.Background(), app, appConfig, rotationRequest("immich/other"))
+	assert.NoError(t, err)
+	assert.False(t, rotated)
+}
@@ -0,0 +1,117 @@
+package apps
+
+import (
+	"context"
+	goerrors "errors"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+// setPhase records the phase the App is entering. A failed App keeps the Failed phase while it is
+// retried so that the status (and therefore reconciliation) doesn't flap between phases.
+func (r *AppReconciler) setPhase(ctx context.Context, app *v1.App, phase v1.AppPhase) error {
+	if app.Status.Phase == phase {
+		return nil
+	}
+	if app.Status.Phase == v1.AppPhaseFailed && phase != v1.AppPhaseUninstalling {
+		return nil
+	}
+
+	app.Status.Phase = phase
+	meta.SetStatusCondition(&app.Status.Conditions, metav1.Condition{
+		Type:               v1.AppConditionReady,
+		Status:             metav1.ConditionFalse,
+		Reason:             string(phase),
+		ObservedGeneration: app.Generation,
+	})
+	return r.Status().Update(ctx, app)
+}
+
+// setCondition records a successful step of the App reconcile. The status is only written if the
+// condition changed.
+func (r *AppReconciler) setCondition(ctx context.Context, app *v1.App, conditionType string, reason string) error {
+	changed := meta.SetStatusCondition(&app.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		ObservedGeneration: app.Generation,
+	})
+	if !changed {
+		return nil
+	}
+	return r.Status().Update(ctx, app)
+}
+
+// routesReconciled records the outcome of reconciling the routes of the App on the RoutesReady
+// condition. Rejected hostnames are reported without failing the App as its other routes are served.
+func (r *AppReconciler) routesReconciled(ctx context.Context, app *v1.App, err error) error {
+	switch {
+	case err == nil:
+		return r.setCondition(ctx, app, v1.AppConditionRoutesReady, "RoutesCreated")
+	case goerrors.Is(err, errAuthUnsupported):
+		return r.fail(ctx, app, v1.AppConditionRoutesReady, "AuthUnsupported", err)
+	case goerrors.Is(err, errHostnameRejected):
+		changed := meta.SetStatusCondition(&app.Status.Conditions, metav1.Condition{
+			Type:               v1.AppConditionRoutesReady,
+			Status:             metav1.ConditionFalse,
+			Reason:             "HostnameRejected",
+			Message:            err.Error(),
+			ObservedGeneration: app.Generation,
+		})
+		if !changed {
+			return nil
+		}
+		return r.Status().Update(ctx, app)
+	}
+	return r.fail(ctx, app, v1.AppConditionRoutesReady, "RoutesFailed", err)
+}
+
+// fail records a failed step of the App reconcile on the status and returns the given error so that
+// the reconcile is retried.
+func (r *AppReconciler) fail(ctx context.Context, app *v1.App, conditionType string, reason string, err error) error {
+	// an App being deleted stays in the Uninstalling phase
+	if app.GetDeletionTimestamp() == nil {
+		app.Status.Phase = v1.AppPhaseFailed
+	}
+	app.Status.Error = err.Error()
+	app.Status.ObservedGeneration = app.Generation
+	for _, t := range []string{conditionType, v1.AppConditionReady} {
+		meta.SetStatusCondition(&app.Status.Conditions, metav1.Condition{
+			Type:               t,
+			Status:             metav1.ConditionFalse,
+			Reason:             reason,
+			Message:            err.Error(),
+			ObservedGeneration: app.Generation,
+		})
+	}
+
+	statusErr := r.Status().Update(ctx, app)
+	if statusErr != nil {
+		log.FromContext(ctx).Error(statusErr, "Failed to update App status")
+	}
+	return err
+}
+
+// updateStatus records the successful install of the requested version and values.
+func (r *AppReconciler) updateStatus(ctx context.Context, app *v1.App) error {
+	app.Status.Version = app.Spec.Version
+	app.Status.Values = app.Spec.Values
+	app.Status.Phase = v1.AppPhaseInstalled
+	app.Status.Error = ""
+	app.Status.FailedVersion = ""
+	app.Status.RolledBackGeneration = 0
+	app.Status.UpgradeRevision = 0
+	app.Status.UpgradeStarted = nil
+	app.Status.ObservedGeneration = app.Generation
+	meta.SetStatusCondition(&app.Status.Conditions, metav1.Condition{
+		Type:               v1.AppConditionReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             string(v1.AppPhaseInstalled),
+		ObservedGeneration: app.Generation,
+	})
+	return r.Status().Update(ctx, app)
+}
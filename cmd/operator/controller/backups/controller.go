@@ -0,0 +1,299 @@
+package backups
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+	"github.com/home-cloud-io/core/cmd/operator/controller/apps"
+)
+
+const (
+	// how often a running backup job is checked for completion
+	pollInterval = 10 * time.Second
+)
+
+// BackupReconciler reconciles a Backup object
+type BackupReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// Reconcile runs a Job for each new Backup and tracks it until it completes.
+func (r *BackupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	l := log.FromContext(ctx)
+	l.Info("Reconciling Backup")
+
+	// Get the CRD that triggered reconciliation
+	backup := &v1.Backup{}
+	err := r.Get(ctx, req.NamespacedName, backup)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			l.Info("Backup resource not found. Assuming this means the resource was deleted and so ignoring.")
+			return ctrl.Result{}, nil
+		}
+		l.Info("Failed to get Backup resource. Re-running reconcile.")
+		return ctrl.Result{}, err
+	}
+
+	switch backup.Status.Phase {
+	case "":
+		l.Info("Starting Backup")
+		err = r.start(ctx, backup)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: pollInterval}, nil
+	case v1.BackupPhaseRunning:
+		done, err := r.check(ctx, backup)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !done {
+			return ctrl.Result{RequeueAfter: pollInterval}, nil
+		}
+		return ctrl.Result{}, r.prune(ctx, backup)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *BackupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1.Backup{}).
+		Complete(r)
+}
+
+// start creates the Job for the Backup.
+func (r *BackupReconciler) start(ctx context.Context, backup *v1.Backup) error {
+	app, appConfig, err := r.app(ctx, backup)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return r.fail(ctx, backup, fmt.Errorf("app not found: %s", backup.Spec.App))
+		}
+		return err
+	}
+
+	expired, err := r.expired(ctx, backup)
+	if err != nil {
+		return err
+	}
+	names := []string{}
+	for _, b := range expired {
+		names = append(names, b.Name)
+	}
+
+	job, err := backupJob(backup, app, appConfig, names)
+	if err != nil {
+		return r.fail(ctx, backup, err)
+	}
+	err = r.Create(ctx, job)
+	if err != nil {
+		if !errors.IsAlreadyExists(err) {
+			return err
+		}
+		err = r.Get(ctx, client.ObjectKeyFromObject(job), job)
+		if err != nil {
+			return err
+		}
+	}
+
+	// copy the S3 credentials next to the job so they can be mounted (removed along with the job)
+	if backup.Spec.Target.S3 != nil {
+		err = createCredentials(ctx, r.Client, r.Scheme, backup.Namespace, backup.Spec.Target.S3, job)
+		if err != nil {
+			return err
+		}
+	}
+
+	now := metav1.Now()
+	backup.Status.Phase = v1.BackupPhaseRunning
+	backup.Status.StartTime = &now
+	backup.Status.Location = location(backup)
+	return r.Status().Update(ctx, backup)
+}
+
+// check updates the Backup from the status of its Job and reports whether the Backup is done.
+func (r *BackupReconciler) check(ctx context.Context, backup *v1.Backup) (bool, error) {
+	_, appConfig, err := r.app(ctx, backup)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return true, r.fail(ctx, backup, fmt.Errorf("app not found: %s", backup.Spec.App))
+		}
+		return false, err
+	}
+
+	// the job runs in the App namespace: Backups of the same name in other namespaces have their
+	// own jobs
+	jobs := &batchv1.JobList{}
+	err = r.List(ctx, jobs, client.InNamespace(appConfig.Namespace), client.MatchingLabels{v1.BackupLabel: backup.Name})
+	if err != nil {
+		return false, err
+	}
+	if len(jobs.Items) == 0 {
+		return true, r.fail(ctx, backup, fmt.Errorf("backup job not found"))
+	}
+
+	for _, condition := range jobs.Items[0].Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			now := metav1.Now()
+			backup.Status.Phase = v1.BackupPhaseCompleted
+			backup.Status.CompletionTime = &now
+			return true, r.Status().Update(ctx, backup)
+		case batchv1.JobFailed:
+			return true, r.fail(ctx, backup, fmt.Errorf("backup job failed: %s", condition.Message))
+		}
+	}
+
+	return false, nil
+}
+
+// app gets the App of the Backup and its config.
+func (r *BackupReconciler) app(ctx context.Context, backup *v1.Backup) (*v1.App, *apps.AppConfig, error) {
+	app := &v1.App{}
+	err := r.Get(ctx, types.NamespacedName{
+		Namespace: backup.Namespace,
+		Name:      backup.Spec.App,
+	}, app)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// read combined app config from chart values and override values configured in the app
+	appConfig, err := apps.Config(ctx, r.Client, app)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return app, appConfig, nil
+}
+
+// prune removes the expired Backups once the Backup has completed. The backup data itself was
+// removed from the target by the backup job.
+func (r *BackupReconciler) prune(ctx context.Context, backup *v1.Backup) error {
+	if backup.Status.Phase != v1.BackupPhaseCompleted {
+		return nil
+	}
+
+	expired, err := r.expired(ctx, backup)
+	if err != nil {
+		return err
+	}
+	for _, b := range expired {
+		err = r.Delete(ctx, &b)
+		if client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// expired returns the oldest completed Backups of the same schedule which are beyond the retention
+// count once the Backup is completed too.
+func (r *BackupReconciler) expired(ctx context.Context, backup *v1.Backup) ([]v1.Backup, error) {
+	schedule, ok := backup.Labels[v1.BackupScheduleLabel]
+	if !ok || backup.Spec.Keep <= 0 {
+		return nil, nil
+	}
+
+	backups := &v1.BackupList{}
+	err := r.List(ctx, backups, client.InNamespace(backup.Namespace), client.MatchingLabels{v1.BackupScheduleLabel: schedule})
+	if err != nil {
+		return nil, err
+	}
+
+	// sort newest first (the Backup is the newest)
+	completed := slices.DeleteFunc(backups.Items, func(b v1.Backup) bool {
+		return b.Status.Phase != v1.BackupPhaseCompleted || b.Name == backup.Name
+	})
+	slices.SortFunc(completed, func(a, b v1.Backup) int {
+		return b.CreationTimestamp.Compare(a.CreationTimestamp.Time)
+	})
+
+	keep := backup.Spec.Keep - 1
+	if len(completed) <= keep {
+		return nil, nil
+	}
+	return completed[keep:], nil
+}
+
+// createCredentials copies the credentials of the S3 target into a Secret named after the Job and
+// owned by it so that it is removed along with the Job. The referenced Secrets default to the given
+// namespace.
+func createCredentials(ctx context.Context, c client.Client, scheme *runtime.Scheme, namespace string, target *v1.S3BackupTarget, job *batchv1.Job) error {
+	accessKeyID, err := secretValue(ctx, c, namespace, target.AccessKeyID)
+	if err != nil {
+		return err
+	}
+	secretAccessKey, err := secretValue(ctx, c, namespace, target.SecretAccessKey)
+	if err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      job.Name,
+			Namespace: job.Namespace,
+			Labels:    job.Labels,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"accessKeyID":     accessKeyID,
+			"secretAccessKey": secretAccessKey,
+		},
+	}
+	err = controllerutil.SetOwnerReference(job, secret, scheme)
+	if err != nil {
+		return err
+	}
+	return client.IgnoreAlreadyExists(c.Create(ctx, secret))
+}
+
+// secretValue reads the referenced value from a Secret. The Secret defaults to the given namespace.
+func secretValue(ctx context.Context, c client.Reader, namespace string, ref v1.SecretReference) ([]byte, error) {
+	if ref.Namespace != nil {
+		namespace = *ref.Namespace
+	}
+	secret := &corev1.Secret{}
+	err := c.Get(ctx, types.NamespacedName{
+		Namespace: namespace,
+		Name:      ref.Name,
+	}, secret)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := secret.Data[ref.DataKey]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found in secret %s/%s", ref.DataKey, namespace, ref.Name)
+	}
+	return value, nil
+}
+
+// fail records the error on the Backup status. The error is not returned since retrying won't fix it.
+func (r *BackupReconciler) fail(ctx context.Context, backup *v1.Backup, err error) error {
+	log.FromContext(ctx).Error(err, "Backup failed")
+	now := metav1.Now()
+	backup.Status.Phase = v1.BackupPhaseFailed
+	backup.Status.CompletionTime = &now
+	backup.Status.Error = err.Error()
+	return r.Status().Update(ctx, backup)
+}
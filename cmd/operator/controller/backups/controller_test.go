@@ -0,0 +1,82 @@
+package backups
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+func TestPrune(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	assert.NoError(t, clientgoscheme.AddToScheme(scheme))
+	assert.NoError(t, v1.AddToScheme(scheme))
+
+	start := time.Date(2025, 1, 1, 2, 0, 0, 0, time.UTC)
+	scheduled := func(schedule string, day int, phase v1.BackupPhase) *v1.Backup {
+		created := start.AddDate(0, 0, day)
+		return &v1.Backup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              schedule + "-" + created.Format(backupTimeFormat),
+				Namespace:         "home-cloud-system",
+				Labels:            map[string]string{v1.BackupScheduleLabel: schedule},
+				CreationTimestamp: metav1.Time{Time: created},
+			},
+			Spec:   v1.BackupSpec{App: "immich", Keep: 2},
+			Status: v1.BackupStatus{Phase: phase},
+		}
+	}
+	backup := scheduled("nightly", 3, v1.BackupPhaseRunning)
+
+	r := &BackupReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			backup,
+			scheduled("nightly", 2, v1.BackupPhaseCompleted),
+			scheduled("nightly", 1, v1.BackupPhaseCompleted),
+			scheduled("nightly", 0, v1.BackupPhaseCompleted),
+			// failed backups and those of other schedules are never expired
+			scheduled("nightly", -1, v1.BackupPhaseFailed),
+			scheduled("nightly-db", -2, v1.BackupPhaseCompleted),
+		).Build(),
+		Scheme: scheme,
+	}
+
+	// the backup and the newest completed one are kept
+	expired, err := r.expired(ctx, backup)
+	assert.NoError(t, err)
+	names := []string{}
+	for _, b := range expired {
+		names = append(names, b.Name)
+	}
+	assert.Equal(t, []string{"nightly-20250102020000", "nightly-20250101020000"}, names)
+
+	// nothing is pruned until the backup has completed
+	err = r.prune(ctx, backup)
+	assert.NoError(t, err)
+	backups := &v1.BackupList{}
+	assert.NoError(t, r.List(ctx, backups))
+	assert.Len(t, backups.Items, 6)
+
+	backup.Status.Phase = v1.BackupPhaseCompleted
+	err = r.prune(ctx, backup)
+	assert.NoError(t, err)
+	assert.NoError(t, r.List(ctx, backups))
+	names = []string{}
+	for _, b := range backups.Items {
+		names = append(names, b.Name)
+	}
+	assert.ElementsMatch(t, []string{
+		"nightly-20250104020000",
+		"nightly-20250103020000",
+		"nightly-20241231020000",
+		"nightly-db-20241230020000",
+	}, names)
+}
@@ -0,0 +1,416 @@
+package backups
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+	"github.com/home-cloud-io/core/cmd/operator/controller/apps"
+)
+
+const (
+	// TODO: make these configurable through the Install
+	PostgresImage    = "postgres:17-alpine"
+	MariaDBImage     = "mariadb:11"
+	ArchiveImage     = "alpine:3.22"
+	MinioClientImage = "minio/mc:RELEASE.2024-11-21T17-21-54Z"
+
+	// directories within the backup job containers
+	backupDir  = "/backup"
+	volumesDir = "/volumes"
+	targetDir  = "/target"
+
+	// keep finished jobs around for a day so that their logs can be inspected
+	jobTTLSeconds = 24 * 60 * 60
+)
+
+// jobResources are the resources of every container of the backup and restore jobs. They're set
+// explicitly since the jobs run in the App namespace where a quota on the App limits rejects
+// containers which don't declare them.
+var jobResources = corev1.ResourceRequirements{
+	Requests: corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("100m"),
+		corev1.ResourceMemory: resource.MustParse("128Mi"),
+	},
+	Limits: corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("1"),
+		corev1.ResourceMemory: resource.MustParse("1Gi"),
+	},
+}
+
+// jobName returns the name of the Job which runs the given Backup.
+func jobName(backup *v1.Backup) string {
+	return fmt.Sprintf("backup-%s", backup.Name)
+}
+
+// restoreJobName returns the name of the Job which runs the given Restore.
+func restoreJobName(restore *v1.Restore) string {
+	return fmt.Sprintf("restore-%s", restore.Name)
+}
+
+// location returns where the given Backup is stored within its target.
+func location(backup *v1.Backup) string {
+	target := backup.Spec.Target
+	switch {
+	case target.Local != nil:
+		return path.Join(target.Local.Path, backup.Spec.App, backup.Name)
+	case target.S3 != nil:
+		return "s3://" + path.Join(target.S3.Bucket, target.S3.Prefix, backup.Spec.App, backup.Name)
+	}
+	return ""
+}
+
+func validateTarget(target v1.BackupTarget) error {
+	if (target.Local == nil) == (target.S3 == nil) {
+		return fmt.Errorf("exactly one of local or s3 must be set on the backup target")
+	}
+	if target.Local != nil && !path.IsAbs(target.Local.Path) {
+		return fmt.Errorf("local backup target path must be absolute: %s", target.Local.Path)
+	}
+	if target.S3 != nil && (target.S3.Endpoint == "" || target.S3.Bucket == "") {
+		return fmt.Errorf("s3 backup target requires an endpoint and bucket")
+	}
+	return nil
+}
+
+// backupJob creates the Job which dumps every database and archives every volume of the App into a
+// scratch directory and then copies the result to the target of the Backup. The expired backups
+// (see BackupReconciler.expired) are then removed from the target.
+//
+// The backup is laid out as:
+//
+//	<location>/databases/<database>.dump (postgres custom format) or <database>.sql (mysql)
+//	<location>/volumes/<persistence>.tar.gz
+func backupJob(backup *v1.Backup, app *v1.App, appConfig *apps.AppConfig, expired []string) (*batchv1.Job, error) {
+	err := validateTarget(backup.Spec.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	pod := scratchPod()
+
+	// dump databases
+	for i, d := range appConfig.Databases {
+		c, err := databaseContainer(fmt.Sprintf("database-%d", i), d, false)
+		if err != nil {
+			return nil, err
+		}
+		pod.InitContainers = append(pod.InitContainers, c)
+	}
+
+	// archive volumes
+	if len(appConfig.Persistence) > 0 {
+		commands := []string{"mkdir -p " + shellQuote(path.Join(backupDir, "volumes"))}
+		for _, p := range appConfig.Persistence {
+			commands = append(commands, fmt.Sprintf("tar -czf %s -C %s .",
+				shellQuote(archivePath(p)), shellQuote(path.Join(volumesDir, p.Name))))
+		}
+		pod.InitContainers = append(pod.InitContainers, volumesContainer(&pod, app, appConfig, true, commands))
+	}
+
+	// copy to target
+	upload := uploadContainer(backup, expired, &pod)
+	upload.VolumeMounts = append(upload.VolumeMounts, scratchMount())
+	pod.Containers = []corev1.Container{upload}
+
+	return job(jobName(backup), appConfig.Namespace, v1.BackupLabel, backup.Name, pod), nil
+}
+
+// restoreJob creates the Job which copies the given Backup from its target into a scratch directory
+// and then restores every database and volume of the App from it. The workloads of the App must
+// be stopped before the Job is run.
+func restoreJob(restore *v1.Restore, backup *v1.Backup, app *v1.App, appConfig *apps.AppConfig) (*batchv1.Job, error) {
+	err := validateTarget(backup.Spec.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	pod := scratchPod()
+
+	// copy from target
+	download := downloadContainer(backup, restoreJobName(restore), &pod)
+	download.VolumeMounts = append(download.VolumeMounts, scratchMount())
+	pod.InitContainers = append(pod.InitContainers, download)
+
+	// restore databases
+	for i, d := range appConfig.Databases {
+		c, err := databaseContainer(fmt.Sprintf("database-%d", i), d, true)
+		if err != nil {
+			return nil, err
+		}
+		pod.InitContainers = append(pod.InitContainers, c)
+	}
+
+	// replace the contents of each volume with the archive
+	if len(appConfig.Persistence) > 0 {
+		var commands []string
+		for _, p := range appConfig.Persistence {
+			dir := shellQuote(path.Join(volumesDir, p.Name))
+			commands = append(commands, fmt.Sprintf("find %s -mindepth 1 -delete && tar -xzf %s -C %s",
+				dir, shellQuote(archivePath(p)), dir))
+		}
+		pod.InitContainers = append(pod.InitContainers, volumesContainer(&pod, app, appConfig, false, commands))
+	}
+
+	// a job needs at least one container so report completion once all of the init containers are done
+	pod.Containers = []corev1.Container{
+		{
+			Name:    "complete",
+			Image:   ArchiveImage,
+			Command: []string{"/bin/sh", "-c", fmt.Sprintf("echo %s", shellQuote("restored backup "+backup.Name))},
+		},
+	}
+
+	return job(restoreJobName(restore), appConfig.Namespace, v1.RestoreLabel, restore.Name, pod), nil
+}
+
+func job(name string, namespace string, label string, owner string, pod corev1.PodSpec) *batchv1.Job {
+	for i := range pod.InitContainers {
+		pod.InitContainers[i].Resources = *jobResources.DeepCopy()
+	}
+	for i := range pod.Containers {
+		pod.Containers[i].Resources = *jobResources.DeepCopy()
+	}
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				label: owner,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            ptr.To(int32(1)),
+			TTLSecondsAfterFinished: ptr.To(int32(jobTTLSeconds)),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						label: owner,
+					},
+				},
+				Spec: pod,
+			},
+		},
+	}
+}
+
+// scratchPod creates a pod spec with the scratch volume that backups are staged in.
+func scratchPod() corev1.PodSpec {
+	return corev1.PodSpec{
+		RestartPolicy: corev1.RestartPolicyNever,
+		Volumes: []corev1.Volume{
+			{
+				Name: "backup",
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{},
+				},
+			},
+		},
+	}
+}
+
+func scratchMount() corev1.VolumeMount {
+	return corev1.VolumeMount{
+		Name:      "backup",
+		MountPath: backupDir,
+	}
+}
+
+func archivePath(p apps.AppPersistence) string {
+	return path.Join(backupDir, "volumes", p.Name+".tar.gz")
+}
+
+// databaseContainer creates the container which dumps the given database into the scratch directory
+// (or restores it from there) using the app credentials of the database.
+func databaseContainer(name string, d apps.AppDatabase, restore bool) (corev1.Container, error) {
+	fromSecret := secretEnv(apps.DatabaseSecretName(d))
+	dir := path.Join(backupDir, "databases")
+
+	var c corev1.Container
+	switch d.Type {
+	case "postgres":
+		file := shellQuote(path.Join(dir, d.Name+".dump"))
+		c = corev1.Container{
+			Image: PostgresImage,
+			Env: []corev1.EnvVar{
+				fromSecret("PGHOST", "hostname"),
+				fromSecret("PGPORT", "port"),
+				fromSecret("PGUSER", "username"),
+				fromSecret("PGPASSWORD", "password"),
+				fromSecret("PGDATABASE", "database"),
+			},
+			Command: []string{"/bin/sh", "-c", fmt.Sprintf("mkdir -p %s && pg_dump --format=custom --file=%s", shellQuote(dir), file)},
+		}
+		if restore {
+			// objects are owned by the app user so --no-owner avoids needing the original roles
+			c.Command[2] = fmt.Sprintf(`pg_restore --clean --if-exists --no-owner --dbname="$PGDATABASE" %s`, file)
+		}
+	case "mysql":
+		file := shellQuote(path.Join(dir, d.Name+".sql"))
+		c = corev1.Container{
+			Image: MariaDBImage,
+			Env: []corev1.EnvVar{
+				fromSecret("DB_HOST", "hostname"),
+				fromSecret("DB_PORT", "port"),
+				fromSecret("DB_USER", "username"),
+				// read by the client so the password isn't on the command line
+				fromSecret("MYSQL_PWD", "password"),
+				fromSecret("DB_NAME", "database"),
+			},
+			Command: []string{"/bin/sh", "-c", fmt.Sprintf(`mkdir -p %s && mariadb-dump --host="$DB_HOST" --port="$DB_PORT" --user="$DB_USER" --single-transaction "$DB_NAME" > %s`, shellQuote(dir), file)},
+		}
+		if restore {
+			c.Command[2] = fmt.Sprintf(`mariadb --host="$DB_HOST" --port="$DB_PORT" --user="$DB_USER" "$DB_NAME" < %s`, file)
+		}
+	default:
+		return corev1.Container{}, fmt.Errorf("unsupported database type requested: %s", d.Type)
+	}
+
+	c.Name = name
+	c.VolumeMounts = []corev1.VolumeMount{scratchMount()}
+	return c, nil
+}
+
+// volumesContainer creates the container which runs the given commands with every volume of the App
+// mounted under the volumes directory. The volumes are added to the pod.
+func volumesContainer(pod *corev1.PodSpec, app *v1.App, appConfig *apps.AppConfig, readOnly bool, commands []string) corev1.Container {
+	c := corev1.Container{
+		Name:         "volumes",
+		Image:        ArchiveImage,
+		Command:      []string{"/bin/sh", "-c", strings.Join(commands, " && ")},
+		VolumeMounts: []corev1.VolumeMount{scratchMount()},
+	}
+	for i, p := range appConfig.Persistence {
+		volume := fmt.Sprintf("volume-%d", i)
+		pod.Volumes = append(pod.Volumes, corev1.Volume{
+			Name: volume,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: apps.VolumeName(app, p),
+					ReadOnly:  readOnly,
+				},
+			},
+		})
+		c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{
+			Name:      volume,
+			MountPath: path.Join(volumesDir, p.Name),
+			ReadOnly:  readOnly,
+		})
+	}
+	return c
+}
+
+// uploadContainer creates the container which copies the scratch directory to the target and then
+// removes the expired backups of the App from it. Any volumes needed for the target are added to the
+// pod.
+func uploadContainer(backup *v1.Backup, expired []string, pod *corev1.PodSpec) corev1.Container {
+	target := backup.Spec.Target
+
+	if target.Local != nil {
+		appDir := path.Join(targetDir, backup.Spec.App)
+		dest := path.Join(appDir, backup.Name)
+		script := fmt.Sprintf("mkdir -p %s && cp -R %s/. %s/", shellQuote(dest), backupDir, shellQuote(dest))
+		for _, name := range expired {
+			script += " && rm -rf " + shellQuote(path.Join(appDir, name))
+		}
+		return localContainer("upload", target.Local, pod, script)
+	}
+
+	base := "target/" + path.Join(target.S3.Bucket, target.S3.Prefix, backup.Spec.App)
+	script := fmt.Sprintf("mc cp --recursive %s/ %s/", backupDir, shellQuote(path.Join(base, backup.Name)))
+	for _, name := range expired {
+		script += fmt.Sprintf(" && mc rm --recursive --force %s/", shellQuote(path.Join(base, name)))
+	}
+	return s3Container("upload", target.S3, jobName(backup), script)
+}
+
+// downloadContainer creates the container which copies the given Backup from its target into the
+// scratch directory. Any volumes needed for the target are added to the pod.
+func downloadContainer(backup *v1.Backup, credentials string, pod *corev1.PodSpec) corev1.Container {
+	target := backup.Spec.Target
+
+	if target.Local != nil {
+		src := path.Join(targetDir, backup.Spec.App, backup.Name)
+		return localContainer("download", target.Local, pod, fmt.Sprintf("cp -R %s/. %s/", shellQuote(src), backupDir))
+	}
+
+	src := "target/" + path.Join(target.S3.Bucket, target.S3.Prefix, backup.Spec.App, backup.Name)
+	return s3Container("download", target.S3, credentials, fmt.Sprintf("mc cp --recursive %s/ %s/", shellQuote(src), backupDir))
+}
+
+// localContainer creates a container which runs the given script with the local target mounted at
+// the target directory. The target volume is added to the pod.
+func localContainer(name string, target *v1.LocalBackupTarget, pod *corev1.PodSpec, script string) corev1.Container {
+	pod.Volumes = append(pod.Volumes, corev1.Volume{
+		Name: "target",
+		VolumeSource: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{
+				Path: target.Path,
+				Type: ptr.To(corev1.HostPathDirectoryOrCreate),
+			},
+		},
+	})
+	return corev1.Container{
+		Name:    name,
+		Image:   ArchiveImage,
+		Command: []string{"/bin/sh", "-c", script},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      "target",
+				MountPath: targetDir,
+			},
+		},
+	}
+}
+
+// s3Container creates a container which runs the given script with the S3 target available under
+// the "target" mc alias. The credentials are read from the Secret with the given name.
+func s3Container(name string, target *v1.S3BackupTarget, credentials string, script string) corev1.Container {
+	fromSecret := secretEnv(credentials)
+	return corev1.Container{
+		Name:  name,
+		Image: MinioClientImage,
+		Command: []string{"/bin/sh", "-c",
+			`mc alias set target "$S3_ENDPOINT" "$S3_ACCESS_KEY_ID" "$S3_SECRET_ACCESS_KEY" && ` + script},
+		Env: []corev1.EnvVar{
+			{
+				Name:  "S3_ENDPOINT",
+				Value: target.Endpoint,
+			},
+			fromSecret("S3_ACCESS_KEY_ID", "accessKeyID"),
+			fromSecret("S3_SECRET_ACCESS_KEY", "secretAccessKey"),
+			// mc needs a writable config directory
+			{
+				Name:  "MC_CONFIG_DIR",
+				Value: "/tmp/.mc",
+			},
+		},
+	}
+}
+
+// secretEnv returns a function which creates environment variables from keys of the named Secret.
+func secretEnv(secretName string) func(env string, key string) corev1.EnvVar {
+	return func(env string, key string) corev1.EnvVar {
+		return corev1.EnvVar{
+			Name: env,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+					Key:                  key,
+				},
+			},
+		}
+	}
+}
+
+// shellQuote quotes the given value for use as a single word in a shell script.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,194 @@
+package backups
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+	"github.com/home-cloud-io/core/cmd/operator/controller/apps"
+)
+
+func TestValidateTarget(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  v1.BackupTarget
+		wantErr bool
+	}{
+		{name: "local", target: v1.BackupTarget{Local: &v1.LocalBackupTarget{Path: "/mnt/backups"}}},
+		{name: "s3", target: v1.BackupTarget{S3: &v1.S3BackupTarget{Endpoint: "https://s3.example.com", Bucket: "backups"}}},
+		{name: "none", target: v1.BackupTarget{}, wantErr: true},
+		{
+			name: "both",
+			target: v1.BackupTarget{
+				Local: &v1.LocalBackupTarget{Path: "/mnt/backups"},
+				S3:    &v1.S3BackupTarget{Endpoint: "https://s3.example.com", Bucket: "backups"},
+			},
+			wantErr: true,
+		},
+		{name: "relative local path", target: v1.BackupTarget{Local: &v1.LocalBackupTarget{Path: "backups"}}, wantErr: true},
+		{name: "s3 without bucket", target: v1.BackupTarget{S3: &v1.S3BackupTarget{Endpoint: "https://s3.example.com"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTarget(tt.target)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBackupJob(t *testing.T) {
+	app := &v1.App{
+		ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "home-cloud-system"},
+		Spec: v1.AppSpec{
+			Release: "immich",
+			// the App namespace gets a quota on limits
+			Resources: &v1.ResourcesSpec{Limits: v1.ResourceAmounts{CPU: "2", Memory: "4Gi"}},
+		},
+	}
+	appConfig := &apps.AppConfig{
+		Namespace: "immich",
+		Databases: []apps.AppDatabase{{Name: "immich", Type: "postgres"}},
+		Persistence: []apps.AppPersistence{
+			{Name: "library", Size: "10Gi"},
+		},
+	}
+	backup := &v1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "nightly-20250101020000",
+			Namespace: "home-cloud-system",
+			Labels:    map[string]string{v1.BackupScheduleLabel: "nightly"},
+		},
+		Spec: v1.BackupSpec{
+			App:    "immich",
+			Target: v1.BackupTarget{Local: &v1.LocalBackupTarget{Path: "/mnt/backups"}},
+			Keep:   3,
+		},
+	}
+
+	job, err := backupJob(backup, app, appConfig, []string{"nightly-20241229020000", "nightly-20241228020000"})
+	assert.NoError(t, err)
+	assert.Equal(t, "backup-nightly-20250101020000", job.Name)
+	assert.Equal(t, "immich", job.Namespace)
+	assert.Equal(t, "/mnt/backups/immich/nightly-20250101020000", location(backup))
+
+	pod := job.Spec.Template.Spec
+	if assert.Len(t, pod.InitContainers, 2) {
+		assert.Equal(t, PostgresImage, pod.InitContainers[0].Image)
+		assert.Contains(t, pod.InitContainers[0].Command[2], "pg_dump --format=custom --file='/backup/databases/immich.dump'")
+		assert.Contains(t, pod.InitContainers[1].Command[2], "tar -czf '/backup/volumes/library.tar.gz' -C '/volumes/library' .")
+	}
+	if assert.Len(t, pod.Containers, 1) {
+		script := pod.Containers[0].Command[2]
+		assert.Contains(t, script, "cp -R /backup/. '/target/immich/nightly-20250101020000'/")
+		// removes the expired backups once the backup is copied
+		assert.Contains(t, script, "cp -R /backup/. '/target/immich/nightly-20250101020000'/ && rm -rf '/target/immich/nightly-20241229020000' && rm -rf '/target/immich/nightly-20241228020000'")
+	}
+
+	// every container declares its resources so the job fits the quota of the App namespace
+	for _, c := range append(pod.InitContainers, pod.Containers...) {
+		assert.Equal(t, jobResources, c.Resources, c.Name)
+	}
+
+	// volumes are mounted read-only from the app claims
+	var claims []string
+	for _, volume := range pod.Volumes {
+		if volume.PersistentVolumeClaim != nil {
+			assert.True(t, volume.PersistentVolumeClaim.ReadOnly)
+			claims = append(claims, volume.PersistentVolumeClaim.ClaimName)
+		}
+	}
+	assert.Equal(t, []string{"immich-library"}, claims)
+}
+
+func TestUploadContainerS3(t *testing.T) {
+	backup := &v1.Backup{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly-20250101020000", Namespace: "home-cloud-system"},
+		Spec: v1.BackupSpec{
+			App: "immich",
+			Target: v1.BackupTarget{S3: &v1.S3BackupTarget{
+				Endpoint: "https://s3.example.com",
+				Bucket:   "backups",
+				Prefix:   "home",
+			}},
+		},
+	}
+
+	upload := uploadContainer(backup, []string{"nightly-20241229020000"}, &corev1.PodSpec{})
+	assert.Equal(t, MinioClientImage, upload.Image)
+	// only mc (and the shell) is needed to remove the expired backups
+	assert.True(t, strings.HasSuffix(upload.Command[2],
+		"mc cp --recursive /backup/ 'target/backups/home/immich/nightly-20250101020000'/ && mc rm --recursive --force 'target/backups/home/immich/nightly-20241229020000'/"))
+}
+
+func TestRestoreJob(t *testing.T) {
+	app := &v1.App{
+		ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "home-cloud-system"},
+		Spec:       v1.AppSpec{Release: "immich"},
+	}
+	appConfig := &apps.AppConfig{
+		Namespace: "immich",
+		Databases: []apps.AppDatabase{{Name: "immich", Type: "postgres"}, {Name: "photos", Type: "mysql"}},
+		Persistence: []apps.AppPersistence{
+			{Name: "library", Size: "10Gi"},
+		},
+	}
+	backup := &v1.Backup{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly-20250101020000", Namespace: "home-cloud-system"},
+		Spec: v1.BackupSpec{
+			App: "immich",
+			Target: v1.BackupTarget{S3: &v1.S3BackupTarget{
+				Endpoint: "https://s3.example.com",
+				Bucket:   "backups",
+				Prefix:   "home",
+			}},
+		},
+	}
+	restore := &v1.Restore{
+		ObjectMeta: metav1.ObjectMeta{Name: "immich-restore-x7k2p", Namespace: "home-cloud-system"},
+		Spec:       v1.RestoreSpec{Backup: backup.Name},
+	}
+
+	job, err := restoreJob(restore, backup, app, appConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, "restore-immich-restore-x7k2p", job.Name)
+	assert.Equal(t, "immich", job.Namespace)
+	assert.Equal(t, restore.Name, job.Labels[v1.RestoreLabel])
+
+	pod := job.Spec.Template.Spec
+	if assert.Len(t, pod.InitContainers, 4) {
+		download := pod.InitContainers[0]
+		assert.Equal(t, MinioClientImage, download.Image)
+		assert.Contains(t, download.Command[2], "mc cp --recursive 'target/backups/home/immich/nightly-20250101020000'/ /backup/")
+		// credentials are read from the secret created next to the job
+		assert.Equal(t, job.Name, download.Env[1].ValueFrom.SecretKeyRef.Name)
+
+		assert.Contains(t, pod.InitContainers[1].Command[2], `pg_restore --clean --if-exists --no-owner --dbname="$PGDATABASE" '/backup/databases/immich.dump'`)
+		assert.Contains(t, pod.InitContainers[2].Command[2], `"$DB_NAME" < '/backup/databases/photos.sql'`)
+		assert.Equal(t, "find '/volumes/library' -mindepth 1 -delete && tar -xzf '/backup/volumes/library.tar.gz' -C '/volumes/library'", pod.InitContainers[3].Command[2])
+	}
+	assert.Len(t, pod.Containers, 1)
+	for _, c := range append(pod.InitContainers, pod.Containers...) {
+		assert.Equal(t, jobResources, c.Resources, c.Name)
+	}
+
+	// volumes are mounted writable so they can be replaced
+	for _, volume := range pod.Volumes {
+		if volume.PersistentVolumeClaim != nil {
+			assert.False(t, volume.PersistentVolumeClaim.ReadOnly)
+			assert.Equal(t, "immich-library", volume.PersistentVolumeClaim.ClaimName)
+		}
+	}
+}
+
+func TestShellQuote(t *testing.T) {
+	assert.Equal(t, `'/mnt/backups'`, shellQuote("/mnt/backups"))
+	assert.Equal(t, `'/mnt/it'\''s; rm -rf /'`, shellQuote("/mnt/it's; rm -rf /"))
+}
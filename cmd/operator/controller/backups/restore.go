@@ -0,0 +1,347 @@
+package backups
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+	"github.com/home-cloud-io/core/cmd/operator/controller/apps"
+)
+
+const (
+	deploymentKind  = "Deployment"
+	statefulSetKind = "StatefulSet"
+)
+
+// RestoreReconciler reconciles a Restore object
+type RestoreReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// Reconcile restores an App from a Backup. The workloads of the App are stopped, a Job restores the
+// databases and volumes of the App from the Backup, and the workloads are started again.
+func (r *RestoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	l := log.FromContext(ctx)
+	l.Info("Reconciling Restore")
+
+	// Get the CRD that triggered reconciliation
+	restore := &v1.Restore{}
+	err := r.Get(ctx, req.NamespacedName, restore)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			l.Info("Restore resource not found. Assuming this means the resource was deleted and so ignoring.")
+			return ctrl.Result{}, nil
+		}
+		l.Info("Failed to get Restore resource. Re-running reconcile.")
+		return ctrl.Result{}, err
+	}
+
+	switch restore.Status.Phase {
+	case "":
+		l.Info("Stopping App for Restore")
+		err = r.stop(ctx, restore)
+	case v1.RestorePhaseStopping:
+		err = r.restore(ctx, restore)
+	case v1.RestorePhaseRestoring:
+		err = r.check(ctx, restore)
+	default:
+		return ctrl.Result{}, nil
+	}
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: pollInterval}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RestoreReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1.Restore{}).
+		Complete(r)
+}
+
+// stop scales the workloads of the App down to zero, recording their replicas so they can be
+// scaled back up once the Restore is done.
+func (r *RestoreReconciler) stop(ctx context.Context, restore *v1.Restore) error {
+	backup, app, appConfig, err := r.resources(ctx, restore)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return r.fail(ctx, restore, err)
+		}
+		return err
+	}
+	if backup.Status.Phase != v1.BackupPhaseCompleted {
+		return r.fail(ctx, restore, fmt.Errorf("backup has not completed: %s", backup.Name))
+	}
+
+	// record the replicas before scaling anything down in case the status update fails
+	if restore.Status.Replicas == nil {
+		replicas, err := r.replicas(ctx, appConfig.Namespace)
+		if err != nil {
+			return err
+		}
+		now := metav1.Now()
+		restore.Status.App = app.Name
+		restore.Status.StartTime = &now
+		restore.Status.Replicas = replicas
+		err = r.Status().Update(ctx, restore)
+		if err != nil {
+			return err
+		}
+	}
+
+	for workload := range restore.Status.Replicas {
+		err = r.scale(ctx, appConfig.Namespace, workload, 0)
+		if err != nil {
+			return err
+		}
+	}
+
+	restore.Status.Phase = v1.RestorePhaseStopping
+	return r.Status().Update(ctx, restore)
+}
+
+// restore creates the Job for the Restore once the workloads of the App have stopped.
+func (r *RestoreReconciler) restore(ctx context.Context, restore *v1.Restore) error {
+	backup, app, appConfig, err := r.resources(ctx, restore)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return r.fail(ctx, restore, err)
+		}
+		return err
+	}
+
+	stopped, err := r.stopped(ctx, appConfig.Namespace)
+	if err != nil || !stopped {
+		return err
+	}
+
+	job, err := restoreJob(restore, backup, app, appConfig)
+	if err != nil {
+		return r.fail(ctx, restore, err)
+	}
+	err = r.Create(ctx, job)
+	if err != nil {
+		if !errors.IsAlreadyExists(err) {
+			return err
+		}
+		err = r.Get(ctx, client.ObjectKeyFromObject(job), job)
+		if err != nil {
+			return err
+		}
+	}
+
+	// copy the S3 credentials next to the job so they can be mounted (removed along with the job)
+	if backup.Spec.Target.S3 != nil {
+		err = createCredentials(ctx, r.Client, r.Scheme, backup.Namespace, backup.Spec.Target.S3, job)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.FromContext(ctx).Info("Restoring App", "Backup", backup.Name)
+	restore.Status.Phase = v1.RestorePhaseRestoring
+	return r.Status().Update(ctx, restore)
+}
+
+// check updates the Restore from the status of its Job and starts the workloads of the App again
+// once the Job is done.
+func (r *RestoreReconciler) check(ctx context.Context, restore *v1.Restore) error {
+	_, _, appConfig, err := r.resources(ctx, restore)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return r.fail(ctx, restore, err)
+		}
+		return err
+	}
+
+	// the job runs in the App namespace: Restores of the same name in other namespaces have their
+	// own jobs
+	jobs := &batchv1.JobList{}
+	err = r.List(ctx, jobs, client.InNamespace(appConfig.Namespace), client.MatchingLabels{v1.RestoreLabel: restore.Name})
+	if err != nil {
+		return err
+	}
+	if len(jobs.Items) == 0 {
+		return r.fail(ctx, restore, fmt.Errorf("restore job not found"))
+	}
+
+	for _, condition := range jobs.Items[0].Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			err = r.start(ctx, jobs.Items[0].Namespace, restore)
+			if err != nil {
+				return err
+			}
+			now := metav1.Now()
+			restore.Status.Phase = v1.RestorePhaseCompleted
+			restore.Status.CompletionTime = &now
+			return r.Status().Update(ctx, restore)
+		case batchv1.JobFailed:
+			return r.fail(ctx, restore, fmt.Errorf("restore job failed: %s", condition.Message))
+		}
+	}
+
+	return nil
+}
+
+// resources gets the Backup, App, and App config for the Restore.
+func (r *RestoreReconciler) resources(ctx context.Context, restore *v1.Restore) (*v1.Backup, *v1.App, *apps.AppConfig, error) {
+	backup := &v1.Backup{}
+	err := r.Get(ctx, types.NamespacedName{
+		Namespace: restore.Namespace,
+		Name:      restore.Spec.Backup,
+	}, backup)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	app := &v1.App{}
+	err = r.Get(ctx, types.NamespacedName{
+		Namespace: restore.Namespace,
+		Name:      backup.Spec.App,
+	}, app)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// read combined app config from chart values and override values configured in the app
+	appConfig, err := apps.Config(ctx, r.Client, app)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return backup, app, appConfig, nil
+}
+
+// replicas returns the replicas of each Deployment and StatefulSet in the namespace keyed by
+// <kind>/<name>.
+func (r *RestoreReconciler) replicas(ctx context.Context, namespace string) (map[string]int32, error) {
+	replicas := map[string]int32{}
+
+	deployments := &appsv1.DeploymentList{}
+	err := r.List(ctx, deployments, client.InNamespace(namespace))
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range deployments.Items {
+		replicas[deploymentKind+"/"+d.Name] = specReplicas(d.Spec.Replicas)
+	}
+
+	statefulSets := &appsv1.StatefulSetList{}
+	err = r.List(ctx, statefulSets, client.InNamespace(namespace))
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range statefulSets.Items {
+		replicas[statefulSetKind+"/"+s.Name] = specReplicas(s.Spec.Replicas)
+	}
+
+	return replicas, nil
+}
+
+// stopped reports whether every pod of the Deployments and StatefulSets in the namespace is gone.
+func (r *RestoreReconciler) stopped(ctx context.Context, namespace string) (bool, error) {
+	deployments := &appsv1.DeploymentList{}
+	err := r.List(ctx, deployments, client.InNamespace(namespace))
+	if err != nil {
+		return false, err
+	}
+	for _, d := range deployments.Items {
+		if d.Status.Replicas > 0 {
+			return false, nil
+		}
+	}
+
+	statefulSets := &appsv1.StatefulSetList{}
+	err = r.List(ctx, statefulSets, client.InNamespace(namespace))
+	if err != nil {
+		return false, err
+	}
+	for _, s := range statefulSets.Items {
+		if s.Status.Replicas > 0 {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// start scales the workloads of the App back up to the replicas recorded when they were stopped.
+func (r *RestoreReconciler) start(ctx context.Context, namespace string, restore *v1.Restore) error {
+	for workload, replicas := range restore.Status.Replicas {
+		err := r.scale(ctx, namespace, workload, replicas)
+		if client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scale sets the replicas of the given workload (formatted as <kind>/<name>).
+func (r *RestoreReconciler) scale(ctx context.Context, namespace string, workload string, replicas int32) error {
+	kind, name, _ := strings.Cut(workload, "/")
+
+	var obj client.Object
+	switch kind {
+	case deploymentKind:
+		obj = &appsv1.Deployment{}
+	case statefulSetKind:
+		obj = &appsv1.StatefulSet{}
+	default:
+		return fmt.Errorf("unsupported workload kind: %s", kind)
+	}
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+
+	patch := fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas)
+	return r.Patch(ctx, obj, client.RawPatch(types.MergePatchType, []byte(patch)))
+}
+
+// fail records the error on the Restore status and starts any workloads that were stopped. The error
+// is not returned since retrying won't fix it.
+func (r *RestoreReconciler) fail(ctx context.Context, restore *v1.Restore, err error) error {
+	l := log.FromContext(ctx)
+	l.Error(err, "Restore failed")
+
+	if len(restore.Status.Replicas) > 0 {
+		_, _, appConfig, startErr := r.resources(ctx, restore)
+		if startErr == nil {
+			startErr = r.start(ctx, appConfig.Namespace, restore)
+		}
+		if startErr != nil {
+			l.Error(startErr, "Failed to start App after failed Restore")
+		}
+	}
+
+	now := metav1.Now()
+	restore.Status.Phase = v1.RestorePhaseFailed
+	restore.Status.CompletionTime = &now
+	restore.Status.Error = err.Error()
+	return r.Status().Update(ctx, restore)
+}
+
+func specReplicas(replicas *int32) int32 {
+	// replicas default to 1 when unset
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
@@ -0,0 +1,81 @@
+package backups
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+func TestRestoreScaling(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	assert.NoError(t, clientgoscheme.AddToScheme(scheme))
+	assert.NoError(t, v1.AddToScheme(scheme))
+
+	r := &RestoreReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "server", Namespace: "immich"},
+				Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(2))},
+			},
+			&appsv1.Deployment{
+				// replicas default to 1
+				ObjectMeta: metav1.ObjectMeta{Name: "machine-learning", Namespace: "immich"},
+			},
+			&appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "redis", Namespace: "immich"},
+				Spec:       appsv1.StatefulSetSpec{Replicas: ptr.To(int32(1))},
+				Status:     appsv1.StatefulSetStatus{Replicas: 1},
+			},
+			&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "other"},
+				Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(3))},
+			},
+		).Build(),
+		Scheme: scheme,
+	}
+
+	replicas, err := r.replicas(ctx, "immich")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int32{
+		"Deployment/server":           2,
+		"Deployment/machine-learning": 1,
+		"StatefulSet/redis":           1,
+	}, replicas)
+
+	for workload := range replicas {
+		assert.NoError(t, r.scale(ctx, "immich", workload, 0))
+	}
+	assertReplicas(t, r.Client, "immich", "server", 0)
+	assertReplicas(t, r.Client, "immich", "machine-learning", 0)
+
+	// the stateful set still reports a running pod
+	stopped, err := r.stopped(ctx, "immich")
+	assert.NoError(t, err)
+	assert.False(t, stopped)
+
+	restore := &v1.Restore{Status: v1.RestoreStatus{Replicas: replicas}}
+	assert.NoError(t, r.start(ctx, "immich", restore))
+	assertReplicas(t, r.Client, "immich", "server", 2)
+	assertReplicas(t, r.Client, "immich", "machine-learning", 1)
+	assertReplicas(t, r.Client, "other", "other", 3)
+
+	assert.Error(t, r.scale(ctx, "immich", "DaemonSet/agent", 0))
+}
+
+func assertReplicas(t *testing.T, c client.Client, namespace string, name string, replicas int32) {
+	t.Helper()
+	d := &appsv1.Deployment{}
+	assert.NoError(t, c.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, d))
+	assert.Equal(t, replicas, *d.Spec.Replicas)
+}
@@ -0,0 +1,182 @@
+package backups
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/robfig/cron/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+const (
+	// DefaultBackupKeep is how many backups of a schedule are kept when the schedule doesn't set Keep.
+	DefaultBackupKeep = 7
+
+	// backupTimeFormat is appended to the schedule name to name each Backup. It sorts by creation time.
+	backupTimeFormat = "20060102150405"
+)
+
+type (
+	// BackupScheduleReconciler reconciles a BackupSchedule object
+	BackupScheduleReconciler struct {
+		client.Client
+		Scheme *runtime.Scheme
+
+		cron    *cron.Cron
+		mu      sync.Mutex
+		entries map[types.NamespacedName]scheduleEntry
+	}
+
+	scheduleEntry struct {
+		id       cron.EntryID
+		schedule string
+	}
+)
+
+// Reconcile keeps a cron entry registered for each BackupSchedule which creates a Backup each time
+// the schedule fires.
+func (r *BackupScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	l := log.FromContext(ctx)
+	l.Info("Reconciling BackupSchedule")
+
+	// Get the CRD that triggered reconciliation
+	schedule := &v1.BackupSchedule{}
+	err := r.Get(ctx, req.NamespacedName, schedule)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			l.Info("BackupSchedule resource not found. Removing schedule.")
+			r.remove(req.NamespacedName)
+			return ctrl.Result{}, nil
+		}
+		l.Info("Failed to get BackupSchedule resource. Re-running reconcile.")
+		return ctrl.Result{}, err
+	}
+
+	if schedule.GetDeletionTimestamp() != nil || schedule.Spec.Suspend {
+		r.remove(req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+
+	r.mu.Lock()
+	entry, ok := r.entries[req.NamespacedName]
+	r.mu.Unlock()
+	if ok && entry.schedule == schedule.Spec.Schedule {
+		return ctrl.Result{}, nil
+	}
+
+	// replace the entry with one for the new schedule
+	r.remove(req.NamespacedName)
+	id, err := r.cron.AddFunc(schedule.Spec.Schedule, func() {
+		r.createBackup(req.NamespacedName)
+	})
+	if err != nil {
+		// an invalid schedule won't be fixed by retrying so record it on the status instead
+		l.Error(err, "Failed to register backup schedule")
+		schedule.Status.Error = fmt.Sprintf("invalid schedule: %s", err.Error())
+		return ctrl.Result{}, r.Status().Update(ctx, schedule)
+	}
+	r.mu.Lock()
+	r.entries[req.NamespacedName] = scheduleEntry{
+		id:       id,
+		schedule: schedule.Spec.Schedule,
+	}
+	r.mu.Unlock()
+	l.Info("Registered backup schedule", "cron", schedule.Spec.Schedule)
+
+	if schedule.Status.Error != "" {
+		schedule.Status.Error = ""
+		return ctrl.Result{}, r.Status().Update(ctx, schedule)
+	}
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *BackupScheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.cron = cron.New()
+	r.entries = map[types.NamespacedName]scheduleEntry{}
+
+	// run the cron scheduler alongside the controllers (only while this operator is the leader)
+	err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		r.cron.Start()
+		<-ctx.Done()
+		<-r.cron.Stop().Done()
+		return nil
+	}))
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1.BackupSchedule{}).
+		Complete(r)
+}
+
+func (r *BackupScheduleReconciler) remove(name types.NamespacedName) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entry, ok := r.entries[name]
+	if !ok {
+		return
+	}
+	r.cron.Remove(entry.id)
+	delete(r.entries, name)
+}
+
+// createBackup creates a new Backup from the current spec of the given BackupSchedule.
+func (r *BackupScheduleReconciler) createBackup(name types.NamespacedName) {
+	ctx := context.Background()
+	l := ctrl.Log.WithName("backupschedule").WithValues("BackupSchedule", name)
+
+	schedule := &v1.BackupSchedule{}
+	err := r.Get(ctx, name, schedule)
+	if err != nil {
+		l.Error(err, "Failed to get BackupSchedule")
+		return
+	}
+	if schedule.Spec.Suspend {
+		return
+	}
+
+	keep := schedule.Spec.Keep
+	if keep <= 0 {
+		keep = DefaultBackupKeep
+	}
+	now := metav1.Now()
+	backup := &v1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", schedule.Name, now.UTC().Format(backupTimeFormat)),
+			Namespace: schedule.Namespace,
+			Labels: map[string]string{
+				v1.BackupScheduleLabel: schedule.Name,
+			},
+		},
+		Spec: v1.BackupSpec{
+			App:    schedule.Spec.App,
+			Target: schedule.Spec.Target,
+			Keep:   keep,
+		},
+	}
+	err = r.Create(ctx, backup)
+	if err != nil {
+		l.Error(err, "Failed to create Backup")
+		return
+	}
+	l.Info("Created Backup", "Backup", backup.Name)
+
+	schedule.Status.LastScheduleTime = &now
+	schedule.Status.LastBackup = backup.Name
+	err = r.Status().Update(ctx, schedule)
+	if err != nil {
+		l.Error(err, "Failed to update BackupSchedule status")
+	}
+}
@@ -0,0 +1,216 @@
+package certificates
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/acme"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+const (
+	// ACMEAccountSecretName is the Secret in the namespace of the Install holding the ACME account key
+	ACMEAccountSecretName = "home-cloud-acme-account"
+	// how long to wait for challenge records to propagate before asking the CA to validate them
+	dnsPropagationDelay = 30 * time.Second
+	// how long an ACME order may take before giving up
+	acmeTimeout = 10 * time.Minute
+)
+
+// acmeIssuer issues certificates from an ACME CA using DNS-01 challenges.
+type acmeIssuer struct {
+	client *acme.Client
+	dns    dnsProvider
+}
+
+// acmeIssuer returns an issuer for the ACME CA configured on the Install with a registered account.
+func (r *CertificateReconciler) acmeIssuer(ctx context.Context, install *v1.Install) (*acmeIssuer, error) {
+	spec := install.Spec.TLS.ACME
+
+	credentials := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{
+		Name:      spec.DNS01.CredentialsSecret,
+		Namespace: install.Namespace,
+	}, credentials)
+	if err != nil {
+		return nil, err
+	}
+	dns, err := newDNSProvider(spec.DNS01.Provider, string(credentials.Data["token"]))
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := r.acmeAccountKey(ctx, install.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	client := &acme.Client{
+		Key:          key,
+		DirectoryURL: spec.Server,
+	}
+	account := &acme.Account{}
+	if spec.Email != "" {
+		account.Contact = []string{"mailto:" + spec.Email}
+	}
+	_, err = client.Register(ctx, account, acme.AcceptTOS)
+	if err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return nil, err
+	}
+
+	return &acmeIssuer{
+		client: client,
+		dns:    dns,
+	}, nil
+}
+
+// acmeAccountKey returns the key of the ACME account, creating it if it doesn't exist yet.
+func (r *CertificateReconciler) acmeAccountKey(ctx context.Context, namespace string) (*ecdsa.PrivateKey, error) {
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{
+		Name:      ACMEAccountSecretName,
+		Namespace: namespace,
+	}, secret)
+	if err == nil {
+		block, _ := pem.Decode(secret.Data[corev1.TLSPrivateKeyKey])
+		if block == nil {
+			return nil, fmt.Errorf("invalid acme account key")
+		}
+		return x509.ParseECPrivateKey(block.Bytes)
+	}
+	if !kerrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	keyPEM, err := encodeKey(key)
+	if err != nil {
+		return nil, err
+	}
+	err = r.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ACMEAccountSecretName,
+			Namespace: namespace,
+		},
+		Data: map[string][]byte{
+			corev1.TLSPrivateKeyKey: keyPEM,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// issue orders a certificate for the hostname, answering the DNS-01 challenges of the order.
+func (a *acmeIssuer) issue(ctx context.Context, hostname string) (certPEM []byte, keyPEM []byte, err error) {
+	ctx, cancel := context.WithTimeout(ctx, acmeTimeout)
+	defer cancel()
+	l := log.FromContext(ctx).WithValues("hostname", hostname)
+
+	order, err := a.client.AuthorizeOrder(ctx, acme.DomainIDs(hostname))
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, url := range order.AuthzURLs {
+		err = a.authorize(ctx, url)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	order, err = a.client.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		DNSNames: []string{hostname},
+	}, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	chain, _, err := a.client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, der := range chain {
+		certPEM = append(certPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+	keyPEM, err = encodeKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	l.Info("Issued ACME certificate")
+	return certPEM, keyPEM, nil
+}
+
+// authorize answers the DNS-01 challenge of the authorization and waits for the CA to validate it.
+func (a *acmeIssuer) authorize(ctx context.Context, url string) error {
+	authz, err := a.client.GetAuthorization(ctx, url)
+	if err != nil {
+		return err
+	}
+	if authz.Status == acme.StatusValid {
+		return nil
+	}
+
+	var challenge *acme.Challenge
+	for _, c := range authz.Challenges {
+		if c.Type == "dns-01" {
+			challenge = c
+			break
+		}
+	}
+	if challenge == nil {
+		return fmt.Errorf("no dns-01 challenge offered for %s", authz.Identifier.Value)
+	}
+
+	value, err := a.client.DNS01ChallengeRecord(challenge.Token)
+	if err != nil {
+		return err
+	}
+	fqdn := "_acme-challenge." + authz.Identifier.Value
+	err = a.dns.Present(ctx, fqdn, value)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// the record is no longer needed whether or not the challenge succeeded
+		err := a.dns.CleanUp(context.WithoutCancel(ctx), fqdn, value)
+		if err != nil {
+			log.FromContext(ctx).Error(err, "Failed to clean up challenge record", "record", fqdn)
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(dnsPropagationDelay):
+	}
+
+	_, err = a.client.Accept(ctx, challenge)
+	if err != nil {
+		return err
+	}
+	_, err = a.client.WaitAuthorization(ctx, authz.URI)
+	return err
+}
@@ -0,0 +1,324 @@
+package certificates
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"dario.cat/mergo"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+	"github.com/home-cloud-io/core/pkg/install/resources"
+)
+
+const (
+	// IssuerAnnotation records which issuer a certificate Secret was issued by (local or acme)
+	IssuerAnnotation = "tls.home-cloud.io/issuer"
+
+	issuerLocal = "local"
+	issuerACME  = "acme"
+
+	// how often certificates are checked for renewal
+	renewInterval = 12 * time.Hour
+)
+
+// CertificateReconciler issues certificates for the hostnames of App routes (and the Home Cloud
+// hostname) and serves them on HTTPS listeners of the ingress gateway.
+type CertificateReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// Reconcile makes sure that every routed hostname has a valid certificate, removes the certificates of
+// hostnames which are no longer routed, and updates the listeners of the ingress gateway to match.
+func (r *CertificateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	l := log.FromContext(ctx)
+	l.Info("Reconciling certificates")
+
+	install := &v1.Install{}
+	err := r.Get(ctx, req.NamespacedName, install)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			l.Info("Install resource not found. Assuming this means the resource was deleted and so ignoring.")
+			return ctrl.Result{}, nil
+		}
+		l.Info("Failed to get Install resource. Re-running reconcile.")
+		return ctrl.Result{}, err
+	}
+
+	// set defaults: any values set on the resource will override the defaults
+	err = mergo.Merge(install, resources.DefaultInstall)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// only the ingress gateway managed by Home Cloud is configured
+	if install.Spec.Istio.Disable || install.Spec.GatewayAPI.Gateway != nil {
+		return ctrl.Result{}, nil
+	}
+
+	var hostnames []string
+	if install.Spec.TLS != nil && !install.Spec.TLS.Disable {
+		hostnames, err = r.hostnames(ctx, install)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// a failure to issue one certificate shouldn't hold up the others
+	errs := r.issue(ctx, install, hostnames, time.Now())
+
+	err = r.prune(ctx, install.Spec.Istio.Namespace, hostnames)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	err = r.updateGateway(ctx, install)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	err = errors.Join(errs...)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: renewInterval}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CertificateReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// reconcile the install whenever the hostnames of App routes change
+	install := func(ctx context.Context, obj client.Object) []ctrl.Request {
+		return []ctrl.Request{{NamespacedName: client.ObjectKeyFromObject(resources.DefaultInstall)}}
+	}
+	appRoute := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		_, ok := obj.GetLabels()[v1.AppLabel]
+		return ok
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("certificate").
+		For(&v1.Install{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&gwv1.HTTPRoute{}, handler.EnqueueRequestsFromMapFunc(install), builder.WithPredicates(appRoute, predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
+
+// Secrets returns the certificate Secrets in the namespace of the ingress gateway sorted by hostname.
+func Secrets(ctx context.Context, c client.Reader, install *v1.Install) ([]corev1.Secret, error) {
+	secrets := &corev1.SecretList{}
+	err := c.List(ctx, secrets, client.InNamespace(install.Spec.Istio.Namespace), client.HasLabels{v1.CertificateLabel})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(secrets.Items, func(a, b corev1.Secret) int {
+		return strings.Compare(a.Annotations[v1.CertificateHostnameAnnotation], b.Annotations[v1.CertificateHostnameAnnotation])
+	})
+	return secrets.Items, nil
+}
+
+// SecretName returns the name of the certificate Secret of the hostname.
+func SecretName(hostname string) string {
+	return "tls-" + hostname
+}
+
+// hostnames returns the Home Cloud hostname and the hostnames of all App routes.
+func (r *CertificateReconciler) hostnames(ctx context.Context, install *v1.Install) ([]string, error) {
+	routes := &gwv1.HTTPRouteList{}
+	err := r.List(ctx, routes, client.HasLabels{v1.AppLabel})
+	if err != nil {
+		return nil, err
+	}
+
+	hostnames := []string{install.Spec.Settings.Hostname}
+	for _, route := range routes.Items {
+		for _, hostname := range route.Spec.Hostnames {
+			if !slices.Contains(hostnames, string(hostname)) {
+				hostnames = append(hostnames, string(hostname))
+			}
+		}
+	}
+	slices.Sort(hostnames)
+	return hostnames, nil
+}
+
+// issue issues certificates for the hostnames which don't have a valid certificate yet and returns the
+// errors of the hostnames which couldn't be issued.
+func (r *CertificateReconciler) issue(ctx context.Context, install *v1.Install, hostnames []string, now time.Time) []error {
+	if len(hostnames) == 0 {
+		return nil
+	}
+	l := log.FromContext(ctx)
+
+	ca, err := r.authority(ctx, install.Namespace)
+	if err != nil {
+		return []error{err}
+	}
+	var (
+		acmeIssuer *acmeIssuer
+		acmeErr    error
+	)
+
+	errs := []error{}
+	for _, hostname := range hostnames {
+		secret := &corev1.Secret{}
+		err := r.Get(ctx, types.NamespacedName{
+			Name:      SecretName(hostname),
+			Namespace: install.Spec.Istio.Namespace,
+		}, secret)
+		if err != nil && !kerrors.IsNotFound(err) {
+			errs = append(errs, err)
+			continue
+		}
+		issuer := issuerFor(install, hostname)
+		if err == nil && !needsRenewal(secret, issuer, ca, now) {
+			continue
+		}
+
+		var certPEM, keyPEM []byte
+		switch issuer {
+		case issuerACME:
+			// the ACME account is only set up once it is needed
+			if acmeIssuer == nil && acmeErr == nil {
+				acmeIssuer, acmeErr = r.acmeIssuer(ctx, install)
+			}
+			if acmeErr != nil {
+				errs = append(errs, acmeErr)
+				continue
+			}
+			certPEM, keyPEM, err = acmeIssuer.issue(ctx, hostname)
+		default:
+			certPEM, keyPEM, err = ca.issue(hostname, now)
+		}
+		if err != nil {
+			l.Error(err, "Failed to issue certificate", "hostname", hostname)
+			errs = append(errs, err)
+			continue
+		}
+
+		l.Info("Saving certificate", "hostname", hostname, "issuer", issuer)
+		err = r.save(ctx, install.Spec.Istio.Namespace, hostname, issuer, certPEM, keyPEM)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// issuerFor returns the issuer of the hostname: ACME (if configured) for public hostnames and the
+// local CA for .local hostnames.
+func issuerFor(install *v1.Install, hostname string) string {
+	if install.Spec.TLS.ACME != nil && !strings.HasSuffix(hostname, ".local") {
+		return issuerACME
+	}
+	return issuerLocal
+}
+
+// needsRenewal reports whether the certificate Secret should be issued again: because it was issued
+// by another issuer, the local CA has changed, or it is in the last third of its lifetime.
+func needsRenewal(secret *corev1.Secret, issuer string, ca *authority, now time.Time) bool {
+	if secret.Annotations[IssuerAnnotation] != issuer {
+		return true
+	}
+	cert, err := parseCertificate(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return true
+	}
+	if issuer == issuerLocal && cert.CheckSignatureFrom(ca.cert) != nil {
+		return true
+	}
+	lifetime := cert.NotAfter.Sub(cert.NotBefore)
+	return now.After(cert.NotAfter.Add(-lifetime / 3))
+}
+
+// save creates or updates the certificate Secret of the hostname.
+func (r *CertificateReconciler) save(ctx context.Context, namespace string, hostname string, issuer string, certPEM []byte, keyPEM []byte) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      SecretName(hostname),
+			Namespace: namespace,
+		},
+	}
+	err := r.Get(ctx, client.ObjectKeyFromObject(secret), secret)
+	if err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	exists := err == nil
+
+	secret.Labels = map[string]string{
+		v1.CertificateLabel: "true",
+	}
+	secret.Annotations = map[string]string{
+		v1.CertificateHostnameAnnotation: hostname,
+		IssuerAnnotation:                 issuer,
+	}
+	secret.Type = corev1.SecretTypeTLS
+	secret.Data = map[string][]byte{
+		corev1.TLSCertKey:       certPEM,
+		corev1.TLSPrivateKeyKey: keyPEM,
+	}
+	if exists {
+		return r.Update(ctx, secret)
+	}
+	return r.Create(ctx, secret)
+}
+
+// prune deletes the certificate Secrets of hostnames which are no longer routed.
+func (r *CertificateReconciler) prune(ctx context.Context, namespace string, hostnames []string) error {
+	secrets := &corev1.SecretList{}
+	err := r.List(ctx, secrets, client.InNamespace(namespace), client.HasLabels{v1.CertificateLabel})
+	if err != nil {
+		return err
+	}
+	for _, secret := range secrets.Items {
+		hostname := secret.Annotations[v1.CertificateHostnameAnnotation]
+		if slices.Contains(hostnames, hostname) {
+			continue
+		}
+		log.FromContext(ctx).Info("Deleting certificate", "hostname", hostname)
+		err = r.Delete(ctx, &secret)
+		if client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateGateway sets the listeners of the ingress gateway to serve the current certificates.
+func (r *CertificateReconciler) updateGateway(ctx context.Context, install *v1.Install) error {
+	gateway := &gwv1.Gateway{}
+	err := r.Get(ctx, types.NamespacedName{
+		Name:      install.Spec.Istio.IngressGatewayName,
+		Namespace: install.Spec.Istio.Namespace,
+	}, gateway)
+	if err != nil {
+		// the gateway is created with the listeners by the install
+		return client.IgnoreNotFound(err)
+	}
+
+	certificates, err := Secrets(ctx, r.Client, install)
+	if err != nil {
+		return err
+	}
+	listeners := resources.GatewayListeners(certificates)
+	if equality.Semantic.DeepEqual(gateway.Spec.Listeners, listeners) {
+		return nil
+	}
+
+	patch := client.MergeFrom(gateway.DeepCopy())
+	gateway.Spec.Listeners = listeners
+	return r.Patch(ctx, gateway, patch)
+}
@@ -0,0 +1,187 @@
+package certificates
+
+import (
+	"context"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+	"github.com/home-cloud-io/core/pkg/install/resources"
+)
+
+func TestReconcile(t *testing.T) {
+	install := &v1.Install{
+		ObjectMeta: metav1.ObjectMeta{Name: "install", Namespace: "home-cloud-system"},
+		Spec:       v1.InstallSpec{TLS: &v1.TLSSpec{}},
+	}
+	gateway := resources.GatewayObjects(resources.DefaultInstall, nil)[0]
+	route := &gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "immich",
+			Namespace: "immich",
+			Labels:    map[string]string{v1.AppLabel: "immich"},
+		},
+		Spec: gwv1.HTTPRouteSpec{Hostnames: []gwv1.Hostname{"immich.local", "photos.local"}},
+	}
+	unmanaged := &gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "other"},
+		Spec:       gwv1.HTTPRouteSpec{Hostnames: []gwv1.Hostname{"other.local"}},
+	}
+	stale := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        SecretName("removed.local"),
+			Namespace:   "istio-system",
+			Labels:      map[string]string{v1.CertificateLabel: "true"},
+			Annotations: map[string]string{v1.CertificateHostnameAnnotation: "removed.local"},
+		},
+	}
+	r := newTestReconciler(install, gateway, route, unmanaged, stale)
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(install)})
+	assert.NoError(t, err)
+
+	// certificates are issued by the local CA for the install hostname and the App route hostnames
+	ca := &corev1.Secret{}
+	err = r.Get(context.Background(), types.NamespacedName{Namespace: "home-cloud-system", Name: v1.CASecretName}, ca)
+	assert.NoError(t, err)
+	caCert, err := parseCertificate(ca.Data[corev1.TLSCertKey])
+	assert.NoError(t, err)
+	roots := x509.NewCertPool()
+	roots.AddCert(caCert)
+
+	secrets, err := Secrets(context.Background(), r.Client, resources.DefaultInstall)
+	assert.NoError(t, err)
+	hostnames := []string{}
+	for _, secret := range secrets {
+		hostname := secret.Annotations[v1.CertificateHostnameAnnotation]
+		hostnames = append(hostnames, hostname)
+		cert, err := parseCertificate(secret.Data[corev1.TLSCertKey])
+		assert.NoError(t, err)
+		_, err = cert.Verify(x509.VerifyOptions{DNSName: hostname, Roots: roots})
+		assert.NoError(t, err, hostname)
+	}
+	assert.Equal(t, []string{"home-cloud.local", "immich.local", "photos.local"}, hostnames)
+
+	// the gateway serves each certificate
+	err = r.Get(context.Background(), client.ObjectKeyFromObject(gateway), gateway)
+	assert.NoError(t, err)
+	listeners := []gwv1.SectionName{}
+	for _, listener := range gateway.(*gwv1.Gateway).Spec.Listeners {
+		listeners = append(listeners, listener.Name)
+	}
+	assert.Equal(t, []gwv1.SectionName{"http", "https-home-cloud-local", "https-immich-local", "https-photos-local"}, listeners)
+
+	// reconciling again keeps the issued certificates
+	_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(install)})
+	assert.NoError(t, err)
+	again, err := Secrets(context.Background(), r.Client, resources.DefaultInstall)
+	assert.NoError(t, err)
+	for i := range secrets {
+		assert.Equal(t, secrets[i].Data, again[i].Data)
+	}
+}
+
+func TestReconcileWithoutTLS(t *testing.T) {
+	install := &v1.Install{ObjectMeta: metav1.ObjectMeta{Name: "install", Namespace: "home-cloud-system"}}
+	gateway := resources.GatewayObjects(resources.DefaultInstall, nil)[0]
+	route := &gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "immich",
+			Namespace: "immich",
+			Labels:    map[string]string{v1.AppLabel: "immich"},
+		},
+		Spec: gwv1.HTTPRouteSpec{Hostnames: []gwv1.Hostname{"immich.local"}},
+	}
+	r := newTestReconciler(install, gateway, route)
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(install)})
+	assert.NoError(t, err)
+
+	// no certificates are issued unless TLS is set
+	secrets, err := Secrets(context.Background(), r.Client, resources.DefaultInstall)
+	assert.NoError(t, err)
+	assert.Empty(t, secrets)
+
+	err = r.Get(context.Background(), client.ObjectKeyFromObject(gateway), gateway)
+	assert.NoError(t, err)
+	assert.Len(t, gateway.(*gwv1.Gateway).Spec.Listeners, 1)
+}
+
+func TestNeedsRenewal(t *testing.T) {
+	now := time.Now()
+	caSecret, err := newAuthoritySecret("home-cloud-system", now)
+	assert.NoError(t, err)
+	ca := mustAuthority(t, caSecret)
+	otherSecret, err := newAuthoritySecret("home-cloud-system", now)
+	assert.NoError(t, err)
+	other := mustAuthority(t, otherSecret)
+
+	secret := func(ca *authority, issuer string, issued time.Time) *corev1.Secret {
+		certPEM, _, err := ca.issue("immich.local", issued)
+		assert.NoError(t, err)
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{IssuerAnnotation: issuer}},
+			Data:       map[string][]byte{corev1.TLSCertKey: certPEM},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		secret *corev1.Secret
+		issuer string
+		want   bool
+	}{
+		{name: "valid", secret: secret(ca, issuerLocal, now), issuer: issuerLocal},
+		{name: "expiring", secret: secret(ca, issuerLocal, now.Add(-300*24*time.Hour)), issuer: issuerLocal, want: true},
+		{name: "issuer changed", secret: secret(ca, issuerLocal, now), issuer: issuerACME, want: true},
+		{name: "ca changed", secret: secret(other, issuerLocal, now), issuer: issuerLocal, want: true},
+		{name: "invalid", secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{IssuerAnnotation: issuerLocal}}}, issuer: issuerLocal, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, needsRenewal(tt.secret, tt.issuer, ca, now))
+		})
+	}
+}
+
+func TestIssuerFor(t *testing.T) {
+	local := &v1.Install{Spec: v1.InstallSpec{TLS: &v1.TLSSpec{}}}
+	acme := &v1.Install{Spec: v1.InstallSpec{TLS: &v1.TLSSpec{ACME: &v1.ACMESpec{}}}}
+
+	assert.Equal(t, issuerLocal, issuerFor(local, "immich.example.com"))
+	assert.Equal(t, issuerLocal, issuerFor(acme, "immich.local"))
+	assert.Equal(t, issuerACME, issuerFor(acme, "immich.example.com"))
+}
+
+// HELPERS
+
+func newTestReconciler(objs ...client.Object) *CertificateReconciler {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1.AddToScheme(scheme)
+	_ = gwv1.Install(scheme)
+	return &CertificateReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+		Scheme: scheme,
+	}
+}
+
+func mustAuthority(t *testing.T, secret *corev1.Secret) *authority {
+	cert, err := parseCertificate(secret.Data[corev1.TLSCertKey])
+	assert.NoError(t, err)
+	key, err := parseKey(secret.Data[corev1.TLSPrivateKeyKey])
+	assert.NoError(t, err)
+	return &authority{cert: cert, key: key}
+}
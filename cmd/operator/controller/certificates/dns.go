@@ -0,0 +1,153 @@
+package certificates
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+// dnsProvider creates and removes the TXT records of DNS-01 challenges.
+type dnsProvider interface {
+	// Present creates a TXT record with the given fully qualified name and value.
+	Present(ctx context.Context, fqdn string, value string) error
+	// CleanUp removes the TXT record created by Present.
+	CleanUp(ctx context.Context, fqdn string, value string) error
+}
+
+const (
+	cloudflareURL = "https://api.cloudflare.com/client/v4"
+)
+
+func newDNSProvider(provider v1.DNSProvider, token string) (dnsProvider, error) {
+	switch provider {
+	case v1.DNSProviderCloudflare:
+		return &cloudflare{
+			url:   cloudflareURL,
+			token: token,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported dns provider: %s", provider)
+	}
+}
+
+// cloudflare manages records through the Cloudflare API. The token needs the Zone:Read and DNS:Edit
+// permissions.
+type cloudflare struct {
+	url   string
+	token string
+}
+
+type cloudflareResponse struct {
+	Success bool `json:"success"`
+	Errors  []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+	Result json.RawMessage `json:"result"`
+}
+
+type cloudflareRecord struct {
+	ID      string `json:"id,omitempty"`
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Content string `json:"content"`
+	TTL     int    `json:"ttl"`
+}
+
+func (c *cloudflare) Present(ctx context.Context, fqdn string, value string) error {
+	zone, err := c.zone(ctx, fqdn)
+	if err != nil {
+		return err
+	}
+	return c.do(ctx, http.MethodPost, "/zones/"+zone+"/dns_records", cloudflareRecord{
+		Type:    "TXT",
+		Name:    fqdn,
+		Content: value,
+		TTL:     60,
+	}, nil)
+}
+
+func (c *cloudflare) CleanUp(ctx context.Context, fqdn string, value string) error {
+	zone, err := c.zone(ctx, fqdn)
+	if err != nil {
+		return err
+	}
+	query := url.Values{"type": {"TXT"}, "name": {fqdn}, "content": {value}}
+	records := []cloudflareRecord{}
+	err = c.do(ctx, http.MethodGet, "/zones/"+zone+"/dns_records?"+query.Encode(), nil, &records)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		err = c.do(ctx, http.MethodDelete, "/zones/"+zone+"/dns_records/"+record.ID, nil, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// zone returns the ID of the closest zone containing the name.
+func (c *cloudflare) zone(ctx context.Context, fqdn string) (string, error) {
+	labels := strings.Split(strings.TrimSuffix(fqdn, "."), ".")
+	for i := range len(labels) - 1 {
+		zones := []struct {
+			ID string `json:"id"`
+		}{}
+		err := c.do(ctx, http.MethodGet, "/zones?"+url.Values{"name": {strings.Join(labels[i:], ".")}}.Encode(), nil, &zones)
+		if err != nil {
+			return "", err
+		}
+		if len(zones) > 0 {
+			return zones[0].ID, nil
+		}
+	}
+	return "", fmt.Errorf("no cloudflare zone found for %s", fqdn)
+}
+
+func (c *cloudflare) do(ctx context.Context, method string, path string, body any, result any) error {
+	var reader *bytes.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(b)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	response := cloudflareResponse{}
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	if err != nil {
+		return fmt.Errorf("cloudflare: %s: %v", resp.Status, err)
+	}
+	if !response.Success {
+		messages := []string{}
+		for _, e := range response.Errors {
+			messages = append(messages, e.Message)
+		}
+		return fmt.Errorf("cloudflare: %s: %s", resp.Status, strings.Join(messages, ", "))
+	}
+	if result != nil {
+		return json.Unmarshal(response.Result, result)
+	}
+	return nil
+}
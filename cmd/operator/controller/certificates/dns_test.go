@@ -0,0 +1,67 @@
+package certificates
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloudflare(t *testing.T) {
+	records := map[string]cloudflareRecord{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		var result any
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/zones":
+			// only the apex domain is a zone
+			result = []map[string]string{}
+			if r.URL.Query().Get("name") == "example.com" {
+				result = []map[string]string{{"id": "zone"}}
+			}
+		case r.Method == http.MethodPost && r.URL.Path == "/zones/zone/dns_records":
+			record := cloudflareRecord{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&record))
+			record.ID = "record"
+			records[record.ID] = record
+			result = record
+		case r.Method == http.MethodGet && r.URL.Path == "/zones/zone/dns_records":
+			found := []cloudflareRecord{}
+			for _, record := range records {
+				if record.Name == r.URL.Query().Get("name") && record.Content == r.URL.Query().Get("content") {
+					found = append(found, record)
+				}
+			}
+			result = found
+		case r.Method == http.MethodDelete && r.URL.Path == "/zones/zone/dns_records/record":
+			delete(records, "record")
+			result = map[string]string{"id": "record"}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]any{"success": false, "errors": []map[string]string{{"message": "not found"}}})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": result})
+	}))
+	defer server.Close()
+
+	c := &cloudflare{url: server.URL, token: "token"}
+	fqdn := "_acme-challenge.immich.home.example.com"
+
+	err := c.Present(context.Background(), fqdn, "value")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]cloudflareRecord{
+		"record": {ID: "record", Type: "TXT", Name: fqdn, Content: "value", TTL: 60},
+	}, records)
+
+	err = c.CleanUp(context.Background(), fqdn, "value")
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+
+	_, err = c.zone(context.Background(), "_acme-challenge.immich.example.org")
+	assert.Error(t, err)
+}
@@ -29,8 +29,10 @@ import (
 
 	v1 "github.com/home-cloud-io/core/api/crds/v1"
 	dv1 "github.com/home-cloud-io/core/api/platform/daemon/v1"
+	"github.com/home-cloud-io/core/cmd/operator/controller/certificates"
 	"github.com/home-cloud-io/core/cmd/operator/controller/daemon"
 	"github.com/home-cloud-io/core/cmd/operator/controller/shared"
+	"github.com/home-cloud-io/core/pkg/install/bundle"
 	"github.com/home-cloud-io/core/pkg/install/resources"
 )
 
@@ -69,18 +71,22 @@ func (r *InstallReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return ctrl.Result{}, err
 	}
 
-	// get version manifest from repo
-	resp, err := http.Get(fmt.Sprintf("%s/%s/manifest.yaml", ReleasesURL, install.Spec.Version))
+	// get version manifest from repo (or bundle)
+	manifest, err := releaseFile(install, bundle.ManifestFile, fmt.Sprintf("%s/%s/manifest.yaml", ReleasesURL, install.Spec.Version))
 	if err != nil {
 		return ctrl.Result{}, err
 	}
+	defer manifest.Close()
 
 	// populate versions into default install spec
-	dec := yaml.NewDecoder(resp.Body)
+	dec := yaml.NewDecoder(manifest)
 	err = dec.Decode(&resources.DefaultInstall.Spec)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
+	if b := bundle.Of(install); b != nil && resources.DefaultInstall.Spec.Version != install.Spec.Version {
+		return ctrl.Result{}, fmt.Errorf("bundle at %s is version %s but the Install is version %s", b.Path, resources.DefaultInstall.Spec.Version, install.Spec.Version)
+	}
 
 	// set defaults: any values set on the resource will override the defaults, including versions
 	err = mergo.Merge(install, resources.DefaultInstall)
@@ -169,7 +175,11 @@ func (r *InstallReconciler) reconcile(ctx context.Context, install *v1.Install)
 		// might have changed with no version bump
 
 		l.Info("reconciling ingress gateway")
-		err = r.installResources(ctx, resources.GatewayObjects(install))
+		certs, err := certificates.Secrets(ctx, r.Client, install)
+		if err != nil {
+			return err
+		}
+		err = r.installResources(ctx, resources.GatewayObjects(install, certs))
 		if err != nil {
 			return err
 		}
@@ -188,7 +198,7 @@ func (r *InstallReconciler) reconcile(ctx context.Context, install *v1.Install)
 		// only try and uninstall if currently installed
 		if install.Status.Istio != nil {
 			l.Info("istio is disabled: removing ingress gateway")
-			err = r.uninstallResources(ctx, resources.GatewayObjects(install))
+			err = r.uninstallResources(ctx, resources.GatewayObjects(install, nil))
 			if err != nil {
 				return err
 			}
@@ -269,11 +279,12 @@ func (r *InstallReconciler) reconcileHomeCloudCRDs(ctx context.Context, install
 	if install.Spec.Version != install.Status.Version {
 		l.Info("reconciling home cloud crds")
 
-		resp, err := http.Get(fmt.Sprintf("%s/%s/crds.yaml", ReleasesURL, install.Spec.Version))
+		crds, err := releaseFile(install, bundle.CRDsFile, fmt.Sprintf("%s/%s/crds.yaml", ReleasesURL, install.Spec.Version))
 		if err != nil {
 			return err
 		}
-		err = r.apply(ctx, resp.Body)
+		defer crds.Close()
+		err = r.apply(ctx, crds)
 		if err != nil {
 			return err
 		}
@@ -296,11 +307,12 @@ func (r *InstallReconciler) reconcileGatewayAPI(ctx context.Context, install *v1
 			install.Spec.GatewayAPI.Version != install.Status.GatewayAPI.Version {
 			l.Info("reconciling gateway api crds")
 
-			resp, err := http.Get(fmt.Sprintf("%s/%s/standard-install.yaml", install.Spec.GatewayAPI.Source, install.Spec.GatewayAPI.Version))
+			crds, err := releaseFile(install, bundle.GatewayAPIFile, fmt.Sprintf("%s/%s/standard-install.yaml", install.Spec.GatewayAPI.Source, install.Spec.GatewayAPI.Version))
 			if err != nil {
 				return err
 			}
-			err = r.apply(ctx, resp.Body)
+			defer crds.Close()
+			err = r.apply(ctx, crds)
 			if err != nil {
 				return err
 			}
@@ -426,46 +438,56 @@ func reconcileIstio(ctx context.Context, install *v1.Install) error {
 	if err != nil {
 		return err
 	}
+
+	// charts are read from the bundle instead of the Istio repository for offline installs
+	source := install.Spec.Istio.Source
+	b := bundle.Of(install)
+	if b != nil {
+		source = ""
+	}
+
 	iAct := action.NewInstall(cfg)
 	iAct.Version = install.Spec.Istio.Version
 	iAct.Namespace = install.Spec.Istio.Namespace
-	iAct.RepoURL = install.Spec.Istio.Source
+	iAct.RepoURL = source
 	iAct.Wait = true
 	iAct.Timeout = 5 * time.Minute
 
 	uAct := action.NewUpgrade(cfg)
 	uAct.Version = install.Spec.Istio.Version
 	uAct.Namespace = install.Spec.Istio.Namespace
-	uAct.RepoURL = install.Spec.Istio.Source
+	uAct.RepoURL = source
 	uAct.Wait = true
 	uAct.Timeout = 5 * time.Minute
 
-	// istio base
-	iAct.ReleaseName = "base"
-	err = helmInstallOrUpgrade(ctx, cfg, iAct, uAct, install.Spec.Istio.Base.Values)
-	if err != nil {
-		return err
-	}
-
-	// istio istiod
-	iAct.ReleaseName = "istiod"
-	err = helmInstallOrUpgrade(ctx, cfg, iAct, uAct, install.Spec.Istio.Istiod.Values)
+	istiodValues, err := resources.IstiodValues(install)
 	if err != nil {
 		return err
 	}
 
-	// istio cni
-	iAct.ReleaseName = "cni"
-	err = helmInstallOrUpgrade(ctx, cfg, iAct, uAct, install.Spec.Istio.CNI.Values)
-	if err != nil {
-		return err
+	// the releases are named after their charts
+	releases := []struct {
+		name   string
+		values string
+	}{
+		{name: "base", values: install.Spec.Istio.Base.Values},
+		{name: "istiod", values: istiodValues},
+		{name: "cni", values: install.Spec.Istio.CNI.Values},
+		{name: "ztunnel", values: install.Spec.Istio.Ztunnel.Values},
 	}
-
-	// istio ztunnel
-	iAct.ReleaseName = "ztunnel"
-	err = helmInstallOrUpgrade(ctx, cfg, iAct, uAct, install.Spec.Istio.Ztunnel.Values)
-	if err != nil {
-		return err
+	for _, release := range releases {
+		chart := release.name
+		if b != nil {
+			chart, err = b.Chart(release.name, install.Spec.Istio.Version)
+			if err != nil {
+				return err
+			}
+		}
+		iAct.ReleaseName = release.name
+		err = helmInstallOrUpgrade(ctx, cfg, iAct, uAct, chart, release.values)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -499,7 +521,7 @@ func (r *InstallReconciler) uninstall(ctx context.Context, install *v1.Install)
 	// 			 we also do not uninstall operator resources for obvious reasons
 
 	err := r.uninstallResources(ctx, slices.Concat(
-		resources.GatewayObjects(install),
+		resources.GatewayObjects(install, nil),
 		resources.MDNSObjects(install),
 		resources.TunnelObjects(install),
 		resources.DaemonObjects(install),
@@ -594,6 +616,23 @@ func kubeCreateOrUpdate(ctx context.Context, kube client.Client, obj client.Obje
 	return err
 }
 
+// releaseFile opens a file of the release: from the bundle of the Install if it has one or else by
+// downloading it from url.
+func releaseFile(install *v1.Install, name string, url string) (io.ReadCloser, error) {
+	if b := bundle.Of(install); b != nil {
+		return b.Open(name)
+	}
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to download %s: %s", url, resp.Status)
+	}
+	return resp.Body, nil
+}
+
 func helmExists(cfg *action.Configuration, releaseName string) (bool, error) {
 	release, err := helmGet(cfg, releaseName)
 	if err != nil {
@@ -617,7 +656,7 @@ func helmGet(cfg *action.Configuration, releaseName string) (*release.Release, e
 	return release, nil
 }
 
-func helmInstallOrUpgrade(ctx context.Context, cfg *action.Configuration, iAct *action.Install, uAct *action.Upgrade, values string) error {
+func helmInstallOrUpgrade(ctx context.Context, cfg *action.Configuration, iAct *action.Install, uAct *action.Upgrade, chart string, values string) error {
 	l := log.FromContext(ctx)
 
 	// get user values
@@ -640,7 +679,7 @@ func helmInstallOrUpgrade(ctx context.Context, cfg *action.Configuration, iAct *
 
 	// install if no release found
 	if release == nil {
-		return helmInstall(ctx, cfg, iAct, v)
+		return helmInstall(ctx, cfg, iAct, chart, v)
 	}
 
 	// ignore if no changes
@@ -654,13 +693,13 @@ func helmInstallOrUpgrade(ctx context.Context, cfg *action.Configuration, iAct *
 	}
 
 	// upgrade
-	return helmUpgrade(ctx, cfg, iAct.ReleaseName, uAct, v)
+	return helmUpgrade(ctx, cfg, iAct.ReleaseName, uAct, chart, v)
 }
 
-func helmInstall(ctx context.Context, cfg *action.Configuration, act *action.Install, values map[string]interface{}) error {
+func helmInstall(ctx context.Context, cfg *action.Configuration, act *action.Install, chart string, values map[string]interface{}) error {
 	l := log.FromContext(ctx)
 	l.Info("installing helm chart", "chart", act.ChartPathOptions.RepoURL)
-	c, err := shared.GetChart(act.ChartPathOptions, act.ReleaseName)
+	c, err := shared.GetChart(act.ChartPathOptions, chart)
 	if err != nil {
 		return err
 	}
@@ -671,10 +710,10 @@ func helmInstall(ctx context.Context, cfg *action.Configuration, act *action.Ins
 	return nil
 }
 
-func helmUpgrade(ctx context.Context, cfg *action.Configuration, releaseName string, act *action.Upgrade, values map[string]interface{}) error {
+func helmUpgrade(ctx context.Context, cfg *action.Configuration, releaseName string, act *action.Upgrade, chart string, values map[string]interface{}) error {
 	l := log.FromContext(ctx)
 	l.Info("upgrading helm release", "release", releaseName)
-	c, err := shared.GetChart(act.ChartPathOptions, releaseName)
+	c, err := shared.GetChart(act.ChartPathOptions, chart)
 	if err != nil {
 		return err
 	}
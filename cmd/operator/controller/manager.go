@@ -9,15 +9,22 @@ import (
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
 	"github.com/steady-bytes/draft/pkg/chassis"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/discovery"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	v1 "github.com/home-cloud-io/core/api/crds/v1"
 	"github.com/home-cloud-io/core/cmd/operator/controller/apps"
+	"github.com/home-cloud-io/core/cmd/operator/controller/backups"
+	"github.com/home-cloud-io/core/cmd/operator/controller/certificates"
 	"github.com/home-cloud-io/core/cmd/operator/controller/installs"
 	"github.com/home-cloud-io/core/pkg/logr"
 	talos "github.com/home-cloud-io/core/pkg/talos/api"
@@ -54,6 +61,19 @@ func Start(l chassis.Logger) {
 		LeaderElectionID:              "operator.home-cloud.io",
 		LeaderElectionReleaseOnCancel: true,
 		LeaderElectionNamespace:       "home-cloud-system",
+		// only the Secrets and Namespaces created for Apps are watched (to restore them if changed or
+		// deleted) so only those are cached and all others are read from the API server
+		Cache: cache.Options{
+			ByObject: map[client.Object]cache.ByObject{
+				&corev1.Secret{}:    {Label: appSelector()},
+				&corev1.Namespace{}: {Label: appSelector()},
+			},
+		},
+		Client: client.Options{
+			Cache: &client.CacheOptions{
+				DisableFor: []client.Object{&corev1.Secret{}, &corev1.Namespace{}},
+			},
+		},
 	})
 	if err != nil {
 		l.WithError(err).Error("failed to create manager")
@@ -75,6 +95,38 @@ func Start(l chassis.Logger) {
 		return
 	}
 
+	// create backup controllers
+	if err = (&backups.BackupReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		l.WithField("controller", "backup").WithError(err).Error("failed to create controller")
+		return
+	}
+	if err = (&backups.BackupScheduleReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		l.WithField("controller", "backupschedule").WithError(err).Error("failed to create controller")
+		return
+	}
+	if err = (&backups.RestoreReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		l.WithField("controller", "restore").WithError(err).Error("failed to create controller")
+		return
+	}
+
+	// create certificate controller
+	if err = (&certificates.CertificateReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		l.WithField("controller", "certificate").WithError(err).Error("failed to create controller")
+		return
+	}
+
 	// global context to allow the install reconciler to stop the manager when a shutdown
 	// is needed on operator upgrade
 	ctx, cancel := context.WithCancel(ctrl.SetupSignalHandler())
@@ -100,6 +152,13 @@ func Start(l chassis.Logger) {
 	}
 }
 
+// appSelector selects the resources created for Apps.
+func appSelector() labels.Selector {
+	requirement, err := labels.NewRequirement(v1.AppLabel, selection.Exists, nil)
+	utilruntime.Must(err)
+	return labels.NewSelector().Add(*requirement)
+}
+
 // send interupt to the chassis
 func Stop() {
 	chassis.Closer() <- os.Interrupt
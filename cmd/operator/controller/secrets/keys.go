@@ -0,0 +1,171 @@
+package secrets
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// RandomBytes returns the given number of random bytes.
+func RandomBytes(length int) ([]byte, error) {
+	if length < 16 {
+		return nil, fmt.Errorf("length %d is too short: must be at least 16 bytes", length)
+	}
+	b := make([]byte, length)
+	_, err := rand.Read(b)
+	return b, err
+}
+
+// UUID returns a random (version 4) UUID.
+func UUID() []byte {
+	return []byte(uuid.NewString())
+}
+
+// RSAKeyPair returns a PEM encoded RSA private key (PKCS #8) and public key (PKIX) of the given size.
+func RSAKeyPair(bits int) (private []byte, public []byte, err error) {
+	if bits < 2048 {
+		return nil, nil, fmt.Errorf("rsa key size %d is too small: must be at least 2048 bits", bits)
+	}
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		return nil, nil, err
+	}
+	return encodeKeyPair(key, key.Public())
+}
+
+// Ed25519KeyPair returns a PEM encoded Ed25519 private key (PKCS #8) and public key (PKIX).
+func Ed25519KeyPair() (private []byte, public []byte, err error) {
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	return encodeKeyPair(key, pub)
+}
+
+// Bcrypt returns the bcrypt hash of the password.
+func Bcrypt(password []byte) ([]byte, error) {
+	return bcrypt.GenerateFromPassword(password, bcrypt.DefaultCost)
+}
+
+// Htpasswd returns an htpasswd entry with the bcrypt hash of the password of the user.
+func Htpasswd(username string, password []byte) ([]byte, error) {
+	hash, err := Bcrypt(password)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(username + ":" + string(hash)), nil
+}
+
+// SelfSignedCertificate returns a PEM encoded self-signed certificate for the given hostnames (or IP
+// addresses) and its private key. The first host is used as the common name.
+func SelfSignedCertificate(hosts []string, validity time.Duration) (cert []byte, key []byte, err error) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	cert, err = selfSign(privateKey, hosts, validity)
+	if err != nil {
+		return nil, nil, err
+	}
+	key, _, err = encodeKeyPair(privateKey, privateKey.Public())
+	if err != nil {
+		return nil, nil, err
+	}
+	return cert, key, nil
+}
+
+// Certificate returns a PEM encoded self-signed certificate for the given hostnames (or IP addresses)
+// signed by the existing PEM encoded private key (PKCS #8).
+func Certificate(key []byte, hosts []string, validity time.Duration) ([]byte, error) {
+	signer, err := parsePrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return selfSign(signer, hosts, validity)
+}
+
+// PublicKey returns the PEM encoded public key (PKIX) of the PEM encoded private key (PKCS #8).
+func PublicKey(private []byte) ([]byte, error) {
+	signer, err := parsePrivateKey(private)
+	if err != nil {
+		return nil, err
+	}
+	_, public, err := encodeKeyPair(signer, signer.Public())
+	return public, err
+}
+
+func selfSign(key crypto.Signer, hosts []string, validity time.Duration) ([]byte, error) {
+	if len(hosts) == 0 {
+		return nil, fmt.Errorf("a certificate needs at least one host")
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: hosts[0]},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+	}
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), nil
+}
+
+func parsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "PRIVATE KEY" {
+		return nil, fmt.Errorf("not a PEM encoded private key")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return signer, nil
+}
+
+func encodeKeyPair(private crypto.PrivateKey, public crypto.PublicKey) ([]byte, []byte, error) {
+	privateDER, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return nil, nil, err
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(public)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER}),
+		pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER}),
+		nil
+}
@@ -0,0 +1,96 @@
+package secrets
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestRandomBytes(t *testing.T) {
+	b, err := RandomBytes(32)
+	assert.NoError(t, err)
+	assert.Len(t, b, 32)
+	_, err = RandomBytes(8)
+	assert.Error(t, err)
+}
+
+func TestUUID(t *testing.T) {
+	_, err := uuid.ParseBytes(UUID())
+	assert.NoError(t, err)
+}
+
+func TestKeyPairs(t *testing.T) {
+	private, public, err := RSAKeyPair(2048)
+	assert.NoError(t, err)
+	key, err := parsePrivateKey(private)
+	assert.NoError(t, err)
+	assert.IsType(t, &rsa.PrivateKey{}, key)
+	assert.True(t, strings.HasPrefix(string(public), "-----BEGIN PUBLIC KEY-----"))
+
+	_, _, err = RSAKeyPair(1024)
+	assert.Error(t, err)
+
+	private, _, err = Ed25519KeyPair()
+	assert.NoError(t, err)
+	key, err = parsePrivateKey(private)
+	assert.NoError(t, err)
+	assert.IsType(t, ed25519.PrivateKey{}, key)
+}
+
+func TestHtpasswd(t *testing.T) {
+	entry, err := Htpasswd("admin", []byte("password"))
+	assert.NoError(t, err)
+	username, hash, found := strings.Cut(string(entry), ":")
+	assert.True(t, found)
+	assert.Equal(t, "admin", username)
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(hash), []byte("password")))
+}
+
+func TestSelfSignedCertificate(t *testing.T) {
+	certPEM, keyPEM, err := SelfSignedCertificate([]string{"server.immich.svc", "10.0.0.1"}, time.Hour)
+	assert.NoError(t, err)
+	block, _ := pem.Decode(certPEM)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	assert.NoError(t, err)
+	assert.Equal(t, "server.immich.svc", cert.Subject.CommonName)
+	assert.Equal(t, []string{"server.immich.svc"}, cert.DNSNames)
+	assert.Equal(t, "10.0.0.1", cert.IPAddresses[0].String())
+	assert.NoError(t, cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature))
+	_, err = parsePrivateKey(keyPEM)
+	assert.NoError(t, err)
+
+	_, _, err = SelfSignedCertificate(nil, time.Hour)
+	assert.Error(t, err)
+}
+
+func TestPublicKey(t *testing.T) {
+	private, public, err := Ed25519KeyPair()
+	assert.NoError(t, err)
+	derived, err := PublicKey(private)
+	assert.NoError(t, err)
+	assert.Equal(t, public, derived)
+
+	_, err = PublicKey([]byte("private"))
+	assert.Error(t, err)
+}
+
+func TestCertificate(t *testing.T) {
+	_, keyPEM, err := SelfSignedCertificate([]string{"server.immich.svc"}, time.Hour)
+	assert.NoError(t, err)
+	certPEM, err := Certificate(keyPEM, []string{"server.immich.svc"}, time.Hour)
+	assert.NoError(t, err)
+	block, _ := pem.Decode(certPEM)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	assert.NoError(t, err)
+	key, err := parsePrivateKey(keyPEM)
+	assert.NoError(t, err)
+	assert.Equal(t, key.Public(), cert.PublicKey)
+}
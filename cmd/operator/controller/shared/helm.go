@@ -13,8 +13,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
-// CreateHelmAction creates a helm action configuration with the given namespace.
-func CreateHelmAction(namespace string) (*action.Configuration, error) {
+// CreateHelmAction creates a helm action configuration with the given namespace. The options configure
+// the client of OCI registries: e.g. credentials.
+func CreateHelmAction(namespace string, opts ...registry.ClientOption) (*action.Configuration, error) {
 	settings := cli.New()
 	settings.SetNamespace(namespace)
 	actionConfig := new(action.Configuration)
@@ -28,7 +29,7 @@ func CreateHelmAction(namespace string) (*action.Configuration, error) {
 		return nil, err
 	}
 
-	registryClient, err := registry.NewClient(registry.ClientOptWriter(io.Discard))
+	registryClient, err := registry.NewClient(append([]registry.ClientOption{registry.ClientOptWriter(io.Discard)}, opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/home-cloud-io/core/cmd/operator/controller"
 	"github.com/home-cloud-io/core/cmd/operator/server/apps"
+	"github.com/home-cloud-io/core/cmd/operator/server/identity"
 	k8sclient "github.com/home-cloud-io/core/cmd/operator/server/k8s-client"
 	"github.com/home-cloud-io/core/cmd/operator/server/system"
 	"github.com/home-cloud-io/core/cmd/operator/server/web"
@@ -20,10 +21,11 @@ func main() {
 		kclient = k8sclient.NewClient(c.Logger())
 		actl    = apps.NewController(kclient)
 		sctl    = system.NewController(c.Logger(), kclient, actl)
+		ictl    = identity.NewController(c.Logger(), kclient)
 	)
 
 	c = c.WithClientApplication(client.Files, client.Root).
-		WithRPCHandler(web.New(c.Logger(), actl, sctl)).
+		WithRPCHandler(web.New(c.Logger(), actl, sctl, ictl)).
 		WithRunner(func() {
 			controller.Start(c.Logger())
 		})
@@ -13,9 +13,11 @@ import (
 	"github.com/robfig/cron/v3"
 	"github.com/steady-bytes/draft/pkg/chassis"
 	"golang.org/x/mod/semver"
+	"k8s.io/apimachinery/pkg/types"
 
 	opv1 "github.com/home-cloud-io/core/api/crds/v1"
 	v1 "github.com/home-cloud-io/core/api/platform/server/v1"
+	capps "github.com/home-cloud-io/core/cmd/operator/controller/apps"
 	k8sclient "github.com/home-cloud-io/core/cmd/operator/server/k8s-client"
 )
 
@@ -41,6 +43,17 @@ type (
 		AutoUpdate(ctx context.Context, logger chassis.Logger, schedule string)
 		// GetAppStorage will retrieve the app storage volumes for all installed apps.
 		GetAppStorage(ctx context.Context, logger chassis.Logger) ([]*v1.AppStorage, error)
+		// Backups will retrieve all backups of the requested app, newest first.
+		Backups(ctx context.Context, logger chassis.Logger, request *v1.ListAppBackupsRequest) ([]*v1.AppBackup, error)
+		// Restore will start restoring the requested app from one of its completed backups and
+		// return the name of the restore.
+		Restore(ctx context.Context, logger chassis.Logger, request *v1.RestoreAppRequest) (restore string, err error)
+		// WaitForRestore will wait for the restore with the given name to finish, calling progress
+		// each time its phase changes.
+		WaitForRestore(ctx context.Context, logger chassis.Logger, name string, progress func(status opv1.RestoreStatus)) error
+		// Preview will return the changes installing or updating the requested app would make without
+		// applying them.
+		Preview(ctx context.Context, logger chassis.Logger, request *v1.PreviewAppChangeRequest) (*v1.PreviewAppChangeResponse, error)
 	}
 
 	controller struct {
@@ -61,6 +74,9 @@ const (
 	ErrFailedToGetAppStorage        = "failed to get app storage"
 	ErrFailedToGetComponentVersions = "failed to get component versions"
 	ErrFailedToGetLogs              = "failed to get logs"
+	ErrFailedToGetAppBackups        = "failed to get app backups"
+	ErrFailedToRestoreApp           = "failed to restore app"
+	ErrFailedToPreviewApp           = "failed to preview app"
 
 	DefaultAutoUpdateAppsSchedule = "0 3 * * *"
 )
@@ -97,22 +113,25 @@ func (c *controller) Store(ctx context.Context, logger chassis.Logger) ([]*v1.Ap
 		// add extra information not from the index (e.g. readme and installed flag)
 		for _, app := range apps {
 
-			resp, err := http.Get(fmt.Sprintf("%s/%s-%s/charts/%s/README.md", store.RawChartUrl, app.Name, app.Version, app.Name))
-			if err != nil {
-				logger.WithFields(chassis.Fields{
-					"app":         app.Name,
-					"app_version": app.Version,
-				}).WithError(err).Error("failed to get readme for app")
-			}
-			defer resp.Body.Close()
-			body, err := io.ReadAll(resp.Body)
-			if err != nil {
-				logger.WithFields(chassis.Fields{
-					"app":         app.Name,
-					"app_version": app.Version,
-				}).WithError(err).Error("failed to read body of response while getting readme for app")
+			// stores without READMEs (e.g. the bundle of offline installs) only have an index
+			if store.RawChartUrl != "" {
+				resp, err := http.Get(fmt.Sprintf("%s/%s-%s/charts/%s/README.md", store.RawChartUrl, app.Name, app.Version, app.Name))
+				if err != nil {
+					logger.WithFields(chassis.Fields{
+						"app":         app.Name,
+						"app_version": app.Version,
+					}).WithError(err).Error("failed to get readme for app")
+				}
+				defer resp.Body.Close()
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					logger.WithFields(chassis.Fields{
+						"app":         app.Name,
+						"app_version": app.Version,
+					}).WithError(err).Error("failed to read body of response while getting readme for app")
+				}
+				app.Readme = string(body)
 			}
-			app.Readme = string(body)
 
 			for _, health := range healths {
 				if app.Name == health.Name {
@@ -231,6 +250,58 @@ func (c *controller) Update(ctx context.Context, logger chassis.Logger, request
 	return nil
 }
 
+func (c *controller) Preview(ctx context.Context, logger chassis.Logger, request *v1.PreviewAppChangeRequest) (*v1.PreviewAppChangeResponse, error) {
+	preview, err := c.k8sclient.PreviewApp(ctx, opv1.AppSpec{
+		Chart:   request.Chart,
+		Repo:    request.Repo,
+		Release: request.Release,
+		Values:  request.Values,
+		Version: request.Version,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to preview app")
+		return nil, err
+	}
+
+	response := &v1.PreviewAppChangeResponse{
+		InstalledVersion:  preview.InstalledVersion,
+		ManifestChanges:   make([]*v1.ManifestChange, len(preview.Manifest)),
+		DependencyChanges: make([]*v1.DependencyChange, len(preview.Dependencies)),
+	}
+	for i, change := range preview.Manifest {
+		response.ManifestChanges[i] = &v1.ManifestChange{
+			Kind:      change.Kind,
+			Namespace: change.Namespace,
+			Name:      change.Name,
+			Type:      changeType(change.Type),
+			Diff:      change.Diff,
+		}
+	}
+	for i, change := range preview.Dependencies {
+		response.DependencyChanges[i] = &v1.DependencyChange{
+			Kind:        string(change.Kind),
+			Name:        change.Name,
+			Type:        changeType(change.Type),
+			Description: change.Description,
+		}
+	}
+
+	return response, nil
+}
+
+func changeType(t capps.ChangeType) v1.ChangeType {
+	switch t {
+	case capps.ChangeTypeAdded:
+		return v1.ChangeType_CHANGE_TYPE_ADDED
+	case capps.ChangeTypeRemoved:
+		return v1.ChangeType_CHANGE_TYPE_REMOVED
+	case capps.ChangeTypeModified:
+		return v1.ChangeType_CHANGE_TYPE_MODIFIED
+	default:
+		return v1.ChangeType_CHANGE_TYPE_UNSPECIFIED
+	}
+}
+
 func (c *controller) UpdateAll(ctx context.Context, logger chassis.Logger) error {
 	logger.Info("running update check for all apps")
 
@@ -257,6 +328,10 @@ func (c *controller) UpdateAll(ctx context.Context, logger chassis.Logger) error
 					"latest_version":    store.Version,
 				})
 				log.Info("checking if update is needed")
+				if store.Version == installed.Status.FailedVersion {
+					log.Info("skipping update to version which was previously rolled back")
+					continue
+				}
 				if semver.Compare("v"+store.Version, "v"+installed.Spec.Version) == 1 {
 					log.Info("update is needed")
 					err := c.Update(ctx, logger, &v1.UpdateAppRequest{
@@ -366,6 +441,92 @@ func (c *controller) GetAppStorage(ctx context.Context, logger chassis.Logger) (
 	return storage, err
 }
 
+func (c *controller) Backups(ctx context.Context, logger chassis.Logger, request *v1.ListAppBackupsRequest) ([]*v1.AppBackup, error) {
+	backups, err := c.k8sclient.AppBackups(ctx, request.AppName)
+	if err != nil {
+		logger.WithError(err).Error("failed to get app backups")
+		return nil, err
+	}
+
+	// sort newest first
+	slices.SortFunc(backups, func(a, b opv1.Backup) int {
+		return b.CreationTimestamp.Compare(a.CreationTimestamp.Time)
+	})
+
+	appBackups := make([]*v1.AppBackup, len(backups))
+	for i, backup := range backups {
+		appBackups[i] = &v1.AppBackup{
+			Name:     backup.Name,
+			AppName:  backup.Spec.App,
+			Phase:    string(backup.Status.Phase),
+			Created:  backup.CreationTimestamp.Format(time.RFC3339),
+			Location: backup.Status.Location,
+			Error:    backup.Status.Error,
+		}
+	}
+
+	return appBackups, nil
+}
+
+func (c *controller) Restore(ctx context.Context, logger chassis.Logger, request *v1.RestoreAppRequest) (string, error) {
+	backup := &opv1.Backup{}
+	err := c.k8sclient.Get(ctx, types.NamespacedName{
+		Namespace: k8sclient.DefaultHomeCloudNamespace,
+		Name:      request.Backup,
+	}, backup)
+	if err != nil {
+		logger.WithError(err).Error("failed to get backup")
+		return "", err
+	}
+	if backup.Spec.App != request.AppName {
+		return "", fmt.Errorf("backup %s is not a backup of app %s", request.Backup, request.AppName)
+	}
+	if backup.Status.Phase != opv1.BackupPhaseCompleted {
+		return "", fmt.Errorf("backup %s has not completed", request.Backup)
+	}
+
+	restore, err := c.k8sclient.RestoreApp(ctx, request.AppName, request.Backup)
+	if err != nil {
+		logger.WithError(err).Error("failed to create restore")
+		return "", err
+	}
+
+	return restore.Name, nil
+}
+
+func (c *controller) WaitForRestore(ctx context.Context, logger chassis.Logger, name string, progress func(status opv1.RestoreStatus)) error {
+	var phase opv1.RestorePhase
+	for {
+		if ctx.Err() != nil {
+			logger.WithError(ctx.Err()).Error("context is done")
+			return ctx.Err()
+		}
+		restore := &opv1.Restore{}
+		err := c.k8sclient.Get(ctx, types.NamespacedName{
+			Namespace: k8sclient.DefaultHomeCloudNamespace,
+			Name:      name,
+		}, restore)
+		if err != nil {
+			logger.WithError(err).Error("failed to get restore")
+			return err
+		}
+		if restore.Status.Phase != phase {
+			phase = restore.Status.Phase
+			progress(restore.Status)
+		}
+		switch phase {
+		case opv1.RestorePhaseCompleted:
+			logger.Info("restore completed")
+			return nil
+		case opv1.RestorePhaseFailed:
+			return fmt.Errorf("restore failed: %s", restore.Status.Error)
+		}
+		logger.WithField("phase", phase).Info("not yet restored")
+
+		time.Sleep(5 * time.Second)
+	}
+}
+
 func (c *controller) waitForInstall(ctx context.Context, logger chassis.Logger, appName string) error {
 	for {
 		if ctx.Err() != nil {
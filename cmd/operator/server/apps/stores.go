@@ -3,14 +3,21 @@ package apps
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/steady-bytes/draft/pkg/chassis"
 	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/types"
 
 	opv1 "github.com/home-cloud-io/core/api/crds/v1"
 	v1 "github.com/home-cloud-io/core/api/platform/server/v1"
+	k8sclient "github.com/home-cloud-io/core/cmd/operator/server/k8s-client"
+	"github.com/home-cloud-io/core/pkg/install/bundle"
 )
 
 type (
@@ -35,11 +42,19 @@ const (
 func (c *controller) stores(ctx context.Context, logger chassis.Logger) ([]*v1.AppStoreEntries, error) {
 	results := []*v1.AppStoreEntries{}
 
-	settings, err := c.k8sclient.Settings(ctx)
+	install := &opv1.Install{}
+	err := c.k8sclient.Get(ctx, types.NamespacedName{
+		Namespace: k8sclient.DefaultHomeCloudNamespace,
+		Name:      "install",
+	}, install)
 	if err != nil {
-		logger.WithError(err).Error("failed to get settings")
+		logger.WithError(err).Error("failed to get install")
 		return nil, err
 	}
+	settings := install.Spec.Settings
+	if settings == nil {
+		settings = &opv1.SettingsSpec{}
+	}
 
 	if len(settings.AppStores) == 0 {
 		settings.AppStores = []opv1.AppStore{
@@ -48,31 +63,24 @@ func (c *controller) stores(ctx context.Context, logger chassis.Logger) ([]*v1.A
 				RawChartURL: DefaultAppStoreRawChartURL,
 			},
 		}
+		// offline installs default to the charts of their bundle (which has no READMEs)
+		if b := bundle.Of(install); b != nil {
+			settings.AppStores = []opv1.AppStore{
+				{
+					URL: "file://" + filepath.Join(b.Path, bundle.ChartsDir, "index.yaml"),
+				},
+			}
+		}
 	}
 
 	for _, store := range settings.AppStores {
 		l := logger.WithField("app_store", store.URL)
-		url := store.URL
-
-		httpClient := &http.Client{}
-		req, err := http.NewRequest(http.MethodGet, url, nil)
-		if err != nil {
-			l.WithError(err).Error("failed to create request to app store")
-			return results, errors.New(ErrFailedToPopulateAppStore)
-		}
 
-		res, err := httpClient.Do(req)
+		body, err := readStoreIndex(store.URL)
 		if err != nil {
 			l.WithError(err).Error("failed to get entries from app store")
 			return results, errors.New(ErrFailedToPopulateAppStore)
 		}
-		defer res.Body.Close()
-
-		body, err := io.ReadAll(res.Body)
-		if err != nil {
-			l.WithError(err).Error("failed to read entries from app store")
-			return results, errors.New(ErrFailedToPopulateAppStore)
-		}
 
 		appStoreResponse := &HelmIndex{}
 		if err := yaml.Unmarshal(body, appStoreResponse); err != nil {
@@ -97,3 +105,20 @@ func (c *controller) stores(ctx context.Context, logger chassis.Logger) ([]*v1.A
 
 	return results, nil
 }
+
+// readStoreIndex reads the index of an app store from a URL or, with the file:// scheme, from disk.
+func readStoreIndex(url string) ([]byte, error) {
+	if path, ok := strings.CutPrefix(url, "file://"); ok {
+		return os.ReadFile(path)
+	}
+
+	res, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get %s: %s", url, res.Status)
+	}
+	return io.ReadAll(res.Body)
+}
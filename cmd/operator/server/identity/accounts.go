@@ -0,0 +1,284 @@
+package identity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/steady-bytes/draft/pkg/chassis"
+	"golang.org/x/crypto/bcrypt"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	opv1 "github.com/home-cloud-io/core/api/crds/v1"
+	v1 "github.com/home-cloud-io/core/api/platform/server/v1"
+	k8sclient "github.com/home-cloud-io/core/cmd/operator/server/k8s-client"
+)
+
+type (
+	// Accounts manages the Home Cloud accounts. Only signed in users (see SignedIn) can manage
+	// accounts except that the first account (which is an admin) can be created before any exist.
+	// Admins can create, change and delete any account while other users can only change their own
+	// account with its current password. The last admin can't be demoted or deleted.
+	Accounts interface {
+		// SignedIn returns the username of the account signed in to the Home Cloud hostname by the
+		// session cookie of the request headers or ErrNotSignedIn.
+		SignedIn(ctx context.Context, header http.Header) (string, error)
+		// ListAccounts returns all Home Cloud accounts sorted by username.
+		ListAccounts(ctx context.Context, logger chassis.Logger, user string) ([]*v1.Account, error)
+		// SaveAccount creates the account or updates its email and (if given) password.
+		SaveAccount(ctx context.Context, logger chassis.Logger, user string, request *v1.SaveAccountRequest) error
+		// DeleteAccount deletes the account. Users stay signed in to Apps until their session expires.
+		DeleteAccount(ctx context.Context, logger chassis.Logger, user string, request *v1.DeleteAccountRequest) error
+	}
+
+	// account is stored as JSON under the key of its username in the accounts Secret
+	account struct {
+		PasswordHash string `json:"passwordHash"`
+		Email        string `json:"email,omitempty"`
+		Admin        bool   `json:"admin,omitempty"`
+	}
+)
+
+const (
+	minPasswordLength = 8
+)
+
+var (
+	// usernames are used as keys of the accounts Secret
+	usernamePattern = regexp.MustCompile(`^[a-z0-9]([-._a-z0-9]{0,61}[a-z0-9])?$`)
+
+	ErrInvalidUsername    = errors.New("username must be lowercase letters, numbers, '-', '.' or '_'")
+	ErrPasswordTooShort   = errors.New("password must be at least 8 characters")
+	ErrAccountNotFound    = errors.New("account not found")
+	ErrInvalidCredentials = errors.New("invalid username or password")
+	ErrNotSignedIn        = errors.New("not signed in")
+	ErrForbidden          = errors.New("only admins can manage other accounts and the current password is required to change your own")
+	ErrLastAdmin          = errors.New("the last admin can't be demoted or deleted")
+
+	// compared against when the account doesn't exist so that unknown usernames take as long to reject
+	dummyHash, _ = bcrypt.GenerateFromPassword([]byte("home-cloud"), bcrypt.DefaultCost)
+)
+
+func (c *controller) SignedIn(ctx context.Context, header http.Header) (string, error) {
+	install, err := c.install(ctx)
+	if err != nil {
+		return "", err
+	}
+	k, err := c.keys(ctx)
+	if err != nil {
+		return "", err
+	}
+	session, err := c.session(&http.Request{Header: header}, k, install.Spec.Settings.Hostname)
+	if err != nil {
+		return "", ErrNotSignedIn
+	}
+	// the account may have been deleted since
+	_, err = c.account(ctx, session.Subject)
+	if errors.Is(err, ErrAccountNotFound) {
+		return "", ErrNotSignedIn
+	}
+	if err != nil {
+		return "", err
+	}
+	return session.Subject, nil
+}
+
+func (c *controller) ListAccounts(ctx context.Context, logger chassis.Logger, user string) ([]*v1.Account, error) {
+	_, accounts, err := c.accounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := accounts[user]; !ok {
+		return nil, ErrNotSignedIn
+	}
+	list := []*v1.Account{}
+	for username, a := range accounts {
+		list = append(list, &v1.Account{
+			Username: username,
+			Email:    a.Email,
+			Admin:    a.Admin,
+		})
+	}
+	slices.SortFunc(list, func(a, b *v1.Account) int {
+		return strings.Compare(a.Username, b.Username)
+	})
+	return list, nil
+}
+
+func (c *controller) SaveAccount(ctx context.Context, logger chassis.Logger, user string, request *v1.SaveAccountRequest) error {
+	if !usernamePattern.MatchString(request.Username) {
+		return ErrInvalidUsername
+	}
+	secret, accounts, err := c.accounts(ctx)
+	if err != nil {
+		return err
+	}
+
+	a, exists := accounts[request.Username]
+	_, signedIn := accounts[user]
+	switch {
+	case len(accounts) == 0:
+		// the first account is created before anyone can sign in and administers the others
+		request.Admin = true
+	case !signedIn:
+		return ErrNotSignedIn
+	case !canChange(accounts, user, request.Username, request.CurrentPassword):
+		return ErrForbidden
+	case !isAdmin(accounts, user):
+		// only admins can change who is an admin
+		request.Admin = a.Admin
+	case !request.Admin && lastAdmin(accounts, request.Username):
+		return ErrLastAdmin
+	}
+
+	if !exists || request.Password != "" {
+		if len(request.Password) < minPasswordLength {
+			return ErrPasswordTooShort
+		}
+		hash, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return err
+		}
+		a.PasswordHash = string(hash)
+	}
+	a.Email = request.Email
+	a.Admin = request.Admin
+
+	b, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data[request.Username] = b
+
+	logger.WithField("username", request.Username).Info("saving account")
+	if secret.ResourceVersion == "" {
+		return c.k8sclient.Create(ctx, secret)
+	}
+	return c.k8sclient.Update(ctx, secret)
+}
+
+func (c *controller) DeleteAccount(ctx context.Context, logger chassis.Logger, user string, request *v1.DeleteAccountRequest) error {
+	secret, accounts, err := c.accounts(ctx)
+	if err != nil {
+		return err
+	}
+	if _, ok := accounts[user]; !ok {
+		return ErrNotSignedIn
+	}
+	if _, ok := accounts[request.Username]; !ok {
+		return ErrAccountNotFound
+	}
+	if !canChange(accounts, user, request.Username, request.CurrentPassword) {
+		return ErrForbidden
+	}
+	if lastAdmin(accounts, request.Username) {
+		return ErrLastAdmin
+	}
+	logger.WithField("username", request.Username).Info("deleting account")
+	delete(secret.Data, request.Username)
+	return c.k8sclient.Update(ctx, secret)
+}
+
+// canChange reports whether the signed in user can create, change or delete the account with the
+// username: admins can change any account and everyone else only their own with its current password.
+func canChange(accounts map[string]account, user string, username string, currentPassword string) bool {
+	if isAdmin(accounts, user) {
+		return true
+	}
+	a, ok := accounts[username]
+	if !ok || user != username {
+		return false
+	}
+	return bcrypt.CompareHashAndPassword([]byte(a.PasswordHash), []byte(currentPassword)) == nil
+}
+
+// isAdmin reports whether the user is an admin.
+func isAdmin(accounts map[string]account, user string) bool {
+	return accounts[user].Admin
+}
+
+// lastAdmin reports whether the account with the username is the only admin.
+func lastAdmin(accounts map[string]account, username string) bool {
+	if !isAdmin(accounts, username) {
+		return false
+	}
+	for other, a := range accounts {
+		if other != username && a.Admin {
+			return false
+		}
+	}
+	return true
+}
+
+// authenticate returns the account if the password matches.
+func (c *controller) authenticate(ctx context.Context, username string, password string) (*account, error) {
+	_, accounts, err := c.accounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a, ok := accounts[username]
+	if !ok {
+		_ = bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+		return nil, ErrInvalidCredentials
+	}
+	if bcrypt.CompareHashAndPassword([]byte(a.PasswordHash), []byte(password)) != nil {
+		return nil, ErrInvalidCredentials
+	}
+	return &a, nil
+}
+
+// account returns the account with the given username.
+func (c *controller) account(ctx context.Context, username string) (*account, error) {
+	_, accounts, err := c.accounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a, ok := accounts[username]
+	if !ok {
+		return nil, ErrAccountNotFound
+	}
+	return &a, nil
+}
+
+// accounts returns the accounts Secret (which is new if it doesn't exist yet) and the accounts it
+// holds by username.
+func (c *controller) accounts(ctx context.Context) (*corev1.Secret, map[string]account, error) {
+	secret := &corev1.Secret{}
+	err := c.k8sclient.Get(ctx, types.NamespacedName{
+		Name:      opv1.AccountsSecretName,
+		Namespace: k8sclient.DefaultHomeCloudNamespace,
+	}, secret)
+	if kerrors.IsNotFound(err) {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      opv1.AccountsSecretName,
+				Namespace: k8sclient.DefaultHomeCloudNamespace,
+			},
+		}, map[string]account{}, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accounts := map[string]account{}
+	for username, data := range secret.Data {
+		a := account{}
+		err := json.Unmarshal(data, &a)
+		if err != nil {
+			c.logger.WithError(err).WithField("username", username).Warn("ignoring invalid account")
+			continue
+		}
+		accounts[username] = a
+	}
+	return secret, accounts, nil
+}
@@ -0,0 +1,112 @@
+package identity
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+
+	"dario.cat/mergo"
+	"github.com/steady-bytes/draft/pkg/chassis"
+	"k8s.io/apimachinery/pkg/types"
+
+	opv1 "github.com/home-cloud-io/core/api/crds/v1"
+	k8sclient "github.com/home-cloud-io/core/cmd/operator/server/k8s-client"
+	"github.com/home-cloud-io/core/pkg/install/resources"
+)
+
+type (
+	// Controller is the identity provider of Home Cloud. Users sign in with their Home Cloud account
+	// once and are then signed in to every App route which requires authentication (through the
+	// forward-auth endpoint called by the ingress gateway) and every App which integrates with the
+	// OIDC endpoints.
+	Controller interface {
+		http.Handler
+		Accounts
+	}
+
+	controller struct {
+		logger    chassis.Logger
+		k8sclient k8sclient.Default
+
+		mu         sync.Mutex
+		cachedKeys *keys
+		// authorization codes which were already exchanged (until they expire)
+		usedCodes sync.Map
+	}
+)
+
+const (
+	// AuthPath and OIDCPath are the path prefixes of the identity provider endpoints
+	AuthPath = "/auth/"
+	OIDCPath = "/oidc/"
+
+	verifyPath   = "/auth/verify"
+	loginPath    = "/auth/login"
+	logoutPath   = "/auth/logout"
+	callbackPath = "/.home-cloud/callback"
+
+	discoveryPath = "/oidc/.well-known/openid-configuration"
+	authorizePath = "/oidc/authorize"
+	tokenPath     = "/oidc/token"
+	userInfoPath  = "/oidc/userinfo"
+	keysPath      = "/oidc/keys"
+
+	ErrFailedToListAccounts  = "failed to list accounts"
+	ErrFailedToSaveAccount   = "failed to save account"
+	ErrFailedToDeleteAccount = "failed to delete account"
+)
+
+func NewController(logger chassis.Logger, kclient k8sclient.Default) Controller {
+	return &controller{
+		logger:    logger,
+		k8sclient: kclient,
+	}
+}
+
+func (c *controller) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	// the ingress gateway appends the path of the request being authenticated
+	case r.URL.Path == verifyPath || strings.HasPrefix(r.URL.Path, verifyPath+"/"):
+		c.verify(w, r)
+	case r.URL.Path == loginPath:
+		c.login(w, r)
+	case r.URL.Path == logoutPath:
+		c.logout(w, r)
+	case r.URL.Path == discoveryPath:
+		c.discovery(w, r)
+	case r.URL.Path == authorizePath:
+		c.authorize(w, r)
+	case r.URL.Path == tokenPath:
+		c.token(w, r)
+	case r.URL.Path == userInfoPath:
+		c.userInfo(w, r)
+	case r.URL.Path == keysPath:
+		c.jwks(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// install returns the Install with defaults set.
+func (c *controller) install(ctx context.Context) (*opv1.Install, error) {
+	install := &opv1.Install{}
+	err := c.k8sclient.Get(ctx, types.NamespacedName{
+		Namespace: k8sclient.DefaultHomeCloudNamespace,
+		Name:      "install",
+	}, install)
+	if err != nil {
+		return nil, err
+	}
+	err = mergo.Merge(install, resources.DefaultInstall)
+	if err != nil {
+		return nil, err
+	}
+	return install, nil
+}
+
+// serverError logs the error and responds with a generic error so that no internals are leaked.
+func (c *controller) serverError(w http.ResponseWriter, err error, msg string) {
+	c.logger.WithError(err).Error(msg)
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
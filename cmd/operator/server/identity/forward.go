@@ -0,0 +1,306 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"html/template"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	opv1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+const (
+	sessionCookie   = "home_cloud_session"
+	sessionDuration = 7 * 24 * time.Hour
+	// tickets carry a sign in from the Home Cloud hostname over to the hostname of an App
+	ticketDuration = time.Minute
+
+	// UserHeader and EmailHeader are set on authenticated requests forwarded to Apps
+	UserHeader  = "X-Forwarded-User"
+	EmailHeader = "X-Forwarded-Email"
+)
+
+var loginPage = template.Must(template.New("login").Parse(`<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+  <title>Sign in to Home Cloud</title>
+  <style>
+    body { font-family: sans-serif; display: flex; justify-content: center; margin-top: 10vh; }
+    form { display: flex; flex-direction: column; gap: 0.75rem; width: 18rem; }
+    .error { color: #b00020; }
+  </style>
+</head>
+<body>
+  <form method="post" action="/auth/login">
+    <h2>Sign in to Home Cloud</h2>
+    {{if .Error}}<p class="error">{{.Error}}</p>{{end}}
+    {{if .SignedOut}}<p>You have been signed out.</p>{{end}}
+    <input type="hidden" name="rd" value="{{.Redirect}}">
+    <input name="username" placeholder="Username" autocomplete="username" autofocus required>
+    <input name="password" type="password" placeholder="Password" autocomplete="current-password" required>
+    <button type="submit">Sign in</button>
+  </form>
+</body>
+</html>
+`))
+
+type loginData struct {
+	Redirect  string
+	Error     string
+	SignedOut bool
+}
+
+// verify is called by the ingress gateway for every request to an App route which requires
+// authentication. A 200 response lets the request through, any other response is returned to the
+// user instead: a redirect to sign in or, on the callback from signing in, a redirect back to the
+// requested page which sets the session of the App hostname.
+func (c *controller) verify(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	host := hostname(r.Host)
+	path := strings.TrimPrefix(r.URL.Path, verifyPath)
+	if path == "" {
+		path = "/"
+	}
+	scheme := requestScheme(r)
+
+	k, err := c.keys(ctx)
+	if err != nil {
+		c.serverError(w, err, "failed to get identity keys")
+		return
+	}
+
+	if path == callbackPath {
+		query := r.URL.Query()
+		ticket, err := k.verify(query.Get("ticket"), useTicket, host, time.Now())
+		if err != nil {
+			http.Error(w, "invalid or expired sign in", http.StatusUnauthorized)
+			return
+		}
+		err = c.setSession(ctx, w, k, ticket.Subject, host, scheme == "https")
+		if err != nil {
+			c.serverError(w, err, "failed to create session")
+			return
+		}
+		http.Redirect(w, r, sameHostRedirect(query.Get("rd"), r.Host), http.StatusFound)
+		return
+	}
+
+	session, err := c.session(r, k, host)
+	if err == nil {
+		w.Header().Set(UserHeader, session.Subject)
+		w.Header().Set(EmailHeader, session.Email)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	settings, err := c.k8sclient.Settings(ctx)
+	if err != nil {
+		c.serverError(w, err, "failed to get settings")
+		return
+	}
+	requested := url.URL{Scheme: scheme, Host: r.Host, Path: path, RawQuery: r.URL.RawQuery}
+	login := url.URL{
+		Scheme:   scheme,
+		Host:     settings.Hostname,
+		Path:     loginPath,
+		RawQuery: url.Values{"rd": {requested.String()}}.Encode(),
+	}
+	http.Redirect(w, r, login.String(), http.StatusFound)
+}
+
+// login signs the user in to the Home Cloud hostname and then sends them on to where they were going.
+func (c *controller) login(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	host := hostname(r.Host)
+	k, err := c.keys(ctx)
+	if err != nil {
+		c.serverError(w, err, "failed to get identity keys")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		rd := r.URL.Query().Get("rd")
+		session, err := c.session(r, k, host)
+		if err == nil {
+			c.continueTo(w, r, k, session.Subject, rd)
+			return
+		}
+		renderLogin(w, http.StatusOK, loginData{Redirect: rd})
+	case http.MethodPost:
+		username := r.PostFormValue("username")
+		rd := r.PostFormValue("rd")
+		_, err := c.authenticate(ctx, username, r.PostFormValue("password"))
+		if errors.Is(err, ErrInvalidCredentials) {
+			c.logger.WithField("username", username).Warn("failed sign in")
+			renderLogin(w, http.StatusUnauthorized, loginData{Redirect: rd, Error: err.Error()})
+			return
+		}
+		if err != nil {
+			c.serverError(w, err, "failed to authenticate")
+			return
+		}
+		err = c.setSession(ctx, w, k, username, host, requestScheme(r) == "https")
+		if err != nil {
+			c.serverError(w, err, "failed to create session")
+			return
+		}
+		c.continueTo(w, r, k, username, rd)
+	default:
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	}
+}
+
+// logout ends the session on the Home Cloud hostname. Sessions on App hostnames end when they expire.
+func (c *controller) logout(w http.ResponseWriter, r *http.Request) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+	})
+	renderLogin(w, http.StatusOK, loginData{SignedOut: true})
+}
+
+// continueTo redirects a signed in user to the given URL. URLs on other hostnames (i.e. App routes) are
+// reached through the callback of the hostname with a ticket so that it can set its own session.
+func (c *controller) continueTo(w http.ResponseWriter, r *http.Request, k *keys, username string, rd string) {
+	target, err := url.Parse(rd)
+	if err != nil || target.Host == "" || target.Host == r.Host {
+		http.Redirect(w, r, sameHostRedirect(rd, r.Host), http.StatusFound)
+		return
+	}
+
+	// only hand out tickets to hostnames routed to Apps
+	routed, err := c.routedHostname(r.Context(), hostname(target.Host))
+	if err != nil {
+		c.serverError(w, err, "failed to get app routes")
+		return
+	}
+	if !routed || (target.Scheme != "http" && target.Scheme != "https") {
+		http.Error(w, "invalid redirect", http.StatusBadRequest)
+		return
+	}
+
+	a, err := c.account(r.Context(), username)
+	if err != nil {
+		// the account was deleted: start over
+		renderLogin(w, http.StatusUnauthorized, loginData{Redirect: rd})
+		return
+	}
+	ticket, err := k.sign(claims{
+		Subject:  username,
+		Email:    a.Email,
+		Audience: hostname(target.Host),
+		Expiry:   time.Now().Add(ticketDuration).Unix(),
+		Use:      useTicket,
+	})
+	if err != nil {
+		c.serverError(w, err, "failed to sign ticket")
+		return
+	}
+	callback := url.URL{
+		Scheme:   target.Scheme,
+		Host:     target.Host,
+		Path:     callbackPath,
+		RawQuery: url.Values{"ticket": {ticket}, "rd": {rd}}.Encode(),
+	}
+	http.Redirect(w, r, callback.String(), http.StatusFound)
+}
+
+// routedHostname reports whether the hostname is served by an App route.
+func (c *controller) routedHostname(ctx context.Context, host string) (bool, error) {
+	routes := &gwv1.HTTPRouteList{}
+	err := c.k8sclient.List(ctx, routes, client.HasLabels{opv1.AppLabel})
+	if err != nil {
+		return false, err
+	}
+	for _, route := range routes.Items {
+		if slices.Contains(route.Spec.Hostnames, gwv1.Hostname(host)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// session returns the claims of the valid session of the hostname sent with the request.
+func (c *controller) session(r *http.Request, k *keys, host string) (*claims, error) {
+	cookie, err := r.Cookie(sessionCookie)
+	if err != nil {
+		return nil, err
+	}
+	return k.verify(cookie.Value, useSession, host, time.Now())
+}
+
+// setSession signs the user in to the hostname.
+func (c *controller) setSession(ctx context.Context, w http.ResponseWriter, k *keys, username string, host string, secure bool) error {
+	email := ""
+	a, err := c.account(ctx, username)
+	if err == nil {
+		email = a.Email
+	}
+	expires := time.Now().Add(sessionDuration)
+	session, err := k.sign(claims{
+		Subject:  username,
+		Email:    email,
+		Audience: host,
+		Expiry:   expires.Unix(),
+		Use:      useSession,
+	})
+	if err != nil {
+		return err
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Value:    session,
+		Path:     "/",
+		Expires:  expires,
+		HttpOnly: true,
+		Secure:   secure,
+		SameSite: http.SameSiteLaxMode,
+	})
+	return nil
+}
+
+func renderLogin(w http.ResponseWriter, status int, data loginData) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	_ = loginPage.Execute(w, data)
+}
+
+// sameHostRedirect returns the path of the URL if it is on the given host (or relative) and otherwise
+// the root path so that redirects can't be used to send users elsewhere.
+func sameHostRedirect(rd string, host string) string {
+	target, err := url.Parse(rd)
+	if err != nil || (target.Host != "" && target.Host != host) || !strings.HasPrefix(target.Path, "/") {
+		return "/"
+	}
+	return (&url.URL{Path: target.Path, RawQuery: target.RawQuery}).String()
+}
+
+// requestScheme returns the scheme of the request as received by the ingress gateway.
+func requestScheme(r *http.Request) string {
+	if r.Header.Get("X-Forwarded-Proto") == "https" || r.TLS != nil {
+		return "https"
+	}
+	return "http"
+}
+
+// hostname returns the host without its port.
+func hostname(host string) string {
+	h, _, err := net.SplitHostPort(host)
+	if err != nil {
+		return host
+	}
+	return h
+}
@@ -0,0 +1,490 @@
+package identity
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steady-bytes/draft/pkg/chassis"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	opv1 "github.com/home-cloud-io/core/api/crds/v1"
+	v1 "github.com/home-cloud-io/core/api/platform/server/v1"
+	k8sclient "github.com/home-cloud-io/core/cmd/operator/server/k8s-client"
+)
+
+func TestTokens(t *testing.T) {
+	secret, err := newKeysSecret()
+	assert.NoError(t, err)
+	k, err := parseKeys(secret)
+	assert.NoError(t, err)
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		claims   claims
+		use      string
+		audience string
+		now      time.Time
+		// tamper modifies the signed token
+		tamper  func(token string) string
+		wantErr error
+	}{
+		{
+			name:     "valid session",
+			claims:   claims{Subject: "jane", Audience: "immich.local", Use: useSession, Expiry: now.Add(time.Hour).Unix()},
+			use:      useSession,
+			audience: "immich.local",
+			now:      now,
+		},
+		{
+			name:     "valid access token",
+			claims:   claims{Subject: "jane", Audience: "grafana", Use: useAccess, Expiry: now.Add(time.Hour).Unix()},
+			use:      useAccess,
+			audience: "grafana",
+			now:      now,
+		},
+		{
+			name:     "other audience",
+			claims:   claims{Subject: "jane", Audience: "immich.local", Use: useSession, Expiry: now.Add(time.Hour).Unix()},
+			use:      useSession,
+			audience: "grafana.local",
+			now:      now,
+			wantErr:  ErrInvalidToken,
+		},
+		{
+			name:     "ticket used as session",
+			claims:   claims{Subject: "jane", Audience: "immich.local", Use: useTicket, Expiry: now.Add(time.Hour).Unix()},
+			use:      useSession,
+			audience: "immich.local",
+			now:      now,
+			wantErr:  ErrInvalidToken,
+		},
+		{
+			name:     "expired",
+			claims:   claims{Subject: "jane", Audience: "immich.local", Use: useSession, Expiry: now.Add(time.Hour).Unix()},
+			use:      useSession,
+			audience: "immich.local",
+			now:      now.Add(2 * time.Hour),
+			wantErr:  ErrExpiredToken,
+		},
+		{
+			name:     "tampered claims",
+			claims:   claims{Subject: "jane", Audience: "immich.local", Use: useSession, Expiry: now.Add(time.Hour).Unix()},
+			use:      useSession,
+			audience: "immich.local",
+			now:      now,
+			tamper: func(token string) string {
+				parts := strings.Split(token, ".")
+				forged, _ := encodeSegment(claims{Subject: "admin", Audience: "immich.local", Use: useSession, Expiry: now.Add(time.Hour).Unix()})
+				return parts[0] + "." + forged + "." + parts[2]
+			},
+			wantErr: ErrInvalidToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := k.sign(tt.claims)
+			assert.NoError(t, err)
+			if tt.tamper != nil {
+				token = tt.tamper(token)
+			}
+			c, err := k.verify(token, tt.use, tt.audience, tt.now)
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.claims, *c)
+			}
+		})
+	}
+}
+
+func TestForwardAuth(t *testing.T) {
+	c := newTestController(t)
+
+	// unauthenticated requests are sent to sign in
+	resp := do(c, httptest.NewRequest(http.MethodGet, "http://immich.local/auth/verify/photos?id=1", nil))
+	assert.Equal(t, http.StatusFound, resp.Code)
+	assert.Equal(t, "http://home-cloud.local/auth/login?rd="+url.QueryEscape("http://immich.local/photos?id=1"), resp.Header().Get("Location"))
+
+	// wrong password
+	resp = do(c, loginRequest("jane", "wrong", "http://immich.local/photos?id=1"))
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+	// signing in sends the user back through the callback of the app hostname
+	resp = do(c, loginRequest("jane", "password", "http://immich.local/photos?id=1"))
+	assert.Equal(t, http.StatusFound, resp.Code)
+	callback, err := url.Parse(resp.Header().Get("Location"))
+	assert.NoError(t, err)
+	assert.Equal(t, "immich.local", callback.Host)
+	assert.Equal(t, callbackPath, callback.Path)
+
+	// which sets the session of the app hostname
+	resp = do(c, httptest.NewRequest(http.MethodGet, "http://immich.local/auth/verify"+callback.RequestURI(), nil))
+	assert.Equal(t, http.StatusFound, resp.Code)
+	assert.Equal(t, "/photos?id=1", resp.Header().Get("Location"))
+	session := resp.Result().Cookies()[0]
+	assert.Equal(t, sessionCookie, session.Name)
+
+	// authenticated requests are let through with the user
+	req := httptest.NewRequest(http.MethodGet, "http://immich.local/auth/verify/photos", nil)
+	req.AddCookie(session)
+	resp = do(c, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "jane", resp.Header().Get(UserHeader))
+	assert.Equal(t, "jane@example.com", resp.Header().Get(EmailHeader))
+
+	// the session of one app isn't valid for another
+	req = httptest.NewRequest(http.MethodGet, "http://grafana.local/auth/verify/", nil)
+	req.AddCookie(session)
+	resp = do(c, req)
+	assert.Equal(t, http.StatusFound, resp.Code)
+
+	// tickets are only handed out to app hostnames
+	resp = do(c, loginRequest("jane", "password", "http://evil.example.com/"))
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestOIDC(t *testing.T) {
+	c := newTestController(t)
+	verifier := "a-very-long-random-code-verifier-for-pkce"
+	sum := sha256.Sum256([]byte(verifier))
+	challenge := base64.RawURLEncoding.EncodeToString(sum[:])
+	authorize := "http://home-cloud.local/oidc/authorize?" + url.Values{
+		"client_id":             {"grafana"},
+		"redirect_uri":          {"https://grafana.local/login/generic_oauth"},
+		"response_type":         {"code"},
+		"scope":                 {"openid email"},
+		"state":                 {"xyz"},
+		"nonce":                 {"abc"},
+		"code_challenge":        {challenge},
+		"code_challenge_method": {"S256"},
+	}.Encode()
+
+	// unregistered redirect URIs are rejected
+	resp := do(c, httptest.NewRequest(http.MethodGet, strings.Replace(authorize, "grafana.local", "evil.com", 1), nil))
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+	// users sign in first
+	resp = do(c, httptest.NewRequest(http.MethodGet, authorize, nil))
+	assert.Equal(t, http.StatusFound, resp.Code)
+	assert.True(t, strings.HasPrefix(resp.Header().Get("Location"), loginPath+"?rd="))
+
+	resp = do(c, loginRequest("jane", "password", strings.TrimPrefix(authorize, "http://home-cloud.local")))
+	assert.Equal(t, http.StatusFound, resp.Code)
+	session := resp.Result().Cookies()[0]
+
+	req := httptest.NewRequest(http.MethodGet, authorize, nil)
+	req.AddCookie(session)
+	resp = do(c, req)
+	assert.Equal(t, http.StatusFound, resp.Code)
+	redirect, err := url.Parse(resp.Header().Get("Location"))
+	assert.NoError(t, err)
+	assert.Equal(t, "grafana.local", redirect.Host)
+	assert.Equal(t, "xyz", redirect.Query().Get("state"))
+	code := redirect.Query().Get("code")
+
+	exchange := func(secret string, verifier string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "http://home-cloud.local/oidc/token", strings.NewReader(url.Values{
+			"grant_type":    {"authorization_code"},
+			"code":          {code},
+			"redirect_uri":  {"https://grafana.local/login/generic_oauth"},
+			"code_verifier": {verifier},
+		}.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth("grafana", secret)
+		return do(c, req)
+	}
+	assert.Equal(t, http.StatusUnauthorized, exchange("wrong", verifier).Code)
+	assert.Equal(t, http.StatusBadRequest, exchange("secret", "wrong").Code)
+
+	resp = exchange("secret", verifier)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	tokens := map[string]any{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&tokens))
+
+	k, err := c.keys(context.Background())
+	assert.NoError(t, err)
+	id, err := k.verify(tokens["id_token"].(string), useID, "grafana", time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, "http://home-cloud.local/oidc", id.Issuer)
+	assert.Equal(t, "jane", id.Subject)
+	assert.Equal(t, "abc", id.Nonce)
+	assert.Equal(t, "jane@example.com", id.Email)
+
+	// codes can only be exchanged once
+	assert.Equal(t, http.StatusBadRequest, exchange("secret", verifier).Code)
+
+	// the access token gets the user info
+	req = httptest.NewRequest(http.MethodGet, "http://home-cloud.local/oidc/userinfo", nil)
+	req.Header.Set("Authorization", "Bearer "+tokens["access_token"].(string))
+	resp = do(c, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	info := map[string]string{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&info))
+	assert.Equal(t, map[string]string{"sub": "jane", "preferred_username": "jane", "name": "jane", "email": "jane@example.com"}, info)
+}
+
+func TestSignedIn(t *testing.T) {
+	c := newTestController(t)
+	ctx := context.Background()
+
+	_, err := c.SignedIn(ctx, http.Header{})
+	assert.ErrorIs(t, err, ErrNotSignedIn)
+
+	resp := do(c, loginRequest("jane", "password", "/"))
+	assert.Equal(t, http.StatusFound, resp.Code)
+	req := httptest.NewRequest(http.MethodGet, "http://home-cloud.local/", nil)
+	req.AddCookie(resp.Result().Cookies()[0])
+	user, err := c.SignedIn(ctx, req.Header)
+	assert.NoError(t, err)
+	assert.Equal(t, "jane", user)
+
+	// sessions of deleted accounts are no longer valid
+	assert.NoError(t, c.SaveAccount(ctx, testLogger{}, "jane", &v1.SaveAccountRequest{Username: "bob", Password: "bob-password", Admin: true}))
+	assert.NoError(t, c.DeleteAccount(ctx, testLogger{}, "bob", &v1.DeleteAccountRequest{Username: "jane"}))
+	_, err = c.SignedIn(ctx, req.Header)
+	assert.ErrorIs(t, err, ErrNotSignedIn)
+}
+
+func TestAccounts(t *testing.T) {
+	ctx := context.Background()
+	logger := testLogger{}
+	c := newTestController(t)
+
+	// accounts can only be managed once signed in
+	_, err := c.ListAccounts(ctx, logger, "")
+	assert.ErrorIs(t, err, ErrNotSignedIn)
+	err = c.SaveAccount(ctx, logger, "", &v1.SaveAccountRequest{Username: "bob", Password: "password"})
+	assert.ErrorIs(t, err, ErrNotSignedIn)
+	err = c.DeleteAccount(ctx, logger, "", &v1.DeleteAccountRequest{Username: "jane"})
+	assert.ErrorIs(t, err, ErrNotSignedIn)
+
+	// admins create accounts
+	err = c.SaveAccount(ctx, logger, "jane", &v1.SaveAccountRequest{Username: "bob", Password: "bob-password"})
+	assert.NoError(t, err)
+	accounts, err := c.ListAccounts(ctx, logger, "jane")
+	assert.NoError(t, err)
+	assert.Equal(t, []*v1.Account{
+		{Username: "bob"},
+		{Username: "jane", Email: "jane@example.com", Admin: true},
+	}, accounts)
+
+	// other users can't create accounts or change any but their own
+	err = c.SaveAccount(ctx, logger, "bob", &v1.SaveAccountRequest{Username: "eve", Password: "eve-password"})
+	assert.ErrorIs(t, err, ErrForbidden)
+	err = c.SaveAccount(ctx, logger, "bob", &v1.SaveAccountRequest{Username: "jane", Password: "new-password", CurrentPassword: "password"})
+	assert.ErrorIs(t, err, ErrForbidden)
+	err = c.DeleteAccount(ctx, logger, "bob", &v1.DeleteAccountRequest{Username: "jane", CurrentPassword: "password"})
+	assert.ErrorIs(t, err, ErrForbidden)
+
+	// and need the current password to change their own
+	err = c.SaveAccount(ctx, logger, "bob", &v1.SaveAccountRequest{Username: "bob", Password: "new-password"})
+	assert.ErrorIs(t, err, ErrForbidden)
+	err = c.SaveAccount(ctx, logger, "bob", &v1.SaveAccountRequest{Username: "bob", Password: "new-password", CurrentPassword: "bob-password", Admin: true})
+	assert.NoError(t, err)
+	_, err = c.authenticate(ctx, "bob", "new-password")
+	assert.NoError(t, err)
+
+	// only admins can make admins
+	accounts, err = c.ListAccounts(ctx, logger, "bob")
+	assert.NoError(t, err)
+	assert.False(t, accounts[0].Admin)
+
+	// the last admin can't be demoted or deleted
+	err = c.SaveAccount(ctx, logger, "jane", &v1.SaveAccountRequest{Username: "jane", Email: "jane@example.com"})
+	assert.ErrorIs(t, err, ErrLastAdmin)
+	err = c.DeleteAccount(ctx, logger, "jane", &v1.DeleteAccountRequest{Username: "jane"})
+	assert.ErrorIs(t, err, ErrLastAdmin)
+
+	// admins can change any account
+	err = c.SaveAccount(ctx, logger, "jane", &v1.SaveAccountRequest{Username: "bob", Email: "bob@example.org", Admin: true})
+	assert.NoError(t, err)
+	err = c.DeleteAccount(ctx, logger, "bob", &v1.DeleteAccountRequest{Username: "jane"})
+	assert.NoError(t, err)
+	accounts, err = c.ListAccounts(ctx, logger, "bob")
+	assert.NoError(t, err)
+	assert.Equal(t, []*v1.Account{{Username: "bob", Email: "bob@example.org", Admin: true}}, accounts)
+}
+
+func TestFirstAccount(t *testing.T) {
+	ctx := context.Background()
+	c := newTestController(t)
+	err := c.k8sclient.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: opv1.AccountsSecretName, Namespace: k8sclient.DefaultHomeCloudNamespace}})
+	assert.NoError(t, err)
+
+	// the first account is created without signing in and is an admin
+	err = c.SaveAccount(ctx, testLogger{}, "", &v1.SaveAccountRequest{Username: "jane", Password: "password"})
+	assert.NoError(t, err)
+	accounts, err := c.ListAccounts(ctx, testLogger{}, "jane")
+	assert.NoError(t, err)
+	assert.Equal(t, []*v1.Account{{Username: "jane", Admin: true}}, accounts)
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	clientSecret := func(namespace string, labels map[string]string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "grafana-oidc", Namespace: namespace, Labels: labels},
+			Data:       map[string][]byte{opv1.OIDCClientIDKey: []byte("grafana")},
+		}
+	}
+	appLabels := map[string]string{
+		opv1.OIDCClientLabel:   "true",
+		opv1.AppLabel:          "grafana",
+		opv1.AppNamespaceLabel: k8sclient.DefaultHomeCloudNamespace,
+	}
+
+	// the client Secret of the App is found
+	c := newTestController(t)
+	oc, err := c.client(ctx, "grafana")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"https://grafana.local/login/generic_oauth"}, oc.RedirectURIs)
+
+	// Secrets outside of the namespace of their App or without an App are ignored
+	for _, secret := range []*corev1.Secret{
+		clientSecret("other", appLabels),
+		clientSecret("other", map[string]string{opv1.OIDCClientLabel: "true"}),
+	} {
+		c = newTestController(t)
+		assert.NoError(t, c.k8sclient.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "grafana-oidc", Namespace: "grafana"}}))
+		assert.NoError(t, c.k8sclient.Create(ctx, secret))
+		_, err = c.client(ctx, "grafana")
+		assert.ErrorIs(t, err, ErrClientNotFound)
+	}
+
+	// client IDs registered more than once are rejected
+	c = newTestController(t)
+	duplicate := clientSecret("grafana", appLabels)
+	duplicate.Name = "grafana-oidc-copy"
+	assert.NoError(t, c.k8sclient.Create(ctx, duplicate))
+	_, err = c.client(ctx, "grafana")
+	assert.ErrorIs(t, err, ErrClientNotFound)
+}
+
+// HELPERS
+
+// testClient adapts the fake controller-runtime client to the k8sclient.Default interface
+type testClient struct {
+	crclient.Client
+}
+
+func (c testClient) Create(ctx context.Context, obj crclient.Object) error {
+	return c.Client.Create(ctx, obj)
+}
+
+func (c testClient) Get(ctx context.Context, key crclient.ObjectKey, obj crclient.Object) error {
+	return c.Client.Get(ctx, key, obj)
+}
+
+func (c testClient) Update(ctx context.Context, obj crclient.Object) error {
+	return c.Client.Update(ctx, obj)
+}
+
+func (c testClient) Delete(ctx context.Context, obj crclient.Object) error {
+	return c.Client.Delete(ctx, obj)
+}
+
+func (c testClient) Settings(ctx context.Context) (*opv1.SettingsSpec, error) {
+	install := &opv1.Install{}
+	err := c.Client.Get(ctx, types.NamespacedName{Namespace: k8sclient.DefaultHomeCloudNamespace, Name: "install"}, install)
+	if err != nil {
+		return nil, err
+	}
+	return install.Spec.Settings, nil
+}
+
+// testLogger discards the logs of the controller
+type testLogger struct {
+	chassis.Logger
+}
+
+func (l testLogger) WithError(err error) chassis.Logger       { return l }
+func (l testLogger) WithField(k string, v any) chassis.Logger { return l }
+func (l testLogger) Info(msg string)                          {}
+func (l testLogger) Warn(msg string)                          {}
+func (l testLogger) Error(msg string)                         {}
+
+func newTestController(t *testing.T) *controller {
+	hash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	assert.NoError(t, err)
+	jane, err := json.Marshal(account{PasswordHash: string(hash), Email: "jane@example.com", Admin: true})
+	assert.NoError(t, err)
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = opv1.AddToScheme(scheme)
+	_ = gwv1.Install(scheme)
+	objs := []crclient.Object{
+		&opv1.Install{
+			ObjectMeta: metav1.ObjectMeta{Name: "install", Namespace: k8sclient.DefaultHomeCloudNamespace},
+			Spec: opv1.InstallSpec{
+				Settings: &opv1.SettingsSpec{Hostname: "home-cloud.local"},
+				TLS:      &opv1.TLSSpec{Disable: true},
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: opv1.AccountsSecretName, Namespace: k8sclient.DefaultHomeCloudNamespace},
+			Data:       map[string][]byte{"jane": jane},
+		},
+		&gwv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: "immich", Namespace: "immich", Labels: map[string]string{opv1.AppLabel: "immich"}},
+			Spec:       gwv1.HTTPRouteSpec{Hostnames: []gwv1.Hostname{"immich.local"}},
+		},
+		&opv1.App{
+			ObjectMeta: metav1.ObjectMeta{Name: "grafana", Namespace: k8sclient.DefaultHomeCloudNamespace},
+			Spec:       opv1.AppSpec{Release: "grafana"},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "grafana-oidc",
+				Namespace: "grafana",
+				Labels: map[string]string{
+					opv1.OIDCClientLabel:   "true",
+					opv1.AppLabel:          "grafana",
+					opv1.AppNamespaceLabel: k8sclient.DefaultHomeCloudNamespace,
+				},
+				Annotations: map[string]string{opv1.OIDCRedirectURIsAnnotation: "https://grafana.local/login/generic_oauth"},
+			},
+			Data: map[string][]byte{
+				opv1.OIDCClientIDKey:     []byte("grafana"),
+				opv1.OIDCClientSecretKey: []byte("secret"),
+			},
+		},
+	}
+	return &controller{
+		logger:    testLogger{},
+		k8sclient: testClient{fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()},
+	}
+}
+
+func loginRequest(username string, password string, rd string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "http://home-cloud.local/auth/login", strings.NewReader(url.Values{
+		"username": {username},
+		"password": {password},
+		"rd":       {rd},
+	}.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req
+}
+
+func do(c *controller, req *http.Request) *httptest.ResponseRecorder {
+	resp := httptest.NewRecorder()
+	c.ServeHTTP(resp, req)
+	return resp
+}
@@ -0,0 +1,417 @@
+package identity
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	opv1 "github.com/home-cloud-io/core/api/crds/v1"
+	"github.com/home-cloud-io/core/pkg/install/resources"
+)
+
+const (
+	codeDuration  = time.Minute
+	tokenDuration = time.Hour
+)
+
+var (
+	ErrClientNotFound = errors.New("client not found")
+)
+
+// oidcClient is an OIDC client registered through a Secret labelled with the OIDCClientLabel.
+type oidcClient struct {
+	ID           string
+	Secret       string
+	RedirectURIs []string
+}
+
+// discovery serves the OpenID Provider Metadata.
+func (c *controller) discovery(w http.ResponseWriter, r *http.Request) {
+	issuer, err := c.issuer(r.Context())
+	if err != nil {
+		c.serverError(w, err, "failed to get issuer")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                issuer,
+		"authorization_endpoint":                issuer + "/authorize",
+		"token_endpoint":                        issuer + "/token",
+		"userinfo_endpoint":                     issuer + "/userinfo",
+		"jwks_uri":                              issuer + "/keys",
+		"end_session_endpoint":                  strings.TrimSuffix(issuer, "/oidc") + logoutPath,
+		"response_types_supported":              []string{"code"},
+		"grant_types_supported":                 []string{"authorization_code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"scopes_supported":                      []string{"openid", "profile", "email"},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post"},
+		"code_challenge_methods_supported":      []string{"S256", "plain"},
+		"claims_supported":                      []string{"sub", "iss", "aud", "exp", "iat", "nonce", "preferred_username", "name", "email"},
+	})
+}
+
+// authorize issues an authorization code to the client for the signed in user (signing them in first if
+// needed).
+func (c *controller) authorize(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	oc, err := c.client(ctx, query.Get("client_id"))
+	if errors.Is(err, ErrClientNotFound) {
+		http.Error(w, "unknown client", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		c.serverError(w, err, "failed to get oidc client")
+		return
+	}
+	redirectURI := query.Get("redirect_uri")
+	if !slices.Contains(oc.RedirectURIs, redirectURI) {
+		// never redirect to an unregistered URI
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+	if query.Get("response_type") != "code" {
+		redirectWithParams(w, r, redirectURI, url.Values{"error": {"unsupported_response_type"}, "state": {query.Get("state")}})
+		return
+	}
+
+	k, err := c.keys(ctx)
+	if err != nil {
+		c.serverError(w, err, "failed to get identity keys")
+		return
+	}
+	session, err := c.session(r, k, hostname(r.Host))
+	if err != nil {
+		// come back here once signed in
+		http.Redirect(w, r, loginPath+"?"+url.Values{"rd": {r.URL.RequestURI()}}.Encode(), http.StatusFound)
+		return
+	}
+
+	id, err := randomID()
+	if err != nil {
+		c.serverError(w, err, "failed to generate code")
+		return
+	}
+	code, err := k.sign(claims{
+		ID:                  id,
+		Subject:             session.Subject,
+		Audience:            oc.ID,
+		Expiry:              time.Now().Add(codeDuration).Unix(),
+		Use:                 useCode,
+		Nonce:               query.Get("nonce"),
+		Scope:               query.Get("scope"),
+		RedirectURI:         redirectURI,
+		CodeChallenge:       query.Get("code_challenge"),
+		CodeChallengeMethod: query.Get("code_challenge_method"),
+	})
+	if err != nil {
+		c.serverError(w, err, "failed to sign code")
+		return
+	}
+	redirectWithParams(w, r, redirectURI, url.Values{"code": {code}, "state": {query.Get("state")}})
+}
+
+// token exchanges an authorization code for an ID and access token.
+func (c *controller) token(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if r.PostFormValue("grant_type") != "authorization_code" {
+		tokenError(w, http.StatusBadRequest, "unsupported_grant_type")
+		return
+	}
+
+	// authenticate the client
+	clientID, clientSecret, ok := r.BasicAuth()
+	if ok {
+		// client credentials are form encoded before being put in the header
+		clientID, _ = url.QueryUnescape(clientID)
+		clientSecret, _ = url.QueryUnescape(clientSecret)
+	} else {
+		clientID, clientSecret = r.PostFormValue("client_id"), r.PostFormValue("client_secret")
+	}
+	oc, err := c.client(ctx, clientID)
+	if err != nil && !errors.Is(err, ErrClientNotFound) {
+		c.serverError(w, err, "failed to get oidc client")
+		return
+	}
+	if err != nil || subtle.ConstantTimeCompare([]byte(oc.Secret), []byte(clientSecret)) != 1 {
+		tokenError(w, http.StatusUnauthorized, "invalid_client")
+		return
+	}
+
+	k, err := c.keys(ctx)
+	if err != nil {
+		c.serverError(w, err, "failed to get identity keys")
+		return
+	}
+	now := time.Now()
+	code, err := k.verify(r.PostFormValue("code"), useCode, oc.ID, now)
+	if err != nil || code.RedirectURI != r.PostFormValue("redirect_uri") ||
+		!verifyCodeChallenge(code, r.PostFormValue("code_verifier")) || !c.useCode(code, now) {
+		tokenError(w, http.StatusBadRequest, "invalid_grant")
+		return
+	}
+
+	a, err := c.account(ctx, code.Subject)
+	if err != nil {
+		tokenError(w, http.StatusBadRequest, "invalid_grant")
+		return
+	}
+	issuer, err := c.issuer(ctx)
+	if err != nil {
+		c.serverError(w, err, "failed to get issuer")
+		return
+	}
+	access, err := k.sign(claims{
+		Issuer:   issuer,
+		Subject:  code.Subject,
+		Audience: oc.ID,
+		Expiry:   now.Add(tokenDuration).Unix(),
+		IssuedAt: now.Unix(),
+		Use:      useAccess,
+		Scope:    code.Scope,
+	})
+	if err != nil {
+		c.serverError(w, err, "failed to sign access token")
+		return
+	}
+	id := claims{
+		Issuer:            issuer,
+		Subject:           code.Subject,
+		Audience:          oc.ID,
+		Expiry:            now.Add(tokenDuration).Unix(),
+		IssuedAt:          now.Unix(),
+		Use:               useID,
+		Nonce:             code.Nonce,
+		PreferredUsername: code.Subject,
+	}
+	if slices.Contains(strings.Fields(code.Scope), "email") {
+		id.Email = a.Email
+	}
+	idToken, err := k.sign(id)
+	if err != nil {
+		c.serverError(w, err, "failed to sign id token")
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": access,
+		"token_type":   "Bearer",
+		"expires_in":   int(tokenDuration.Seconds()),
+		"id_token":     idToken,
+		"scope":        code.Scope,
+	})
+}
+
+// userInfo returns the claims of the user the access token was issued for.
+func (c *controller) userInfo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	bearer, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	k, err := c.keys(ctx)
+	if err != nil {
+		c.serverError(w, err, "failed to get identity keys")
+		return
+	}
+	// access tokens are verified by their signature and issuer only as their audience is the client
+	access, err := decodeAccessToken(k, bearer, time.Now())
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+	a, err := c.account(ctx, access.Subject)
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	info := map[string]string{
+		"sub":                access.Subject,
+		"preferred_username": access.Subject,
+		"name":               access.Subject,
+	}
+	if slices.Contains(strings.Fields(access.Scope), "email") && a.Email != "" {
+		info["email"] = a.Email
+	}
+	writeJSON(w, http.StatusOK, info)
+}
+
+// jwks serves the keys which ID and access tokens are signed with.
+func (c *controller) jwks(w http.ResponseWriter, r *http.Request) {
+	k, err := c.keys(r.Context())
+	if err != nil {
+		c.serverError(w, err, "failed to get identity keys")
+		return
+	}
+	writeJSON(w, http.StatusOK, k.jwks())
+}
+
+// issuer returns the issuer URL of the identity provider.
+func (c *controller) issuer(ctx context.Context) (string, error) {
+	install, err := c.install(ctx)
+	if err != nil {
+		return "", err
+	}
+	return resources.IssuerURL(install), nil
+}
+
+// client returns the registered OIDC client with the given ID. Only client Secrets created by the
+// operator are accepted: Secrets labelled with their App in the namespace of that App. A client ID
+// registered more than once is rejected rather than guessing which Secret is meant.
+func (c *controller) client(ctx context.Context, id string) (*oidcClient, error) {
+	if id == "" {
+		return nil, ErrClientNotFound
+	}
+	secrets := &corev1.SecretList{}
+	err := c.k8sclient.List(ctx, secrets, client.HasLabels{opv1.OIDCClientLabel, opv1.AppLabel})
+	if err != nil {
+		return nil, err
+	}
+	var oc *oidcClient
+	for _, secret := range secrets.Items {
+		if string(secret.Data[opv1.OIDCClientIDKey]) != id {
+			continue
+		}
+		owned, err := c.ownedByApp(ctx, &secret)
+		if err != nil {
+			return nil, err
+		}
+		if !owned {
+			c.logger.WithField("secret", secret.Namespace+"/"+secret.Name).Warn("ignoring OIDC client secret outside of its app namespace")
+			continue
+		}
+		if oc != nil {
+			return nil, fmt.Errorf("%w: client %s is registered more than once", ErrClientNotFound, id)
+		}
+		oc = &oidcClient{
+			ID:     id,
+			Secret: string(secret.Data[opv1.OIDCClientSecretKey]),
+		}
+		for _, uri := range strings.Split(secret.Annotations[opv1.OIDCRedirectURIsAnnotation], ",") {
+			if uri = strings.TrimSpace(uri); uri != "" {
+				oc.RedirectURIs = append(oc.RedirectURIs, uri)
+			}
+		}
+	}
+	if oc == nil {
+		return nil, ErrClientNotFound
+	}
+	return oc, nil
+}
+
+// ownedByApp reports whether the Secret is in the namespace of the App it is labelled with.
+func (c *controller) ownedByApp(ctx context.Context, secret *corev1.Secret) (bool, error) {
+	if secret.Labels[opv1.AppNamespaceLabel] == "" {
+		return false, nil
+	}
+	app := &opv1.App{}
+	err := c.k8sclient.Get(ctx, types.NamespacedName{
+		Name:      secret.Labels[opv1.AppLabel],
+		Namespace: secret.Labels[opv1.AppNamespaceLabel],
+	}, app)
+	if kerrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return app.Spec.Release == secret.Namespace, nil
+}
+
+// useCode marks the authorization code as exchanged and reports whether it hadn't been already.
+func (c *controller) useCode(code *claims, now time.Time) bool {
+	// forget codes which have expired anyway
+	c.usedCodes.Range(func(id, expiry any) bool {
+		if now.Unix() >= expiry.(int64) {
+			c.usedCodes.Delete(id)
+		}
+		return true
+	})
+	_, used := c.usedCodes.LoadOrStore(code.ID, code.Expiry)
+	return !used
+}
+
+// verifyCodeChallenge checks the PKCE code verifier against the challenge of the code (if any).
+func verifyCodeChallenge(code *claims, verifier string) bool {
+	switch code.CodeChallengeMethod {
+	case "":
+		if code.CodeChallenge == "" {
+			return true
+		}
+		return verifier == code.CodeChallenge
+	case "plain":
+		return verifier == code.CodeChallenge
+	case "S256":
+		sum := sha256.Sum256([]byte(verifier))
+		return base64.RawURLEncoding.EncodeToString(sum[:]) == code.CodeChallenge
+	default:
+		return false
+	}
+}
+
+// decodeAccessToken verifies the access token without checking its audience.
+func decodeAccessToken(k *keys, token string, now time.Time) (*claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+	c := &claims{}
+	err := decodeSegment(parts[1], c)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	return k.verify(token, useAccess, c.Audience, now)
+}
+
+func redirectWithParams(w http.ResponseWriter, r *http.Request, uri string, params url.Values) {
+	target, err := url.Parse(uri)
+	if err != nil {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+	query := target.Query()
+	for k, v := range params {
+		if len(v) > 0 && v[0] != "" {
+			query[k] = v
+		}
+	}
+	target.RawQuery = query.Encode()
+	http.Redirect(w, r, target.String(), http.StatusFound)
+}
+
+func tokenError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, status, map[string]string{"error": code})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
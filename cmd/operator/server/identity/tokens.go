@@ -0,0 +1,282 @@
+package identity
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	opv1 "github.com/home-cloud-io/core/api/crds/v1"
+	k8sclient "github.com/home-cloud-io/core/cmd/operator/server/k8s-client"
+)
+
+const (
+	// tokens signed with the session key (HS256) are only read by the identity provider itself
+	useSession = "session"
+	useTicket  = "ticket"
+	useCode    = "code"
+	// tokens signed with the signing key (RS256) are read by OIDC clients
+	useAccess = "access"
+	useID     = "id"
+
+	sessionKeyName = "session.key"
+	signingKeyName = "signing.key"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid token")
+	ErrExpiredToken = errors.New("expired token")
+)
+
+type (
+	// keys are the signing keys of the identity provider
+	keys struct {
+		// session signs sessions, tickets and authorization codes
+		session []byte
+		// signing signs ID and access tokens
+		signing *rsa.PrivateKey
+		keyID   string
+	}
+
+	// claims of all tokens issued by the identity provider where Use tells what the token is for
+	claims struct {
+		ID                string `json:"jti,omitempty"`
+		Issuer            string `json:"iss,omitempty"`
+		Subject           string `json:"sub"`
+		Audience          string `json:"aud,omitempty"`
+		Expiry            int64  `json:"exp"`
+		IssuedAt          int64  `json:"iat,omitempty"`
+		Use               string `json:"use,omitempty"`
+		Nonce             string `json:"nonce,omitempty"`
+		Scope             string `json:"scope,omitempty"`
+		Email             string `json:"email,omitempty"`
+		PreferredUsername string `json:"preferred_username,omitempty"`
+		// authorization codes remember the request they were issued for
+		RedirectURI         string `json:"redirect_uri,omitempty"`
+		CodeChallenge       string `json:"code_challenge,omitempty"`
+		CodeChallengeMethod string `json:"code_challenge_method,omitempty"`
+	}
+
+	header struct {
+		Algorithm string `json:"alg"`
+		Type      string `json:"typ"`
+		KeyID     string `json:"kid,omitempty"`
+	}
+)
+
+// keys returns the signing keys of the identity provider, creating them if they don't exist yet.
+func (c *controller) keys(ctx context.Context) (*keys, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cachedKeys != nil {
+		return c.cachedKeys, nil
+	}
+
+	secret := &corev1.Secret{}
+	err := c.k8sclient.Get(ctx, types.NamespacedName{
+		Name:      opv1.IdentitySecretName,
+		Namespace: k8sclient.DefaultHomeCloudNamespace,
+	}, secret)
+	if kerrors.IsNotFound(err) {
+		secret, err = newKeysSecret()
+		if err != nil {
+			return nil, err
+		}
+		err = c.k8sclient.Create(ctx, secret)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := parseKeys(secret)
+	if err != nil {
+		return nil, err
+	}
+	c.cachedKeys = k
+	return k, nil
+}
+
+func newKeysSecret() (*corev1.Secret, error) {
+	session := make([]byte, 32)
+	_, err := rand.Read(session)
+	if err != nil {
+		return nil, err
+	}
+	signing, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      opv1.IdentitySecretName,
+			Namespace: k8sclient.DefaultHomeCloudNamespace,
+		},
+		Data: map[string][]byte{
+			sessionKeyName: session,
+			signingKeyName: pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(signing)}),
+		},
+	}, nil
+}
+
+func parseKeys(secret *corev1.Secret) (*keys, error) {
+	if len(secret.Data[sessionKeyName]) < 32 {
+		return nil, fmt.Errorf("invalid session key in secret %s", secret.Name)
+	}
+	block, _ := pem.Decode(secret.Data[signingKeyName])
+	if block == nil {
+		return nil, fmt.Errorf("invalid signing key in secret %s", secret.Name)
+	}
+	signing, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalPKIXPublicKey(&signing.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(der)
+	return &keys{
+		session: secret.Data[sessionKeyName],
+		signing: signing,
+		keyID:   base64.RawURLEncoding.EncodeToString(sum[:12]),
+	}, nil
+}
+
+// sign returns the claims as a JWT: signed with the signing key for ID and access tokens and with the
+// session key for everything else.
+func (k *keys) sign(c claims) (string, error) {
+	h := header{Algorithm: "HS256", Type: "JWT"}
+	if signedWithKey(c.Use) {
+		h = header{Algorithm: "RS256", Type: "JWT", KeyID: k.keyID}
+	}
+	encodedHeader, err := encodeSegment(h)
+	if err != nil {
+		return "", err
+	}
+	encodedClaims, err := encodeSegment(c)
+	if err != nil {
+		return "", err
+	}
+	input := encodedHeader + "." + encodedClaims
+
+	var signature []byte
+	if signedWithKey(c.Use) {
+		sum := sha256.Sum256([]byte(input))
+		signature, err = rsa.SignPKCS1v15(rand.Reader, k.signing, crypto.SHA256, sum[:])
+		if err != nil {
+			return "", err
+		}
+	} else {
+		mac := hmac.New(sha256.New, k.session)
+		mac.Write([]byte(input))
+		signature = mac.Sum(nil)
+	}
+	return input + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// verify checks the signature and expiry of the token and that it was issued for the given use and
+// audience.
+func (k *keys) verify(token string, use string, audience string, now time.Time) (*claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	h := header{}
+	err = decodeSegment(parts[0], &h)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	input := parts[0] + "." + parts[1]
+	if signedWithKey(use) {
+		sum := sha256.Sum256([]byte(input))
+		if h.Algorithm != "RS256" || rsa.VerifyPKCS1v15(&k.signing.PublicKey, crypto.SHA256, sum[:], signature) != nil {
+			return nil, ErrInvalidToken
+		}
+	} else {
+		mac := hmac.New(sha256.New, k.session)
+		mac.Write([]byte(input))
+		if h.Algorithm != "HS256" || !hmac.Equal(signature, mac.Sum(nil)) {
+			return nil, ErrInvalidToken
+		}
+	}
+
+	c := &claims{}
+	err = decodeSegment(parts[1], c)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	if c.Use != use || c.Audience != audience {
+		return nil, ErrInvalidToken
+	}
+	if now.Unix() >= c.Expiry {
+		return nil, ErrExpiredToken
+	}
+	return c, nil
+}
+
+// jwks returns the JSON Web Key Set which OIDC clients verify tokens with.
+func (k *keys) jwks() map[string]any {
+	return map[string]any{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"use": "sig",
+				"alg": "RS256",
+				"kid": k.keyID,
+				"n":   base64.RawURLEncoding.EncodeToString(k.signing.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.signing.E)).Bytes()),
+			},
+		},
+	}
+}
+
+func signedWithKey(use string) bool {
+	return use == useAccess || use == useID
+}
+
+func encodeSegment(v any) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeSegment(segment string, v any) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// randomID returns a random URL safe identifier.
+func randomID() (string, error) {
+	b := make([]byte, 16)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
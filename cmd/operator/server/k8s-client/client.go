@@ -16,10 +16,12 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
 	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	opv1 "github.com/home-cloud-io/core/api/crds/v1"
 	dv1 "github.com/home-cloud-io/core/api/platform/daemon/v1"
 	webv1 "github.com/home-cloud-io/core/api/platform/server/v1"
+	capps "github.com/home-cloud-io/core/cmd/operator/controller/apps"
 )
 
 type (
@@ -33,6 +35,7 @@ type (
 		Get(ctx context.Context, key crclient.ObjectKey, obj crclient.Object) error
 		Update(ctx context.Context, obj crclient.Object) error
 		Delete(ctx context.Context, obj crclient.Object) error
+		List(ctx context.Context, list crclient.ObjectList, opts ...crclient.ListOption) error
 		// Settings returns the installation settings
 		Settings(ctx context.Context) (*opv1.SettingsSpec, error)
 	}
@@ -57,6 +60,13 @@ type (
 		InstalledApps(ctx context.Context) ([]opv1.App, error)
 		// AppStorage will retrieve storage volumes for the given app list
 		AppStorage(ctx context.Context, apps []opv1.App) ([]*webv1.AppStorage, error)
+		// AppBackups will retrieve all backups of the app with the given name
+		AppBackups(ctx context.Context, name string) ([]opv1.Backup, error)
+		// RestoreApp will restore the app with the given name from the given backup
+		RestoreApp(ctx context.Context, name string, backup string) (*opv1.Restore, error)
+		// PreviewApp will return the changes installing or updating the app to the given spec would make
+		// without applying them
+		PreviewApp(ctx context.Context, spec opv1.AppSpec) (*capps.AppPreview, error)
 	}
 	// System operates on system components (logs, versions)
 	System interface {
@@ -95,6 +105,7 @@ func NewClient(logger chassis.Logger) Client {
 		panic(err)
 	} else {
 		opv1.AddToScheme(c.Scheme())
+		gwv1.Install(c.Scheme())
 	}
 
 	cs, err := kubernetes.NewForConfig(kubeConfig)
@@ -132,6 +143,10 @@ func (c *client) Delete(ctx context.Context, obj crclient.Object) error {
 	return c.client.Delete(ctx, obj)
 }
 
+func (c *client) List(ctx context.Context, list crclient.ObjectList, opts ...crclient.ListOption) error {
+	return c.client.List(ctx, list, opts...)
+}
+
 func (c *client) InstallApp(ctx context.Context, spec opv1.AppSpec) error {
 	app := &opv1.App{
 		ObjectMeta: metav1.ObjectMeta{
@@ -163,10 +178,42 @@ func (c *client) UpdateApp(ctx context.Context, spec opv1.AppSpec) error {
 	if err != nil {
 		return err
 	}
-	app.Spec = spec
+	// only replace the fields managed through the server so that settings configured directly on
+	// the App (e.g. the purge policy) are kept
+	app.Spec.Chart = spec.Chart
+	app.Spec.Repo = spec.Repo
+	app.Spec.Release = spec.Release
+	app.Spec.Values = spec.Values
+	app.Spec.Version = spec.Version
 	return c.client.Update(ctx, app)
 }
 
+func (c *client) PreviewApp(ctx context.Context, spec opv1.AppSpec) (*capps.AppPreview, error) {
+	app := &opv1.App{}
+	err := c.client.Get(ctx, types.NamespacedName{
+		Name:      spec.Release,
+		Namespace: DefaultHomeCloudNamespace,
+	}, app)
+	if crclient.IgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	if err != nil {
+		app = &opv1.App{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      spec.Release,
+				Namespace: DefaultHomeCloudNamespace,
+			},
+		}
+	}
+	// the same fields as UpdateApp so that the preview matches the update
+	app.Spec.Chart = spec.Chart
+	app.Spec.Repo = spec.Repo
+	app.Spec.Release = spec.Release
+	app.Spec.Values = spec.Values
+	app.Spec.Version = spec.Version
+	return capps.Preview(ctx, c.client, app)
+}
+
 func (c *client) Healthcheck(ctx context.Context) ([]*webv1.AppHealth, error) {
 
 	// get all installed apps
@@ -184,6 +231,14 @@ func (c *client) Healthcheck(ctx context.Context) ([]*webv1.AppHealth, error) {
 		checks[index] = &webv1.AppHealth{
 			Name:   app.Name,
 			Status: webv1.AppStatus_APP_STATUS_HEALTHY,
+			Phase:  string(app.Status.Phase),
+			Error:  app.Status.Error,
+		}
+
+		// a failed reconcile means the app is unhealthy regardless of its pods
+		if app.Status.Phase == opv1.AppPhaseFailed {
+			checks[index].Status = webv1.AppStatus_APP_STATUS_UNHEALTHY
+			continue
 		}
 
 		// get all pods in app namespace
@@ -201,22 +256,9 @@ func (c *client) Healthcheck(ctx context.Context) ([]*webv1.AppHealth, error) {
 			continue
 		}
 
-		for _, pod := range pods.Items {
-			ready := false
-			// look through all conditions for the PodReady condition
-			for _, condition := range pod.Status.Conditions {
-				if condition.Type == corev1.PodReady {
-					if condition.Status == corev1.ConditionTrue {
-						ready = true
-					}
-					break
-				}
-			}
-			// if any pod isn't ready, mark app as unhealthy and break
-			if !ready {
-				checks[index].Status = webv1.AppStatus_APP_STATUS_UNHEALTHY
-				break
-			}
+		// the same check as upgrades are rolled out with
+		if !capps.PodsReady(pods.Items) {
+			checks[index].Status = webv1.AppStatus_APP_STATUS_UNHEALTHY
 		}
 	}
 
@@ -283,6 +325,42 @@ func (c *client) AppStorage(ctx context.Context, apps []opv1.App) ([]*webv1.AppS
 	return storage, nil
 }
 
+func (c *client) AppBackups(ctx context.Context, name string) ([]opv1.Backup, error) {
+	backups := &opv1.BackupList{}
+	err := c.client.List(ctx, backups, &crclient.ListOptions{
+		Namespace: DefaultHomeCloudNamespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	appBackups := []opv1.Backup{}
+	for _, backup := range backups.Items {
+		if backup.Spec.App == name {
+			appBackups = append(appBackups, backup)
+		}
+	}
+
+	return appBackups, nil
+}
+
+func (c *client) RestoreApp(ctx context.Context, name string, backup string) (*opv1.Restore, error) {
+	restore := &opv1.Restore{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: name + "-restore-",
+			Namespace:    DefaultHomeCloudNamespace,
+		},
+		Spec: opv1.RestoreSpec{
+			Backup: backup,
+		},
+	}
+	err := c.client.Create(ctx, restore)
+	if err != nil {
+		return nil, err
+	}
+	return restore, nil
+}
+
 func (c *client) GetServerVersion(ctx context.Context) (string, error) {
 	version, err := c.discoveryClient.ServerVersion()
 	if err != nil {
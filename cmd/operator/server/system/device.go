@@ -18,6 +18,7 @@ import (
 	v1 "github.com/home-cloud-io/core/api/platform/server/v1"
 	"github.com/home-cloud-io/core/cmd/operator/server/apps"
 	k8sclient "github.com/home-cloud-io/core/cmd/operator/server/k8s-client"
+	"github.com/home-cloud-io/core/pkg/install/bundle"
 	hstrings "github.com/home-cloud-io/core/pkg/strings"
 )
 
@@ -34,6 +35,8 @@ type (
 		Update(ctx context.Context, logger chassis.Logger) error
 		// GetComponentVersions returns all the versions of system components (server, daemon, etc.)
 		GetComponentVersions(ctx context.Context, logger chassis.Logger) (*v1.GetComponentVersionsResponse, error)
+		// GetCACertificate returns the PEM encoded root certificate of the local CA
+		GetCACertificate(ctx context.Context, logger chassis.Logger) (string, error)
 	}
 )
 
@@ -45,6 +48,7 @@ const (
 	ErrFailedToCreateSettings = "failed to create device settings"
 	ErrFailedToGetSettings    = "failed to get device settings"
 	ErrFailedToSetSettings    = "failed to save device settings"
+	ErrFailedToGetCA          = "failed to get ca certificate"
 )
 
 // DEVICE
@@ -210,6 +214,20 @@ func (c *controller) Update(ctx context.Context, logger chassis.Logger) error {
 		return err
 	}
 
+	// offline installs are upgraded to the release of their bundle once it is replaced
+	if b := bundle.Of(install); b != nil {
+		latest, err := b.Manifest()
+		if err != nil {
+			logger.WithError(err).Error("failed to read bundle release manifest")
+			return err
+		}
+		if install.Spec.Version == latest.Version {
+			return nil
+		}
+		install.Spec.Version = latest.Version
+		return c.k8sclient.Update(ctx, install)
+	}
+
 	// get version manifest from repo
 	resp, err := http.Get(LatestReleaseManifestURL)
 	if err != nil {
@@ -318,3 +336,16 @@ func (c *controller) GetComponentVersions(ctx context.Context, logger chassis.Lo
 
 	return resp, nil
 }
+
+func (c *controller) GetCACertificate(ctx context.Context, logger chassis.Logger) (string, error) {
+	secret := &corev1.Secret{}
+	err := c.k8sclient.Get(ctx, types.NamespacedName{
+		Namespace: k8sclient.DefaultHomeCloudNamespace,
+		Name:      opv1.CASecretName,
+	}, secret)
+	if err != nil {
+		logger.WithError(err).Error("failed to get ca secret")
+		return "", err
+	}
+	return string(secret.Data[corev1.TLSCertKey]), nil
+}
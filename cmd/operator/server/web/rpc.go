@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -12,9 +13,11 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	opv1 "github.com/home-cloud-io/core/api/crds/v1"
 	v1 "github.com/home-cloud-io/core/api/platform/server/v1"
 	sdConnect "github.com/home-cloud-io/core/api/platform/server/v1/v1connect"
 	"github.com/home-cloud-io/core/cmd/operator/server/apps"
+	"github.com/home-cloud-io/core/cmd/operator/server/identity"
 	"github.com/home-cloud-io/core/cmd/operator/server/system"
 )
 
@@ -28,6 +31,7 @@ type (
 		logger chassis.Logger
 		actl   apps.Controller
 		sctl   system.Controller
+		ictl   identity.Controller
 	}
 )
 
@@ -37,13 +41,17 @@ const (
 	ErrFailedToLogin            = "failed to login"
 	ErrFailedPeerRegistration   = "failed to register peer"
 	ErrFailedPeerDeregistration = "failed to deregister peer"
+
+	// restoreTimeout is how long restore progress is reported to clients for
+	restoreTimeout = 30 * time.Minute
 )
 
-func New(logger chassis.Logger, actl apps.Controller, sctl system.Controller) Rpc {
+func New(logger chassis.Logger, actl apps.Controller, sctl system.Controller, ictl identity.Controller) Rpc {
 	return &rpcHandler{
 		logger,
 		actl,
 		sctl,
+		ictl,
 	}
 }
 
@@ -51,6 +59,10 @@ func New(logger chassis.Logger, actl apps.Controller, sctl system.Controller) Rp
 func (h *rpcHandler) RegisterRPC(server chassis.Rpcer) {
 	pattern, handler := sdConnect.NewWebServiceHandler(h)
 	server.AddHandler(pattern, handler, true)
+
+	// the identity provider endpoints are plain http
+	server.AddHandler(identity.AuthPath, h.ictl, false)
+	server.AddHandler(identity.OIDCPath, h.ictl, false)
 }
 
 // APPS
@@ -197,6 +209,18 @@ func (h *rpcHandler) SetDeviceSettings(ctx context.Context, request *connect.Req
 	return connect.NewResponse(&v1.SetDeviceSettingsResponse{}), nil
 }
 
+func (h *rpcHandler) GetCACertificate(ctx context.Context, request *connect.Request[v1.GetCACertificateRequest]) (*connect.Response[v1.GetCACertificateResponse], error) {
+	h.logger.Info("getting ca certificate")
+
+	certificate, err := h.sctl.GetCACertificate(ctx, h.logger)
+	if err != nil {
+		h.logger.WithError(err).Error(system.ErrFailedToGetCA)
+		return nil, errors.New(system.ErrFailedToGetCA)
+	}
+
+	return connect.NewResponse(&v1.GetCACertificateResponse{Certificate: certificate}), nil
+}
+
 func (h *rpcHandler) GetAppStorage(ctx context.Context, request *connect.Request[v1.GetAppStorageRequest]) (*connect.Response[v1.GetAppStorageResponse], error) {
 	h.logger.Info("getting app storage")
 
@@ -209,6 +233,68 @@ func (h *rpcHandler) GetAppStorage(ctx context.Context, request *connect.Request
 	return connect.NewResponse(&v1.GetAppStorageResponse{Apps: appsStorage}), nil
 }
 
+func (h *rpcHandler) ListAppBackups(ctx context.Context, request *connect.Request[v1.ListAppBackupsRequest]) (*connect.Response[v1.ListAppBackupsResponse], error) {
+	h.logger.WithField("request", request.Msg).Info("list app backups request")
+
+	backups, err := h.actl.Backups(ctx, h.logger, request.Msg)
+	if err != nil {
+		h.logger.WithError(err).Error(apps.ErrFailedToGetAppBackups)
+		return nil, errors.New(apps.ErrFailedToGetAppBackups)
+	}
+
+	return connect.NewResponse(&v1.ListAppBackupsResponse{Backups: backups}), nil
+}
+
+func (h *rpcHandler) PreviewAppChange(ctx context.Context, request *connect.Request[v1.PreviewAppChangeRequest]) (*connect.Response[v1.PreviewAppChangeResponse], error) {
+	h.logger.WithField("request", request.Msg).Info("preview app change request")
+
+	preview, err := h.actl.Preview(ctx, h.logger, request.Msg)
+	if err != nil {
+		h.logger.WithError(err).Error(apps.ErrFailedToPreviewApp)
+		return nil, fmt.Errorf("%s: %s", apps.ErrFailedToPreviewApp, err.Error())
+	}
+
+	return connect.NewResponse(preview), nil
+}
+
+func (h *rpcHandler) RestoreApp(ctx context.Context, request *connect.Request[v1.RestoreAppRequest]) (*connect.Response[v1.RestoreAppResponse], error) {
+	h.logger.WithField("request", request.Msg).Info("restore app request")
+
+	restore, err := h.actl.Restore(ctx, h.logger, request.Msg)
+	if err != nil {
+		h.logger.WithError(err).Error(apps.ErrFailedToRestoreApp)
+		return nil, fmt.Errorf("%s: %s", apps.ErrFailedToRestoreApp, err.Error())
+	}
+
+	// report progress of the restore to subscribed clients
+	go func() {
+		c, cancel := context.WithTimeout(context.WithoutCancel(ctx), restoreTimeout)
+		defer cancel()
+		err := h.actl.WaitForRestore(c, h.logger, restore, func(status opv1.RestoreStatus) {
+			err := events.Send(&v1.ServerEvent{
+				Event: &v1.ServerEvent_AppRestore{
+					AppRestore: &v1.AppRestoreEvent{
+						AppName: request.Msg.AppName,
+						Restore: restore,
+						Phase:   string(status.Phase),
+						Error:   status.Error,
+					},
+				},
+			})
+			if err != nil {
+				h.logger.WithError(err).Error("failed to send app restore event to client")
+			}
+		})
+		if err != nil {
+			h.logger.WithError(err).Error("failed to restore app")
+			return
+		}
+		h.logger.Info("app finished restoring")
+	}()
+
+	return connect.NewResponse(&v1.RestoreAppResponse{Restore: restore}), nil
+}
+
 func (h *rpcHandler) EnableSecureTunnelling(ctx context.Context, request *connect.Request[v1.EnableSecureTunnellingRequest]) (*connect.Response[v1.EnableSecureTunnellingResponse], error) {
 	h.logger.Info("enabling secure tunnelling")
 
@@ -336,6 +422,83 @@ func (h *rpcHandler) GetSystemLogs(ctx context.Context, request *connect.Request
 	}), nil
 }
 
+// ACCOUNTS
+
+func (h *rpcHandler) ListAccounts(ctx context.Context, request *connect.Request[v1.ListAccountsRequest]) (*connect.Response[v1.ListAccountsResponse], error) {
+	h.logger.Info("listing accounts")
+
+	user, err := h.signedIn(ctx, request.Header())
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := h.ictl.ListAccounts(ctx, h.logger, user)
+	if err != nil {
+		h.logger.WithError(err).Error(identity.ErrFailedToListAccounts)
+		return nil, accountsError(identity.ErrFailedToListAccounts, err)
+	}
+
+	return connect.NewResponse(&v1.ListAccountsResponse{Accounts: accounts}), nil
+}
+
+func (h *rpcHandler) SaveAccount(ctx context.Context, request *connect.Request[v1.SaveAccountRequest]) (*connect.Response[v1.SaveAccountResponse], error) {
+	h.logger.WithField("username", request.Msg.Username).Info("saving account")
+
+	// the first account is created before anyone can sign in
+	user, err := h.signedIn(ctx, request.Header())
+	if err != nil && !errors.Is(err, identity.ErrNotSignedIn) {
+		return nil, err
+	}
+	err = h.ictl.SaveAccount(ctx, h.logger, user, request.Msg)
+	if err != nil {
+		h.logger.WithError(err).Error(identity.ErrFailedToSaveAccount)
+		return nil, accountsError(identity.ErrFailedToSaveAccount, err)
+	}
+
+	return connect.NewResponse(&v1.SaveAccountResponse{}), nil
+}
+
+func (h *rpcHandler) DeleteAccount(ctx context.Context, request *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error) {
+	h.logger.WithField("username", request.Msg.Username).Info("deleting account")
+
+	user, err := h.signedIn(ctx, request.Header())
+	if err != nil {
+		return nil, err
+	}
+	err = h.ictl.DeleteAccount(ctx, h.logger, user, request.Msg)
+	if err != nil {
+		h.logger.WithError(err).Error(identity.ErrFailedToDeleteAccount)
+		return nil, accountsError(identity.ErrFailedToDeleteAccount, err)
+	}
+
+	return connect.NewResponse(&v1.DeleteAccountResponse{}), nil
+}
+
+// signedIn returns the user signed in to Home Cloud by the request (see identity.Accounts).
+func (h *rpcHandler) signedIn(ctx context.Context, header http.Header) (string, error) {
+	user, err := h.ictl.SignedIn(ctx, header)
+	if errors.Is(err, identity.ErrNotSignedIn) {
+		return "", connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if err != nil {
+		h.logger.WithError(err).Error("failed to get session")
+		return "", err
+	}
+	return user, nil
+}
+
+// accountsError returns the error of a failed account request with the matching code.
+func accountsError(msg string, err error) error {
+	switch {
+	case errors.Is(err, identity.ErrNotSignedIn):
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	case errors.Is(err, identity.ErrForbidden):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	case errors.Is(err, identity.ErrLastAdmin):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return fmt.Errorf("%s: %s", msg, err.Error())
+}
+
 func (h *rpcHandler) Subscribe(ctx context.Context, request *connect.Request[v1.SubscribeRequest], stream *connect.ServerStream[v1.ServerEvent]) error {
 	id := events.AddStream(stream)
 	log := h.logger.WithField("stream_id", id)
@@ -0,0 +1,111 @@
+// Package bundle reads offline install bundles: a directory holding everything needed to install and
+// upgrade Home Cloud without internet access. Bundles are made by tools/releaser and are laid out as:
+//
+//	manifest.yaml     the InstallSpec of the release (i.e. the versions of all components)
+//	crds.yaml         the Home Cloud CRDs
+//	operator.yaml     the operator objects (mounting the bundle into the operator)
+//	install.yaml      the Install pointing at the bundle
+//	gateway-api.yaml  the Gateway API CRDs
+//	charts/           a Helm repository (index.yaml and chart archives) of the Istio and App charts
+//	images.txt        the container images of the release, one per line
+package bundle
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+	"helm.sh/helm/v3/pkg/provenance"
+	"helm.sh/helm/v3/pkg/repo"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+const (
+	ManifestFile   = "manifest.yaml"
+	CRDsFile       = "crds.yaml"
+	OperatorFile   = "operator.yaml"
+	InstallFile    = "install.yaml"
+	GatewayAPIFile = "gateway-api.yaml"
+	ChartsDir      = "charts"
+	ImagesFile     = "images.txt"
+
+	// DefaultPath is where bundles are extracted on the host by default.
+	DefaultPath = "/var/lib/home-cloud/bundle"
+)
+
+// ErrChartNotFound is returned when a chart version isn't in the bundle.
+var ErrChartNotFound = errors.New("chart not found in bundle")
+
+// Bundle is an offline install bundle extracted at Path.
+type Bundle struct {
+	Path string
+}
+
+// New returns the bundle extracted at path.
+func New(path string) *Bundle {
+	return &Bundle{Path: path}
+}
+
+// Of returns the bundle of the Install or nil if it isn't installed from a bundle.
+func Of(install *v1.Install) *Bundle {
+	if install.Spec.Bundle == nil {
+		return nil
+	}
+	path := install.Spec.Bundle.Path
+	if path == "" {
+		path = DefaultPath
+	}
+	return New(path)
+}
+
+// Open opens a file of the bundle: e.g. CRDsFile.
+func (b *Bundle) Open(name string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(b.Path, name))
+}
+
+// Manifest returns the InstallSpec of the release in the bundle.
+func (b *Bundle) Manifest() (*v1.InstallSpec, error) {
+	f, err := b.Open(ManifestFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	spec := &v1.InstallSpec{}
+	err = yaml.NewDecoder(f).Decode(spec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bundle manifest: %w", err)
+	}
+	return spec, nil
+}
+
+// Chart returns the path of the archive of the chart version (the latest if version is empty) after
+// verifying it against the digest in the index of the bundle.
+func (b *Bundle) Chart(name string, version string) (string, error) {
+	dir := filepath.Join(b.Path, ChartsDir)
+	index, err := repo.LoadIndexFile(filepath.Join(dir, "index.yaml"))
+	if err != nil {
+		return "", err
+	}
+	cv, err := index.Get(name, version)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s %s", ErrChartNotFound, name, version)
+	}
+	if len(cv.URLs) == 0 || !filepath.IsLocal(cv.URLs[0]) {
+		return "", fmt.Errorf("chart %s %s is not an archive of the bundle", name, cv.Version)
+	}
+
+	path := filepath.Join(dir, cv.URLs[0])
+	digest, err := provenance.DigestFile(path)
+	if err != nil {
+		return "", err
+	}
+	if digest != cv.Digest {
+		return "", fmt.Errorf("digest of chart %s %s is %s but the bundle index has %s", name, cv.Version, digest, cv.Digest)
+	}
+	return path, nil
+}
@@ -0,0 +1,109 @@
+package bundle
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/repo"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+// newBundle returns a bundle with the given chart versions and a manifest of version v1.2.0.
+func newBundle(t *testing.T, charts map[string][]string) *Bundle {
+	b := New(t.TempDir())
+	dir := filepath.Join(b.Path, ChartsDir)
+	err := os.MkdirAll(dir, 0755)
+	assert.NoError(t, err)
+	for name, versions := range charts {
+		for _, version := range versions {
+			metadata := &chart.Metadata{APIVersion: chart.APIVersionV2, Name: name, Version: version}
+			_, err := chartutil.Save(&chart.Chart{Metadata: metadata}, dir)
+			assert.NoError(t, err)
+		}
+	}
+	index, err := repo.IndexDirectory(dir, "")
+	assert.NoError(t, err)
+	err = index.WriteFile(filepath.Join(dir, "index.yaml"), 0644)
+	assert.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(b.Path, ManifestFile), []byte("version: v1.2.0\n"), 0644)
+	assert.NoError(t, err)
+	return b
+}
+
+func TestOf(t *testing.T) {
+	assert.Nil(t, Of(&v1.Install{}))
+	assert.Equal(t, DefaultPath, Of(&v1.Install{Spec: v1.InstallSpec{Bundle: &v1.BundleSpec{}}}).Path)
+	assert.Equal(t, "/mnt/bundle", Of(&v1.Install{Spec: v1.InstallSpec{Bundle: &v1.BundleSpec{Path: "/mnt/bundle"}}}).Path)
+}
+
+func TestManifest(t *testing.T) {
+	b := newBundle(t, nil)
+	spec, err := b.Manifest()
+	assert.NoError(t, err)
+	assert.Equal(t, "v1.2.0", spec.Version)
+}
+
+func TestChart(t *testing.T) {
+	b := newBundle(t, map[string][]string{
+		"istiod": {"1.27.0"},
+		"immich": {"1.0.0", "1.1.0"},
+	})
+
+	tests := []struct {
+		name     string
+		chart    string
+		version  string
+		want     string
+		notFound bool
+	}{
+		{
+			name:    "version",
+			chart:   "immich",
+			version: "1.0.0",
+			want:    "immich-1.0.0.tgz",
+		},
+		{
+			name:  "latest version",
+			chart: "immich",
+			want:  "immich-1.1.0.tgz",
+		},
+		{
+			name:     "missing version",
+			chart:    "immich",
+			version:  "2.0.0",
+			notFound: true,
+		},
+		{
+			name:     "missing chart",
+			chart:    "jellyfin",
+			notFound: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.Chart(tt.chart, tt.version)
+			if tt.notFound {
+				assert.ErrorIs(t, err, ErrChartNotFound)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, filepath.Join(b.Path, ChartsDir, tt.want), got)
+		})
+	}
+}
+
+func TestChartDigestMismatch(t *testing.T) {
+	b := newBundle(t, map[string][]string{"immich": {"1.0.0"}})
+	err := os.WriteFile(filepath.Join(b.Path, ChartsDir, "immich-1.0.0.tgz"), []byte("tampered"), 0644)
+	assert.NoError(t, err)
+
+	_, err = b.Chart("immich", "1.0.0")
+	assert.ErrorContains(t, err, "bundle index")
+	assert.NotErrorIs(t, err, ErrChartNotFound)
+}
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -13,7 +14,9 @@ import (
 )
 
 var (
-	GatewayObjects = func(install *v1.Install) []client.Object {
+	// GatewayObjects returns the ingress gateway with an HTTPS listener for each of the given
+	// certificate Secrets.
+	GatewayObjects = func(install *v1.Install, certificates []corev1.Secret) []client.Object {
 		return []client.Object{
 			&gwv1.Gateway{
 				ObjectMeta: metav1.ObjectMeta{
@@ -22,18 +25,7 @@ var (
 				},
 				Spec: gwv1.GatewaySpec{
 					GatewayClassName: "istio",
-					Listeners: []gwv1.Listener{
-						{
-							Name:     "http",
-							Port:     80,
-							Protocol: gwv1.HTTPProtocolType,
-							AllowedRoutes: &gwv1.AllowedRoutes{
-								Namespaces: &gwv1.RouteNamespaces{
-									From: ptr.To[gwv1.FromNamespaces]("All"),
-								},
-							},
-						},
-					},
+					Listeners:        GatewayListeners(certificates),
 					Infrastructure: &gwv1.GatewayInfrastructure{
 						ParametersRef: &gwv1.LocalParametersReference{
 							Kind: gwv1.Kind("ConfigMap"),
@@ -53,6 +45,8 @@ var (
   ports:
   - port: 80
     nodePort: 80
+  - port: 443
+    nodePort: 443
 `,
 				},
 			},
@@ -91,4 +85,51 @@ var (
 			},
 		}
 	}
+
+	// GatewayListeners returns the listeners of the ingress gateway: plain HTTP for all hostnames and
+	// HTTPS for the hostname of each of the given certificate Secrets.
+	GatewayListeners = func(certificates []corev1.Secret) []gwv1.Listener {
+		allowedRoutes := &gwv1.AllowedRoutes{
+			Namespaces: &gwv1.RouteNamespaces{
+				From: ptr.To[gwv1.FromNamespaces]("All"),
+			},
+		}
+		listeners := []gwv1.Listener{
+			{
+				Name:          HTTPListenerName,
+				Port:          80,
+				Protocol:      gwv1.HTTPProtocolType,
+				AllowedRoutes: allowedRoutes,
+			},
+		}
+		for _, certificate := range certificates {
+			hostname := certificate.Annotations[v1.CertificateHostnameAnnotation]
+			listeners = append(listeners, gwv1.Listener{
+				Name:     HTTPSListenerName(hostname),
+				Hostname: ptr.To(gwv1.Hostname(hostname)),
+				Port:     443,
+				Protocol: gwv1.HTTPSProtocolType,
+				TLS: &gwv1.ListenerTLSConfig{
+					Mode: ptr.To(gwv1.TLSModeTerminate),
+					CertificateRefs: []gwv1.SecretObjectReference{
+						{
+							Name: gwv1.ObjectName(certificate.Name),
+						},
+					},
+				},
+				AllowedRoutes: allowedRoutes,
+			})
+		}
+		return listeners
+	}
+)
+
+const (
+	// HTTPListenerName is the name of the plain HTTP listener of the ingress gateway
+	HTTPListenerName = "http"
 )
+
+// HTTPSListenerName returns the name of the HTTPS listener of the ingress gateway for the hostname.
+func HTTPSListenerName(hostname string) gwv1.SectionName {
+	return gwv1.SectionName("https-" + strings.ReplaceAll(hostname, ".", "-"))
+}
@@ -0,0 +1,83 @@
+package resources
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+// IssuerURL returns the URL of the OIDC identity provider served by the operator on the Home Cloud
+// hostname. It is served over HTTPS whenever certificates are issued for the ingress gateway.
+func IssuerURL(install *v1.Install) string {
+	scheme := "https"
+	if install.Spec.TLS == nil || install.Spec.TLS.Disable || install.Spec.Istio.Disable ||
+		(install.Spec.GatewayAPI != nil && install.Spec.GatewayAPI.Gateway != nil) {
+		scheme = "http"
+	}
+	return scheme + "://" + install.Spec.Settings.Hostname + "/oidc"
+}
+
+// InternalIssuerURL returns the URL of the OIDC identity provider inside the cluster.
+func InternalIssuerURL(install *v1.Install) string {
+	return "http://operator." + install.Namespace + ".svc.cluster.local/oidc"
+}
+
+// IstiodValues returns the values of the istiod chart: the values set on the Install with the
+// home-cloud-auth extension provider added (unless a provider of that name is set already) so that
+// App routes can be authenticated by the identity provider of the operator.
+func IstiodValues(install *v1.Install) (string, error) {
+	values := map[string]any{}
+	err := yaml.Unmarshal([]byte(install.Spec.Istio.Istiod.Values), &values)
+	if err != nil {
+		return "", err
+	}
+	if values == nil {
+		values = map[string]any{}
+	}
+
+	meshConfig := map[string]any{}
+	if v, ok := values["meshConfig"]; ok {
+		meshConfig, ok = v.(map[string]any)
+		if !ok {
+			return "", fmt.Errorf("invalid istiod values: meshConfig is not a map")
+		}
+	}
+	providers := []any{}
+	if v, ok := meshConfig["extensionProviders"]; ok {
+		providers, ok = v.([]any)
+		if !ok {
+			return "", fmt.Errorf("invalid istiod values: meshConfig.extensionProviders is not a list")
+		}
+	}
+	for _, provider := range providers {
+		if p, ok := provider.(map[string]any); ok && p["name"] == v1.AuthProviderName {
+			return install.Spec.Istio.Istiod.Values, nil
+		}
+	}
+	meshConfig["extensionProviders"] = append(providers, authProvider(install))
+	values["meshConfig"] = meshConfig
+
+	out, err := yaml.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// authProvider returns the Istio extension provider which checks requests against the identity
+// provider served by the operator in the namespace of the Install.
+func authProvider(install *v1.Install) map[string]any {
+	return map[string]any{
+		"name": v1.AuthProviderName,
+		"envoyExtAuthzHttp": map[string]any{
+			"service":                      "operator." + install.Namespace + ".svc.cluster.local",
+			"port":                         80,
+			"pathPrefix":                   "/auth/verify",
+			"includeRequestHeadersInCheck": []any{"cookie", "x-forwarded-proto"},
+			"headersToUpstreamOnAllow":     []any{"x-forwarded-user", "x-forwarded-email"},
+			"headersToDownstreamOnDeny":    []any{"location", "set-cookie", "content-type"},
+		},
+	}
+}
@@ -0,0 +1,100 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/home-cloud-io/core/api/crds/v1"
+)
+
+func TestIstiodValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		values string
+		// expected service of the home-cloud-auth provider
+		wantService string
+		// expected number of extension providers
+		wantProviders int
+	}{
+		{
+			name:          "default values",
+			values:        DefaultInstall.Spec.Istio.Istiod.Values,
+			wantService:   "operator.home-cloud.svc.cluster.local",
+			wantProviders: 1,
+		},
+		{
+			name:          "empty values",
+			wantService:   "operator.home-cloud.svc.cluster.local",
+			wantProviders: 1,
+		},
+		{
+			name: "other providers are kept",
+			values: `
+meshConfig:
+  extensionProviders:
+  - name: other
+`,
+			wantService:   "operator.home-cloud.svc.cluster.local",
+			wantProviders: 2,
+		},
+		{
+			name: "configured provider is kept",
+			values: `
+meshConfig:
+  extensionProviders:
+  - name: home-cloud-auth
+    envoyExtAuthzHttp:
+      service: custom.svc.cluster.local
+`,
+			wantService:   "custom.svc.cluster.local",
+			wantProviders: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			install := &v1.Install{
+				ObjectMeta: metav1.ObjectMeta{Name: "install", Namespace: "home-cloud"},
+				Spec: v1.InstallSpec{
+					Istio: &v1.IstioSpec{Istiod: &v1.IstiodSpec{Values: tt.values}},
+				},
+			}
+			out, err := IstiodValues(install)
+			assert.NoError(t, err)
+
+			values := struct {
+				Resources  map[string]any
+				MeshConfig struct {
+					ExtensionProviders []struct {
+						Name              string
+						EnvoyExtAuthzHttp struct {
+							Service string
+						} `yaml:"envoyExtAuthzHttp"`
+					} `yaml:"extensionProviders"`
+				} `yaml:"meshConfig"`
+			}{}
+			err = yaml.Unmarshal([]byte(out), &values)
+			assert.NoError(t, err)
+			assert.Len(t, values.MeshConfig.ExtensionProviders, tt.wantProviders)
+			for _, provider := range values.MeshConfig.ExtensionProviders {
+				if provider.Name == v1.AuthProviderName {
+					assert.Equal(t, tt.wantService, provider.EnvoyExtAuthzHttp.Service)
+				}
+			}
+			if tt.values == DefaultInstall.Spec.Istio.Istiod.Values {
+				// the other values are kept
+				assert.NotEmpty(t, values.Resources)
+			}
+		})
+	}
+}
+
+func TestIstiodValuesInvalid(t *testing.T) {
+	install := &v1.Install{Spec: v1.InstallSpec{
+		Istio: &v1.IstioSpec{Istiod: &v1.IstiodSpec{Values: "meshConfig: none"}},
+	}}
+	_, err := IstiodValues(install)
+	assert.Error(t, err)
+}
@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/home-cloud-io/core/api/crds/v1"
+	"github.com/home-cloud-io/core/pkg/install/bundle"
 )
 
 var (
@@ -20,6 +21,41 @@ var (
 	//       these definitions to create the releasable `operator.yaml` consistent with what
 	//       the operator will reconcile itself.
 	OperatorObjects = func(install *v1.Install) []client.Object {
+		volumes := []corev1.Volume{
+			{
+				Name: "config",
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "operator"},
+					},
+				},
+			},
+		}
+		volumeMounts := []corev1.VolumeMount{
+			{
+				Name:      "config",
+				MountPath: "/etc/config.yaml",
+				SubPath:   "config.yaml",
+			},
+		}
+		// the operator reads the release files and charts of offline installs from the bundle
+		if b := bundle.Of(install); b != nil {
+			volumes = append(volumes, corev1.Volume{
+				Name: "bundle",
+				VolumeSource: corev1.VolumeSource{
+					HostPath: &corev1.HostPathVolumeSource{
+						Path: b.Path,
+						Type: ptr.To(corev1.HostPathDirectory),
+					},
+				},
+			})
+			volumeMounts = append(volumeMounts, corev1.VolumeMount{
+				Name:      "bundle",
+				MountPath: b.Path,
+				ReadOnly:  true,
+			})
+		}
+
 		return []client.Object{
 			&corev1.ServiceAccount{
 				TypeMeta: metav1.TypeMeta{
@@ -120,25 +156,10 @@ var (
 											},
 										},
 									},
-									VolumeMounts: []corev1.VolumeMount{
-										{
-											Name:      "config",
-											MountPath: "/etc/config.yaml",
-											SubPath:   "config.yaml",
-										},
-									},
-								},
-							},
-							Volumes: []corev1.Volume{
-								{
-									Name: "config",
-									VolumeSource: corev1.VolumeSource{
-										ConfigMap: &corev1.ConfigMapVolumeSource{
-											LocalObjectReference: corev1.LocalObjectReference{Name: "operator"},
-										},
-									},
+									VolumeMounts: volumeMounts,
 								},
 							},
+							Volumes: volumes,
 						},
 					},
 				},
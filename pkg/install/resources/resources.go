@@ -22,6 +22,8 @@ var (
 				Istiod: &v1.IstiodSpec{
 					// The default istiod resources are cpu=500m and memory=2048Mi which is wayyyy
 					// oversized for the typical Home Cloud installation.
+					//
+					// The home-cloud-auth extension provider is added by IstiodValues.
 					Values: `
 resources:
   requests:
@@ -50,6 +52,7 @@ resources:
 			},
 			Settings: &v1.SettingsSpec{
 				Hostname: "home-cloud.local",
+				RouteHostnames: []string{"{route}.local"},
 			},
 		},
 		Status: v1.InstallStatus{
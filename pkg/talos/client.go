@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
@@ -21,6 +22,8 @@ import (
 const (
 	talosConfigKey     = "daemon.talos_config"
 	defaultTalosConfig = "/var/run/secrets/talos.dev/config"
+	// Talos prefixes the volume ids of user volumes
+	userVolumePrefix = "u-"
 
 	ErrFailedToCreateClient = "failed to create talos client"
 )
@@ -48,18 +51,60 @@ func Client(ctx context.Context) (*client.Client, error) {
 }
 
 func CreateUserVolume(ctx context.Context, logger chassis.Logger, uvc *block.UserVolumeConfigV1Alpha1) (id string, err error) {
-	// TODO: support multi-node
-	node := ""
+	out, err := yaml.Marshal(uvc)
+	if err != nil {
+		logger.WithError(err).Error("failed to marshal UserVolumeConfig to yaml")
+		return
+	}
 
-	c, err := Client(ctx)
+	err = applyPatch(ctx, logger, out)
 	if err != nil {
-		logger.WithError(err).Error("failed to create client")
 		return
 	}
 
+	return fmt.Sprintf("%s%s", userVolumePrefix, uvc.MetaName), nil
+}
+
+// UpdateUserVolume applies the given UserVolumeConfig over the existing config of the same name (e.g. to
+// change its size).
+func UpdateUserVolume(ctx context.Context, logger chassis.Logger, uvc *block.UserVolumeConfigV1Alpha1) error {
 	out, err := yaml.Marshal(uvc)
 	if err != nil {
 		logger.WithError(err).Error("failed to marshal UserVolumeConfig to yaml")
+		return err
+	}
+
+	return applyPatch(ctx, logger, out)
+}
+
+// UserVolumeName returns the name of the UserVolumeConfig with the given id (as returned by CreateUserVolume).
+func UserVolumeName(id string) string {
+	return strings.TrimPrefix(id, userVolumePrefix)
+}
+
+// DeleteUserVolume removes the UserVolumeConfig with the given id (as returned by CreateUserVolume) from
+// the machine config.
+func DeleteUserVolume(ctx context.Context, logger chassis.Logger, id string) error {
+	return applyPatch(ctx, logger, deleteUserVolumePatch(id))
+}
+
+// deleteUserVolumePatch returns the machine config patch deleting the UserVolumeConfig with the given id.
+func deleteUserVolumePatch(id string) []byte {
+	return fmt.Appendf(nil, `apiVersion: v1alpha1
+kind: UserVolumeConfig
+name: %s
+$patch: delete
+`, UserVolumeName(id))
+}
+
+// applyPatch applies the given machine config patch to the node.
+func applyPatch(ctx context.Context, logger chassis.Logger, out []byte) (err error) {
+	// TODO: support multi-node
+	node := ""
+
+	c, err := Client(ctx)
+	if err != nil {
+		logger.WithError(err).Error("failed to create client")
 		return
 	}
 
@@ -127,7 +172,7 @@ func CreateUserVolume(ctx context.Context, logger chassis.Logger, uvc *block.Use
 		}).Info("patched resource")
 	}
 
-	return fmt.Sprintf("u-%s", uvc.MetaName), nil
+	return nil
 }
 
 func extractMachineConfigBody(mc resource.Resource) ([]byte, error) {
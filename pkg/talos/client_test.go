@@ -0,0 +1,32 @@
+package talos
+
+import (
+	"testing"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configpatcher"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserVolumeName(t *testing.T) {
+	tests := []struct {
+		id   string
+		want string
+	}{
+		{id: "u-immich-library", want: "immich-library"},
+		{id: "immich-library", want: "immich-library"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			assert.Equal(t, tt.want, UserVolumeName(tt.id))
+		})
+	}
+}
+
+func TestDeleteUserVolumePatch(t *testing.T) {
+	patch := deleteUserVolumePatch("u-immich-library")
+	assert.Contains(t, string(patch), "name: immich-library\n")
+	assert.Contains(t, string(patch), "$patch: delete\n")
+
+	_, err := configpatcher.LoadPatch(patch)
+	assert.NoError(t, err)
+}
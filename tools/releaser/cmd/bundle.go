@@ -0,0 +1,319 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steady-bytes/draft/tools/dctl/output"
+	"gopkg.in/yaml.v3"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/repo"
+	k8syaml "sigs.k8s.io/yaml"
+
+	opv1 "github.com/home-cloud-io/core/api/crds/v1"
+	"github.com/home-cloud-io/core/pkg/install/bundle"
+	"github.com/home-cloud-io/core/pkg/install/resources"
+)
+
+var (
+	manifest   string
+	bundleOut  string
+	bundlePath string
+	chartRefs  []string
+)
+
+var bundleCmd = &cobra.Command{
+	Use:   "bundle",
+	Short: "Generate an offline install bundle of a Home Cloud release",
+	Long: `Generate an offline install bundle of the release of a manifest (see the generate command). The
+bundle holds the release files, the Gateway API CRDs, the Istio charts, the charts of the given Apps and
+the list of the container images they use.
+
+Extract the bundle to the bundle path on the host, make the images available to the cluster (e.g. by
+pushing them to a registry mirror) and apply operator.yaml, crds.yaml and install.yaml.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		fmt.Printf("Manifest: %s\n", manifest)
+		fmt.Printf("Output path: %s\n", bundleOut)
+
+		data, err := os.ReadFile(manifest)
+		if err != nil {
+			return err
+		}
+		spec := &opv1.InstallSpec{}
+		err = k8syaml.Unmarshal(data, spec)
+		if err != nil {
+			return err
+		}
+		spec.Bundle = &opv1.BundleSpec{Path: bundlePath}
+
+		err = os.MkdirAll(filepath.Join(bundleOut, bundle.ChartsDir), 0755)
+		if err != nil {
+			return err
+		}
+		err = os.WriteFile(filepath.Join(bundleOut, bundle.ManifestFile), data, 0644)
+		if err != nil {
+			return err
+		}
+
+		err = installRelease(bundleOut, spec)
+		if err != nil {
+			return err
+		}
+
+		err = crdsRelease(bundleOut)
+		if err != nil {
+			return err
+		}
+
+		err = operatorRelease(bundleOut, spec)
+		if err != nil {
+			return err
+		}
+
+		err = gatewayAPIRelease(bundleOut, spec)
+		if err != nil {
+			return err
+		}
+
+		images, err := chartsRelease(bundleOut, spec)
+		if err != nil {
+			return err
+		}
+
+		return imagesRelease(bundleOut, spec, images)
+	},
+}
+
+// gatewayAPIRelease downloads the Gateway API CRDs of the release.
+func gatewayAPIRelease(dir string, spec *opv1.InstallSpec) error {
+	if spec.GatewayAPI == nil || spec.GatewayAPI.Disable {
+		return nil
+	}
+
+	resp, err := http.Get(fmt.Sprintf("%s/%s/standard-install.yaml", spec.GatewayAPI.Source, spec.GatewayAPI.Version))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download gateway api crds: %s", resp.Status)
+	}
+
+	f, err := os.Create(filepath.Join(dir, bundle.GatewayAPIFile))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(f, resp.Body)
+	return err
+}
+
+// chartsRelease pulls the Istio charts of the release and the App charts into a Helm repository and
+// returns the container images of the charts.
+func chartsRelease(dir string, spec *opv1.InstallSpec) ([]string, error) {
+	dir = filepath.Join(dir, bundle.ChartsDir)
+	images := []string{}
+
+	if spec.Istio != nil && !spec.Istio.Disable {
+		defaults := resources.DefaultInstall.Spec.Istio
+		istio := []struct {
+			name   string
+			values string
+		}{
+			{name: "base", values: defaults.Base.Values},
+			{name: "istiod", values: defaults.Istiod.Values},
+			{name: "cni", values: defaults.CNI.Values},
+			{name: "ztunnel", values: defaults.Ztunnel.Values},
+		}
+		for _, c := range istio {
+			output.Print("Pulling %s %s", c.name, spec.Istio.Version)
+			path, err := pullChart(dir, spec.Istio.Source, c.name, spec.Istio.Version)
+			if err != nil {
+				return nil, err
+			}
+			// the operator installs the istio charts with the ambient profile
+			chartImages, err := renderImages(spec, path, c.name, c.values, map[string]any{"profile": "ambient"})
+			if err != nil {
+				return nil, err
+			}
+			images = append(images, chartImages...)
+		}
+	}
+
+	for _, ref := range chartRefs {
+		ref, version, ok := strings.Cut(ref, "@")
+		if !ok {
+			return nil, fmt.Errorf("chart %s has no version: expected <repo>/<chart>@<version>", ref)
+		}
+		repoURL, name := "", ref
+		if !registry.IsOCI(ref) {
+			i := strings.LastIndex(ref, "/")
+			if i < 0 {
+				return nil, fmt.Errorf("chart %s has no repository: expected <repo>/<chart>@<version>", ref)
+			}
+			repoURL, name = ref[:i], ref[i+1:]
+			if !strings.HasPrefix(repoURL, "http://") && !strings.HasPrefix(repoURL, "https://") {
+				repoURL = "https://" + repoURL
+			}
+		}
+
+		output.Print("Pulling %s %s", ref, version)
+		path, err := pullChart(dir, repoURL, name, version)
+		if err != nil {
+			return nil, err
+		}
+		chartImages, err := renderImages(spec, path, filepath.Base(name), "", nil)
+		if err != nil {
+			// charts may require values to render: their images can be added to images.txt by hand
+			output.Print("Failed to find the images of %s: %s", ref, err)
+			continue
+		}
+		images = append(images, chartImages...)
+	}
+
+	index, err := repo.IndexDirectory(dir, "")
+	if err != nil {
+		return nil, err
+	}
+	index.SortEntries()
+	return images, index.WriteFile(filepath.Join(dir, "index.yaml"), 0644)
+}
+
+// pullChart pulls the chart version into dir and returns the path of its archive. Charts in OCI
+// registries are referenced directly with an empty repository URL.
+func pullChart(dir string, repoURL string, name string, version string) (string, error) {
+	registryClient, err := registry.NewClient(registry.ClientOptWriter(io.Discard))
+	if err != nil {
+		return "", err
+	}
+	pull := action.NewPullWithOpts(action.WithConfig(&action.Configuration{RegistryClient: registryClient}))
+	pull.Settings = cli.New()
+	pull.RepoURL = repoURL
+	pull.Version = version
+	pull.DestDir = dir
+	_, err = pull.Run(name)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, fmt.Sprintf("%s-%s.tgz", filepath.Base(name), version)), nil
+}
+
+// renderImages renders the chart like `helm template` and returns the container images of its
+// manifests.
+func renderImages(spec *opv1.InstallSpec, path string, release string, values string, overrides map[string]any) ([]string, error) {
+	chart, err := loader.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	vals := map[string]any{}
+	err = yaml.Unmarshal([]byte(values), &vals)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range overrides {
+		vals[k] = v
+	}
+
+	act := action.NewInstall(&action.Configuration{Log: func(string, ...any) {}})
+	act.DryRun = true
+	act.ClientOnly = true
+	act.Replace = true
+	act.ReleaseName = release
+	act.Namespace = "default"
+	if spec.Daemon != nil && spec.Daemon.Kubernetes != nil && spec.Daemon.Kubernetes.Version != "" {
+		act.KubeVersion, err = chartutil.ParseKubeVersion(spec.Daemon.Kubernetes.Version)
+		if err != nil {
+			return nil, err
+		}
+	}
+	rel, err := act.Run(chart, vals)
+	if err != nil {
+		return nil, err
+	}
+
+	images := []string{}
+	dec := yaml.NewDecoder(strings.NewReader(rel.Manifest))
+	for {
+		doc := map[string]any{}
+		err := dec.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, findImages(doc)...)
+	}
+	return images, nil
+}
+
+// findImages returns the values of all the image fields of the object: i.e. those of its containers.
+func findImages(obj any) []string {
+	images := []string{}
+	switch obj := obj.(type) {
+	case map[string]any:
+		for k, v := range obj {
+			if image, ok := v.(string); ok && k == "image" {
+				images = append(images, image)
+				continue
+			}
+			images = append(images, findImages(v)...)
+		}
+	case []any:
+		for _, v := range obj {
+			images = append(images, findImages(v)...)
+		}
+	}
+	return images
+}
+
+// imagesRelease writes the container images of the Home Cloud components and of the charts.
+func imagesRelease(dir string, spec *opv1.InstallSpec, images []string) error {
+	image := func(name string, tag string) {
+		if name != "" && tag != "" {
+			images = append(images, fmt.Sprintf("%s:%s", name, tag))
+		}
+	}
+	if spec.Operator != nil && !spec.Operator.Disable {
+		image(spec.Operator.Image, spec.Operator.Tag)
+	}
+	if spec.MDNS != nil && !spec.MDNS.Disable {
+		image(spec.MDNS.Image, spec.MDNS.Tag)
+	}
+	if spec.Tunnel != nil && !spec.Tunnel.Disable {
+		image(spec.Tunnel.Image, spec.Tunnel.Tag)
+	}
+	if spec.Daemon != nil && !spec.Daemon.Disable {
+		image(spec.Daemon.Image, spec.Daemon.Tag)
+		// the installer image is pulled by the daemon to upgrade the system
+		if spec.Daemon.System != nil && !spec.Daemon.System.Disable {
+			image(spec.Daemon.System.Source, spec.Daemon.System.Version)
+		}
+	}
+
+	slices.Sort(images)
+	images = slices.Compact(images)
+	return os.WriteFile(filepath.Join(dir, bundle.ImagesFile), []byte(strings.Join(images, "\n")+"\n"), 0644)
+}
+
+func init() {
+	rootCmd.AddCommand(bundleCmd)
+
+	bundleCmd.Flags().StringVarP(&path, "path", "p", "../../", "Path to the root of the home-cloud-io/core repository")
+	bundleCmd.Flags().StringVarP(&manifest, "manifest", "m", "out/manifest.yaml", "Path to the release manifest written by the generate command")
+	bundleCmd.Flags().StringVarP(&bundleOut, "out", "o", "bundle/", "Output path to write the bundle to")
+	bundleCmd.Flags().StringVar(&bundlePath, "bundle-path", bundle.DefaultPath, "Path the bundle is extracted to on the host")
+	bundleCmd.Flags().StringArrayVarP(&chartRefs, "chart", "c", nil, "App chart to include as <repo>/<chart>@<version>: e.g. apps.home-cloud.io/immich@1.0.0 or oci://ghcr.io/home-cloud-io/charts/immich@1.0.0")
+}
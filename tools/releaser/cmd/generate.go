@@ -16,6 +16,7 @@ import (
 
 	opv1 "github.com/home-cloud-io/core/api/crds/v1"
 	"github.com/home-cloud-io/core/cmd/operator/server/system"
+	"github.com/home-cloud-io/core/pkg/install/bundle"
 	"github.com/home-cloud-io/core/pkg/install/resources"
 )
 
@@ -25,7 +26,10 @@ var (
 
 	crdFiles = []string{
 		"home-cloud.io_apps.yaml",
+		"home-cloud.io_backups.yaml",
+		"home-cloud.io_backupschedules.yaml",
 		"home-cloud.io_installs.yaml",
+		"home-cloud.io_restores.yaml",
 		"home-cloud.io_wireguards.yaml",
 	}
 )
@@ -43,17 +47,17 @@ var generateCmd = &cobra.Command{
 			return err
 		}
 
-		err = installRelease(spec)
+		err = installRelease(out, spec)
 		if err != nil {
 			return err
 		}
 
-		err = crdsRelease()
+		err = crdsRelease(out)
 		if err != nil {
 			return err
 		}
 
-		err = operatorRelease(spec)
+		err = operatorRelease(out, spec)
 		if err != nil {
 			return err
 		}
@@ -63,7 +67,7 @@ var generateCmd = &cobra.Command{
 }
 
 func manifestRelease() (*opv1.InstallSpec, error) {
-	f, err := os.Create(filepath.Join(out, "manifest.yaml"))
+	f, err := os.Create(filepath.Join(out, bundle.ManifestFile))
 	if err != nil {
 		return nil, err
 	}
@@ -114,8 +118,8 @@ func manifestRelease() (*opv1.InstallSpec, error) {
 	return latest, nil
 }
 
-func installRelease(spec *opv1.InstallSpec) error {
-	f, err := os.Create(filepath.Join(out, "install.yaml"))
+func installRelease(dir string, spec *opv1.InstallSpec) error {
+	f, err := os.Create(filepath.Join(dir, bundle.InstallFile))
 	if err != nil {
 		return err
 	}
@@ -135,6 +139,7 @@ func installRelease(spec *opv1.InstallSpec) error {
 		},
 		Spec: opv1.InstallSpec{
 			Version: spec.Version,
+			Bundle:  spec.Bundle,
 		},
 	}
 
@@ -150,8 +155,8 @@ func installRelease(spec *opv1.InstallSpec) error {
 	return nil
 }
 
-func crdsRelease() error {
-	f, err := os.Create(filepath.Join(out, "crds.yaml"))
+func crdsRelease(dir string) error {
+	f, err := os.Create(filepath.Join(dir, bundle.CRDsFile))
 	if err != nil {
 		return err
 	}
@@ -172,18 +177,19 @@ func crdsRelease() error {
 	return nil
 }
 
-func operatorRelease(spec *opv1.InstallSpec) error {
-	f, err := os.Create(filepath.Join(out, "operator.yaml"))
+func operatorRelease(dir string, spec *opv1.InstallSpec) error {
+	f, err := os.Create(filepath.Join(dir, bundle.OperatorFile))
 	if err != nil {
 		return err
 	}
 	defer f.Close()
 
-	install := resources.DefaultInstall
+	install := resources.DefaultInstall.DeepCopy()
 	install.Spec.Operator = &opv1.OperatorSpec{
 		Image: spec.Operator.Image,
 		Tag:   spec.Operator.Tag,
 	}
+	install.Spec.Bundle = spec.Bundle
 
 	objects := resources.OperatorObjects(install)
 
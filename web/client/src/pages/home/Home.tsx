@@ -242,9 +242,11 @@ function Application(props: Props) {
 
   return (
     <div style={{ padding: 4, width: 64, textAlign: "center" }}>
-      <Badge dot status={status}>
-        <Avatar src={app.display?.iconUrl} shape="square" size="large" />
-      </Badge>
+      <Tooltip title={app.error || app.phase} placement={"bottom"}>
+        <Badge dot status={status}>
+          <Avatar src={app.display?.iconUrl} shape="square" size="large" />
+        </Badge>
+      </Tooltip>
       <div>{app.display?.name}</div>
     </div>
   );